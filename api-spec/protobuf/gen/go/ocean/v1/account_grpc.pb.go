@@ -40,6 +40,14 @@ type AccountServiceClient interface {
 	// ListUtxos returns the utxos for the account, or specific list of
 	// account's addresses.
 	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	// FreezeUtxos excludes some utxos of the account from any coin selection
+	// until they're unfrozen. Unlike locks, a freeze never expires.
+	FreezeUtxos(ctx context.Context, in *FreezeUtxosRequest, opts ...grpc.CallOption) (*FreezeUtxosResponse, error)
+	// UnfreezeUtxos makes some frozen utxos of the account spendable again.
+	UnfreezeUtxos(ctx context.Context, in *UnfreezeUtxosRequest, opts ...grpc.CallOption) (*UnfreezeUtxosResponse, error)
+	// ExportHistory streams the history of the account, one entry for every
+	// asset moved by every transaction involving the account.
+	ExportHistory(ctx context.Context, in *ExportHistoryRequest, opts ...grpc.CallOption) (AccountService_ExportHistoryClient, error)
 	// DeleteAccount deletes an existing account. The operation is allowed only
 	// if the account has zero balance.
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
@@ -143,6 +151,56 @@ func (c *accountServiceClient) ListUtxos(ctx context.Context, in *ListUtxosReque
 	return out, nil
 }
 
+func (c *accountServiceClient) FreezeUtxos(ctx context.Context, in *FreezeUtxosRequest, opts ...grpc.CallOption) (*FreezeUtxosResponse, error) {
+	out := new(FreezeUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/FreezeUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UnfreezeUtxos(ctx context.Context, in *UnfreezeUtxosRequest, opts ...grpc.CallOption) (*UnfreezeUtxosResponse, error) {
+	out := new(UnfreezeUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/UnfreezeUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ExportHistory(ctx context.Context, in *ExportHistoryRequest, opts ...grpc.CallOption) (AccountService_ExportHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AccountService_ServiceDesc.Streams[0], "/ocean.v1.AccountService/ExportHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountServiceExportHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AccountService_ExportHistoryClient interface {
+	Recv() (*ExportHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type accountServiceExportHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountServiceExportHistoryClient) Recv() (*ExportHistoryResponse, error) {
+	m := new(ExportHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *accountServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
 	out := new(DeleteAccountResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.AccountService/DeleteAccount", in, out, opts...)
@@ -178,6 +236,14 @@ type AccountServiceServer interface {
 	// ListUtxos returns the utxos for the account, or specific list of
 	// account's addresses.
 	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
+	// FreezeUtxos excludes some utxos of the account from any coin selection
+	// until they're unfrozen. Unlike locks, a freeze never expires.
+	FreezeUtxos(context.Context, *FreezeUtxosRequest) (*FreezeUtxosResponse, error)
+	// UnfreezeUtxos makes some frozen utxos of the account spendable again.
+	UnfreezeUtxos(context.Context, *UnfreezeUtxosRequest) (*UnfreezeUtxosResponse, error)
+	// ExportHistory streams the history of the account, one entry for every
+	// asset moved by every transaction involving the account.
+	ExportHistory(*ExportHistoryRequest, AccountService_ExportHistoryServer) error
 	// DeleteAccount deletes an existing account. The operation is allowed only
 	// if the account has zero balance.
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
@@ -217,6 +283,15 @@ func (UnimplementedAccountServiceServer) Balance(context.Context, *BalanceReques
 func (UnimplementedAccountServiceServer) ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
 }
+func (UnimplementedAccountServiceServer) FreezeUtxos(context.Context, *FreezeUtxosRequest) (*FreezeUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeUtxos not implemented")
+}
+func (UnimplementedAccountServiceServer) UnfreezeUtxos(context.Context, *UnfreezeUtxosRequest) (*UnfreezeUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeUtxos not implemented")
+}
+func (UnimplementedAccountServiceServer) ExportHistory(*ExportHistoryRequest, AccountService_ExportHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportHistory not implemented")
+}
 func (UnimplementedAccountServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
@@ -412,6 +487,63 @@ func _AccountService_ListUtxos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_FreezeUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).FreezeUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/FreezeUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).FreezeUtxos(ctx, req.(*FreezeUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_UnfreezeUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UnfreezeUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.AccountService/UnfreezeUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UnfreezeUtxos(ctx, req.(*UnfreezeUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ExportHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountServiceServer).ExportHistory(m, &accountServiceExportHistoryServer{stream})
+}
+
+type AccountService_ExportHistoryServer interface {
+	Send(*ExportHistoryResponse) error
+	grpc.ServerStream
+}
+
+type accountServiceExportHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountServiceExportHistoryServer) Send(m *ExportHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AccountService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAccountRequest)
 	if err := dec(in); err != nil {
@@ -477,11 +609,25 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUtxos",
 			Handler:    _AccountService_ListUtxos_Handler,
 		},
+		{
+			MethodName: "FreezeUtxos",
+			Handler:    _AccountService_FreezeUtxos_Handler,
+		},
+		{
+			MethodName: "UnfreezeUtxos",
+			Handler:    _AccountService_UnfreezeUtxos_Handler,
+		},
 		{
 			MethodName: "DeleteAccount",
 			Handler:    _AccountService_DeleteAccount_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportHistory",
+			Handler:       _AccountService_ExportHistory_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ocean/v1/account.proto",
 }
@@ -30,6 +30,11 @@ type NotificationServiceClient interface {
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (NotificationService_TransactionNotificationsClient, error)
 	// Notifies about events realted to wallet utxos.
 	UtxosNotifications(ctx context.Context, in *UtxosNotificationsRequest, opts ...grpc.CallOption) (NotificationService_UtxosNotificationsClient, error)
+	// Notifies about the queued payments sent with a batched transaction.
+	PaymentNotifications(ctx context.Context, in *PaymentNotificationsRequest, opts ...grpc.CallOption) (NotificationService_PaymentNotificationsClient, error)
+	// Notifies about the attempts to spend funds refused because violating the
+	// spending policy of an account.
+	PolicyViolationNotifications(ctx context.Context, in *PolicyViolationNotificationsRequest, opts ...grpc.CallOption) (NotificationService_PolicyViolationNotificationsClient, error)
 	// Adds a webhook registered for some kind of event.
 	AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*AddWebhookResponse, error)
 	// Removes some previously added webhook.
@@ -128,6 +133,70 @@ func (x *notificationServiceUtxosNotificationsClient) Recv() (*UtxosNotification
 	return m, nil
 }
 
+func (c *notificationServiceClient) PaymentNotifications(ctx context.Context, in *PaymentNotificationsRequest, opts ...grpc.CallOption) (NotificationService_PaymentNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[2], "/ocean.v1.NotificationService/PaymentNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServicePaymentNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_PaymentNotificationsClient interface {
+	Recv() (*PaymentNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type notificationServicePaymentNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServicePaymentNotificationsClient) Recv() (*PaymentNotificationsResponse, error) {
+	m := new(PaymentNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationServiceClient) PolicyViolationNotifications(ctx context.Context, in *PolicyViolationNotificationsRequest, opts ...grpc.CallOption) (NotificationService_PolicyViolationNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[3], "/ocean.v1.NotificationService/PolicyViolationNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServicePolicyViolationNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_PolicyViolationNotificationsClient interface {
+	Recv() (*PolicyViolationNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type notificationServicePolicyViolationNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServicePolicyViolationNotificationsClient) Recv() (*PolicyViolationNotificationsResponse, error) {
+	m := new(PolicyViolationNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *notificationServiceClient) AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*AddWebhookResponse, error) {
 	out := new(AddWebhookResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.NotificationService/AddWebhook", in, out, opts...)
@@ -171,6 +240,11 @@ type NotificationServiceServer interface {
 	TransactionNotifications(*TransactionNotificationsRequest, NotificationService_TransactionNotificationsServer) error
 	// Notifies about events realted to wallet utxos.
 	UtxosNotifications(*UtxosNotificationsRequest, NotificationService_UtxosNotificationsServer) error
+	// Notifies about the queued payments sent with a batched transaction.
+	PaymentNotifications(*PaymentNotificationsRequest, NotificationService_PaymentNotificationsServer) error
+	// Notifies about the attempts to spend funds refused because violating the
+	// spending policy of an account.
+	PolicyViolationNotifications(*PolicyViolationNotificationsRequest, NotificationService_PolicyViolationNotificationsServer) error
 	// Adds a webhook registered for some kind of event.
 	AddWebhook(context.Context, *AddWebhookRequest) (*AddWebhookResponse, error)
 	// Removes some previously added webhook.
@@ -195,6 +269,12 @@ func (UnimplementedNotificationServiceServer) TransactionNotifications(*Transact
 func (UnimplementedNotificationServiceServer) UtxosNotifications(*UtxosNotificationsRequest, NotificationService_UtxosNotificationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method UtxosNotifications not implemented")
 }
+func (UnimplementedNotificationServiceServer) PaymentNotifications(*PaymentNotificationsRequest, NotificationService_PaymentNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PaymentNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) PolicyViolationNotifications(*PolicyViolationNotificationsRequest, NotificationService_PolicyViolationNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PolicyViolationNotifications not implemented")
+}
 func (UnimplementedNotificationServiceServer) AddWebhook(context.Context, *AddWebhookRequest) (*AddWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
 }
@@ -294,6 +374,48 @@ func (x *notificationServiceUtxosNotificationsServer) Send(m *UtxosNotifications
 	return x.ServerStream.SendMsg(m)
 }
 
+func _NotificationService_PaymentNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PaymentNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).PaymentNotifications(m, &notificationServicePaymentNotificationsServer{stream})
+}
+
+type NotificationService_PaymentNotificationsServer interface {
+	Send(*PaymentNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type notificationServicePaymentNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServicePaymentNotificationsServer) Send(m *PaymentNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NotificationService_PolicyViolationNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PolicyViolationNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).PolicyViolationNotifications(m, &notificationServicePolicyViolationNotificationsServer{stream})
+}
+
+type NotificationService_PolicyViolationNotificationsServer interface {
+	Send(*PolicyViolationNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type notificationServicePolicyViolationNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServicePolicyViolationNotificationsServer) Send(m *PolicyViolationNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _NotificationService_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddWebhookRequest)
 	if err := dec(in); err != nil {
@@ -387,6 +509,16 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _NotificationService_UtxosNotifications_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PaymentNotifications",
+			Handler:       _NotificationService_PaymentNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PolicyViolationNotifications",
+			Handler:       _NotificationService_PolicyViolationNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "ocean/v1/notification.proto",
 }
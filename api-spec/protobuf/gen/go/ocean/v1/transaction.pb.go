@@ -186,6 +186,10 @@ type SelectUtxosRequest struct {
 	// Target amount to cover.
 	TargetAmount uint64                      `protobuf:"varint,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
 	Strategy     SelectUtxosRequest_Strategy `protobuf:"varint,4,opt,name=strategy,proto3,enum=ocean.v1.SelectUtxosRequest_Strategy" json:"strategy,omitempty"`
+	// Minimum number of confirmations for a utxo to be selected. If not
+	// specified, the value the daemon is configured with is used. Unconfirmed
+	// utxos are never selected, therefore 0 is the same as 1.
+	MinConfirmations *uint32 `protobuf:"varint,5,opt,name=min_confirmations,json=minConfirmations,proto3,oneof" json:"min_confirmations,omitempty"`
 }
 
 func (x *SelectUtxosRequest) Reset() {
@@ -248,6 +252,13 @@ func (x *SelectUtxosRequest) GetStrategy() SelectUtxosRequest_Strategy {
 	return SelectUtxosRequest_STRATEGY_UNSPECIFIED
 }
 
+func (x *SelectUtxosRequest) GetMinConfirmations() uint32 {
+	if x != nil && x.MinConfirmations != nil {
+		return *x.MinConfirmations
+	}
+	return 0
+}
+
 type SelectUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -260,6 +271,9 @@ type SelectUtxosResponse struct {
 	// Expiration date for the selected utxo, which are temporary locked to
 	// prevent double spending them.
 	ExpirationDate int64 `protobuf:"varint,3,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	// Id of the lease of the selected utxos, required to unlock them before
+	// the expiration date.
+	LeaseId string `protobuf:"bytes,4,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
 }
 
 func (x *SelectUtxosResponse) Reset() {
@@ -315,6 +329,13 @@ func (x *SelectUtxosResponse) GetExpirationDate() int64 {
 	return 0
 }
 
+func (x *SelectUtxosResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
 type LockUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -376,6 +397,9 @@ type LockUtxosResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	ExpirationDate int64 `protobuf:"varint,1,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	// Id of the lease of the locked utxos, required to unlock them before
+	// the expiration date.
+	LeaseId string `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
 }
 
 func (x *LockUtxosResponse) Reset() {
@@ -417,18 +441,27 @@ func (x *LockUtxosResponse) GetExpirationDate() int64 {
 	return 0
 }
 
-type EstimateFeesRequest struct {
+func (x *LockUtxosResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type UnlockUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inputs           []*Input  `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Outputs          []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	MillisatsPerByte uint64    `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Id of the lease returned when the utxos were locked.
+	LeaseId string `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Optional subset of the utxos of the lease to unlock. If not specified, all
+	// of them are unlocked.
+	Utxos []*Input `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
 }
 
-func (x *EstimateFeesRequest) Reset() {
-	*x = EstimateFeesRequest{}
+func (x *UnlockUtxosRequest) Reset() {
+	*x = UnlockUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,13 +469,13 @@ func (x *EstimateFeesRequest) Reset() {
 	}
 }
 
-func (x *EstimateFeesRequest) String() string {
+func (x *UnlockUtxosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateFeesRequest) ProtoMessage() {}
+func (*UnlockUtxosRequest) ProtoMessage() {}
 
-func (x *EstimateFeesRequest) ProtoReflect() protoreflect.Message {
+func (x *UnlockUtxosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,42 +487,33 @@ func (x *EstimateFeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateFeesRequest.ProtoReflect.Descriptor instead.
-func (*EstimateFeesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockUtxosRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUtxosRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *EstimateFeesRequest) GetInputs() []*Input {
+func (x *UnlockUtxosRequest) GetLeaseId() string {
 	if x != nil {
-		return x.Inputs
+		return x.LeaseId
 	}
-	return nil
+	return ""
 }
 
-func (x *EstimateFeesRequest) GetOutputs() []*Output {
+func (x *UnlockUtxosRequest) GetUtxos() []*Input {
 	if x != nil {
-		return x.Outputs
+		return x.Utxos
 	}
 	return nil
 }
 
-func (x *EstimateFeesRequest) GetMillisatsPerByte() uint64 {
-	if x != nil {
-		return x.MillisatsPerByte
-	}
-	return 0
-}
-
-type EstimateFeesResponse struct {
+type UnlockUtxosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FeeAmount uint64 `protobuf:"varint,1,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
 }
 
-func (x *EstimateFeesResponse) Reset() {
-	*x = EstimateFeesResponse{}
+func (x *UnlockUtxosResponse) Reset() {
+	*x = UnlockUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,13 +521,13 @@ func (x *EstimateFeesResponse) Reset() {
 	}
 }
 
-func (x *EstimateFeesResponse) String() string {
+func (x *UnlockUtxosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateFeesResponse) ProtoMessage() {}
+func (*UnlockUtxosResponse) ProtoMessage() {}
 
-func (x *EstimateFeesResponse) ProtoReflect() protoreflect.Message {
+func (x *UnlockUtxosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,31 +539,25 @@ func (x *EstimateFeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateFeesResponse.ProtoReflect.Descriptor instead.
-func (*EstimateFeesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockUtxosResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUtxosResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EstimateFeesResponse) GetFeeAmount() uint64 {
-	if x != nil {
-		return x.FeeAmount
-	}
-	return 0
-}
-
-type SignTransactionRequest struct {
+type ExtendLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Raw transaction to sign.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
-	// The sighash type. SIGHASH_ALL is used if not defined.
-	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Id of the lease returned when the utxos were locked.
+	LeaseId string `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Number of seconds from now after which the lease expires. If not
+	// specified, the same duration used for locking utxos is applied.
+	Duration uint64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (x *SignTransactionRequest) Reset() {
-	*x = SignTransactionRequest{}
+func (x *ExtendLeaseRequest) Reset() {
+	*x = ExtendLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,13 +565,13 @@ func (x *SignTransactionRequest) Reset() {
 	}
 }
 
-func (x *SignTransactionRequest) String() string {
+func (x *ExtendLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignTransactionRequest) ProtoMessage() {}
+func (*ExtendLeaseRequest) ProtoMessage() {}
 
-func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *ExtendLeaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,36 +583,36 @@ func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExtendLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ExtendLeaseRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SignTransactionRequest) GetTxHex() string {
+func (x *ExtendLeaseRequest) GetLeaseId() string {
 	if x != nil {
-		return x.TxHex
+		return x.LeaseId
 	}
 	return ""
 }
 
-func (x *SignTransactionRequest) GetSighashType() uint32 {
+func (x *ExtendLeaseRequest) GetDuration() uint64 {
 	if x != nil {
-		return x.SighashType
+		return x.Duration
 	}
 	return 0
 }
 
-type SignTransactionResponse struct {
+type ExtendLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Raw signed transaction.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// New expiration date of the lease.
+	ExpirationDate int64 `protobuf:"varint,1,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
 }
 
-func (x *SignTransactionResponse) Reset() {
-	*x = SignTransactionResponse{}
+func (x *ExtendLeaseResponse) Reset() {
+	*x = ExtendLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -602,13 +620,13 @@ func (x *SignTransactionResponse) Reset() {
 	}
 }
 
-func (x *SignTransactionResponse) String() string {
+func (x *ExtendLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignTransactionResponse) ProtoMessage() {}
+func (*ExtendLeaseResponse) ProtoMessage() {}
 
-func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *ExtendLeaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -620,29 +638,29 @@ func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExtendLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ExtendLeaseResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SignTransactionResponse) GetTxHex() string {
+func (x *ExtendLeaseResponse) GetExpirationDate() int64 {
 	if x != nil {
-		return x.TxHex
+		return x.ExpirationDate
 	}
-	return ""
+	return 0
 }
 
-type BroadcastTransactionRequest struct {
+type ListLeasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Transaction to broadcast.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Optional account name to filter the leases.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
 }
 
-func (x *BroadcastTransactionRequest) Reset() {
-	*x = BroadcastTransactionRequest{}
+func (x *ListLeasesRequest) Reset() {
+	*x = ListLeasesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,13 +668,13 @@ func (x *BroadcastTransactionRequest) Reset() {
 	}
 }
 
-func (x *BroadcastTransactionRequest) String() string {
+func (x *ListLeasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastTransactionRequest) ProtoMessage() {}
+func (*ListLeasesRequest) ProtoMessage() {}
 
-func (x *BroadcastTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *ListLeasesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,29 +686,28 @@ func (x *BroadcastTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastTransactionRequest.ProtoReflect.Descriptor instead.
-func (*BroadcastTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLeasesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeasesRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BroadcastTransactionRequest) GetTxHex() string {
+func (x *ListLeasesRequest) GetAccountName() string {
 	if x != nil {
-		return x.TxHex
+		return x.AccountName
 	}
 	return ""
 }
 
-type BroadcastTransactionResponse struct {
+type ListLeasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Hash of the broadcasted transaction.
-	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
 }
 
-func (x *BroadcastTransactionResponse) Reset() {
-	*x = BroadcastTransactionResponse{}
+func (x *ListLeasesResponse) Reset() {
+	*x = ListLeasesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -698,13 +715,13 @@ func (x *BroadcastTransactionResponse) Reset() {
 	}
 }
 
-func (x *BroadcastTransactionResponse) String() string {
+func (x *ListLeasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastTransactionResponse) ProtoMessage() {}
+func (*ListLeasesResponse) ProtoMessage() {}
 
-func (x *BroadcastTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *ListLeasesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,31 +733,37 @@ func (x *BroadcastTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastTransactionResponse.ProtoReflect.Descriptor instead.
-func (*BroadcastTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLeasesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeasesResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BroadcastTransactionResponse) GetTxid() string {
+func (x *ListLeasesResponse) GetLeases() []*Lease {
 	if x != nil {
-		return x.Txid
+		return x.Leases
 	}
-	return ""
+	return nil
 }
 
-type CreatePsetRequest struct {
+type Lease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Inputs of the partial transaction.
-	Inputs []*Input `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	// Outputs of the partial transaction
-	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Id of the lease.
+	LeaseId string `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Name of the account owning the locked utxos.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// List of the locked utxos.
+	Utxos []*Input `protobuf:"bytes,3,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// Timestamp of the lock.
+	LockTimestamp int64 `protobuf:"varint,4,opt,name=lock_timestamp,json=lockTimestamp,proto3" json:"lock_timestamp,omitempty"`
+	// Expiration date of the lease.
+	ExpirationDate int64 `protobuf:"varint,5,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
 }
 
-func (x *CreatePsetRequest) Reset() {
-	*x = CreatePsetRequest{}
+func (x *Lease) Reset() {
+	*x = Lease{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,13 +771,13 @@ func (x *CreatePsetRequest) Reset() {
 	}
 }
 
-func (x *CreatePsetRequest) String() string {
+func (x *Lease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePsetRequest) ProtoMessage() {}
+func (*Lease) ProtoMessage() {}
 
-func (x *CreatePsetRequest) ProtoReflect() protoreflect.Message {
+func (x *Lease) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,36 +789,58 @@ func (x *CreatePsetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePsetRequest.ProtoReflect.Descriptor instead.
-func (*CreatePsetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreatePsetRequest) GetInputs() []*Input {
+func (x *Lease) GetLeaseId() string {
 	if x != nil {
-		return x.Inputs
+		return x.LeaseId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatePsetRequest) GetOutputs() []*Output {
+func (x *Lease) GetAccountName() string {
 	if x != nil {
-		return x.Outputs
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Lease) GetUtxos() []*Input {
+	if x != nil {
+		return x.Utxos
 	}
 	return nil
 }
 
-type CreatePsetResponse struct {
+func (x *Lease) GetLockTimestamp() int64 {
+	if x != nil {
+		return x.LockTimestamp
+	}
+	return 0
+}
+
+func (x *Lease) GetExpirationDate() int64 {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return 0
+}
+
+type EstimateFeesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// New partial transaction in base64 format.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	Inputs           []*Input  `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs          []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	MillisatsPerByte uint64    `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
 }
 
-func (x *CreatePsetResponse) Reset() {
-	*x = CreatePsetResponse{}
+func (x *EstimateFeesRequest) Reset() {
+	*x = EstimateFeesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,13 +848,13 @@ func (x *CreatePsetResponse) Reset() {
 	}
 }
 
-func (x *CreatePsetResponse) String() string {
+func (x *EstimateFeesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePsetResponse) ProtoMessage() {}
+func (*EstimateFeesRequest) ProtoMessage() {}
 
-func (x *CreatePsetResponse) ProtoReflect() protoreflect.Message {
+func (x *EstimateFeesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,33 +866,42 @@ func (x *CreatePsetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePsetResponse.ProtoReflect.Descriptor instead.
-func (*CreatePsetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateFeesRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeesRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreatePsetResponse) GetPset() string {
+func (x *EstimateFeesRequest) GetInputs() []*Input {
 	if x != nil {
-		return x.Pset
+		return x.Inputs
 	}
-	return ""
+	return nil
 }
 
-type UpdatePsetRequest struct {
+func (x *EstimateFeesRequest) GetOutputs() []*Output {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *EstimateFeesRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type EstimateFeesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The partial transaction to update in base64 format.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
-	// Inputs to add to the partial transaction.
-	Inputs []*Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	// Outputs to add to the partil transaction.
-	Outputs []*Output `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	FeeAmount uint64 `protobuf:"varint,1,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
 }
 
-func (x *UpdatePsetRequest) Reset() {
-	*x = UpdatePsetRequest{}
+func (x *EstimateFeesResponse) Reset() {
+	*x = EstimateFeesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,13 +909,13 @@ func (x *UpdatePsetRequest) Reset() {
 	}
 }
 
-func (x *UpdatePsetRequest) String() string {
+func (x *EstimateFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePsetRequest) ProtoMessage() {}
+func (*EstimateFeesResponse) ProtoMessage() {}
 
-func (x *UpdatePsetRequest) ProtoReflect() protoreflect.Message {
+func (x *EstimateFeesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -873,43 +927,30 @@ func (x *UpdatePsetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePsetRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePsetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateFeesResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFeesResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdatePsetRequest) GetPset() string {
-	if x != nil {
-		return x.Pset
-	}
-	return ""
-}
-
-func (x *UpdatePsetRequest) GetInputs() []*Input {
-	if x != nil {
-		return x.Inputs
-	}
-	return nil
-}
-
-func (x *UpdatePsetRequest) GetOutputs() []*Output {
+func (x *EstimateFeesResponse) GetFeeAmount() uint64 {
 	if x != nil {
-		return x.Outputs
+		return x.FeeAmount
 	}
-	return nil
+	return 0
 }
 
-type UpdatePsetResponse struct {
+type EstimateFeeRateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Updated partial transaction in base64 format.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Number of blocks within which the tx should be confirmed. Defaults to 2
+	// if not specified.
+	ConfTarget uint32 `protobuf:"varint,1,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
 }
 
-func (x *UpdatePsetResponse) Reset() {
-	*x = UpdatePsetResponse{}
+func (x *EstimateFeeRateRequest) Reset() {
+	*x = EstimateFeeRateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,13 +958,13 @@ func (x *UpdatePsetResponse) Reset() {
 	}
 }
 
-func (x *UpdatePsetResponse) String() string {
+func (x *EstimateFeeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePsetResponse) ProtoMessage() {}
+func (*EstimateFeeRateRequest) ProtoMessage() {}
 
-func (x *UpdatePsetResponse) ProtoReflect() protoreflect.Message {
+func (x *EstimateFeeRateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,34 +976,29 @@ func (x *UpdatePsetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePsetResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePsetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateFeeRateRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRateRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdatePsetResponse) GetPset() string {
+func (x *EstimateFeeRateRequest) GetConfTarget() uint32 {
 	if x != nil {
-		return x.Pset
+		return x.ConfTarget
 	}
-	return ""
+	return 0
 }
 
-type BlindPsetRequest struct {
+type EstimateFeeRateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The partial transaction with inputs/outputs to blind.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
-	// Whether blinding as last blinder.
-	LastBlinder bool `protobuf:"varint,2,opt,name=last_blinder,json=lastBlinder,proto3" json:"last_blinder,omitempty"`
-	// Optional list of unblinded data related to existing pset inputs in order
-	// to make the wallet blind also outputs it wouldn't own otherwise.
-	ExtraUnblindedInputs []*UnblindedInput `protobuf:"bytes,3,rep,name=extra_unblinded_inputs,json=extraUnblindedInputs,proto3" json:"extra_unblinded_inputs,omitempty"`
+	// Estimated mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,1,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
 }
 
-func (x *BlindPsetRequest) Reset() {
-	*x = BlindPsetRequest{}
+func (x *EstimateFeeRateResponse) Reset() {
+	*x = EstimateFeeRateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,13 +1006,13 @@ func (x *BlindPsetRequest) Reset() {
 	}
 }
 
-func (x *BlindPsetRequest) String() string {
+func (x *EstimateFeeRateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlindPsetRequest) ProtoMessage() {}
+func (*EstimateFeeRateResponse) ProtoMessage() {}
 
-func (x *BlindPsetRequest) ProtoReflect() protoreflect.Message {
+func (x *EstimateFeeRateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,43 +1024,34 @@ func (x *BlindPsetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlindPsetRequest.ProtoReflect.Descriptor instead.
-func (*BlindPsetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateFeeRateResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRateResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BlindPsetRequest) GetPset() string {
-	if x != nil {
-		return x.Pset
-	}
-	return ""
-}
-
-func (x *BlindPsetRequest) GetLastBlinder() bool {
-	if x != nil {
-		return x.LastBlinder
-	}
-	return false
-}
-
-func (x *BlindPsetRequest) GetExtraUnblindedInputs() []*UnblindedInput {
+func (x *EstimateFeeRateResponse) GetMillisatsPerByte() uint64 {
 	if x != nil {
-		return x.ExtraUnblindedInputs
+		return x.MillisatsPerByte
 	}
-	return nil
+	return 0
 }
 
-type BlindPsetResponse struct {
+type SignTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Updated partial transaction with blinded inputs/outputs in base64 format.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Raw transaction to sign.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// The sighash type. SIGHASH_ALL is used if not defined.
+	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Whether to sign the transaction even if its fee rate exceeds the sanity
+	// ceiling the daemon is configured with.
+	OverrideFeeGuard bool `protobuf:"varint,3,opt,name=override_fee_guard,json=overrideFeeGuard,proto3" json:"override_fee_guard,omitempty"`
 }
 
-func (x *BlindPsetResponse) Reset() {
-	*x = BlindPsetResponse{}
+func (x *SignTransactionRequest) Reset() {
+	*x = SignTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,13 +1059,13 @@ func (x *BlindPsetResponse) Reset() {
 	}
 }
 
-func (x *BlindPsetResponse) String() string {
+func (x *SignTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlindPsetResponse) ProtoMessage() {}
+func (*SignTransactionRequest) ProtoMessage() {}
 
-func (x *BlindPsetResponse) ProtoReflect() protoreflect.Message {
+func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,32 +1077,43 @@ func (x *BlindPsetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlindPsetResponse.ProtoReflect.Descriptor instead.
-func (*BlindPsetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *BlindPsetResponse) GetPset() string {
+func (x *SignTransactionRequest) GetTxHex() string {
 	if x != nil {
-		return x.Pset
+		return x.TxHex
 	}
 	return ""
 }
 
-type SignPsetRequest struct {
+func (x *SignTransactionRequest) GetSighashType() uint32 {
+	if x != nil {
+		return x.SighashType
+	}
+	return 0
+}
+
+func (x *SignTransactionRequest) GetOverrideFeeGuard() bool {
+	if x != nil {
+		return x.OverrideFeeGuard
+	}
+	return false
+}
+
+type SignTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The partial transaction to sign in base64 format.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
-	// The sighash type. If not specified, SIGHASH_ALL is used for any input
-	// to sign that doesn't already have one set.
-	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Raw signed transaction.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
 }
 
-func (x *SignPsetRequest) Reset() {
-	*x = SignPsetRequest{}
+func (x *SignTransactionResponse) Reset() {
+	*x = SignTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1083,13 +1121,13 @@ func (x *SignPsetRequest) Reset() {
 	}
 }
 
-func (x *SignPsetRequest) String() string {
+func (x *SignTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignPsetRequest) ProtoMessage() {}
+func (*SignTransactionResponse) ProtoMessage() {}
 
-func (x *SignPsetRequest) ProtoReflect() protoreflect.Message {
+func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,36 +1139,29 @@ func (x *SignPsetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignPsetRequest.ProtoReflect.Descriptor instead.
-func (*SignPsetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SignPsetRequest) GetPset() string {
+func (x *SignTransactionResponse) GetTxHex() string {
 	if x != nil {
-		return x.Pset
+		return x.TxHex
 	}
 	return ""
 }
 
-func (x *SignPsetRequest) GetSighashType() uint32 {
-	if x != nil {
-		return x.SighashType
-	}
-	return 0
-}
-
-type SignPsetResponse struct {
+type BroadcastTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed partial transaction in base64 format.
-	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Transaction to broadcast.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
 }
 
-func (x *SignPsetResponse) Reset() {
-	*x = SignPsetResponse{}
+func (x *BroadcastTransactionRequest) Reset() {
+	*x = BroadcastTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1138,13 +1169,13 @@ func (x *SignPsetResponse) Reset() {
 	}
 }
 
-func (x *SignPsetResponse) String() string {
+func (x *BroadcastTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignPsetResponse) ProtoMessage() {}
+func (*BroadcastTransactionRequest) ProtoMessage() {}
 
-func (x *SignPsetResponse) ProtoReflect() protoreflect.Message {
+func (x *BroadcastTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1156,41 +1187,29 @@ func (x *SignPsetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignPsetResponse.ProtoReflect.Descriptor instead.
-func (*SignPsetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastTransactionRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SignPsetResponse) GetPset() string {
+func (x *BroadcastTransactionRequest) GetTxHex() string {
 	if x != nil {
-		return x.Pset
+		return x.TxHex
 	}
 	return ""
 }
 
-type MintRequest struct {
+type BroadcastTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account name.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// Asset amount to mint.
-	AssetAmount uint64 `protobuf:"varint,2,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
-	// Token amount to mint.
-	TokenAmount uint64 `protobuf:"varint,3,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"`
-	// Name of the asset.
-	AssetName string `protobuf:"bytes,4,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
-	// Ticker of the asset.
-	AssetTicker string `protobuf:"bytes,5,opt,name=asset_ticker,json=assetTicker,proto3" json:"asset_ticker,omitempty"`
-	// Domain of the asset.
-	AssetDomain string `protobuf:"bytes,6,opt,name=asset_domain,json=assetDomain,proto3" json:"asset_domain,omitempty"`
-	// mSats/byte fee ratio.
-	MillisatsPerByte uint64 `protobuf:"varint,7,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Hash of the broadcasted transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (x *MintRequest) Reset() {
-	*x = MintRequest{}
+func (x *BroadcastTransactionResponse) Reset() {
+	*x = BroadcastTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ocean_v1_transaction_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1198,13 +1217,13 @@ func (x *MintRequest) Reset() {
 	}
 }
 
-func (x *MintRequest) String() string {
+func (x *BroadcastTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MintRequest) ProtoMessage() {}
+func (*BroadcastTransactionResponse) ProtoMessage() {}
 
-func (x *MintRequest) ProtoReflect() protoreflect.Message {
+func (x *BroadcastTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ocean_v1_transaction_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1216,86 +1235,111 @@ func (x *MintRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MintRequest.ProtoReflect.Descriptor instead.
-func (*MintRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastTransactionResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MintRequest) GetAccountName() string {
+func (x *BroadcastTransactionResponse) GetTxid() string {
 	if x != nil {
-		return x.AccountName
+		return x.Txid
 	}
 	return ""
 }
 
-func (x *MintRequest) GetAssetAmount() uint64 {
-	if x != nil {
-		return x.AssetAmount
-	}
-	return 0
+type CreatePsetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Inputs of the partial transaction.
+	Inputs []*Input `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Outputs of the partial transaction
+	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Optional locktime of the partial transaction, either a block height or a
+	// timestamp.
+	Locktime uint32 `protobuf:"varint,3,opt,name=locktime,proto3" json:"locktime,omitempty"`
 }
 
-func (x *MintRequest) GetTokenAmount() uint64 {
-	if x != nil {
-		return x.TokenAmount
+func (x *CreatePsetRequest) Reset() {
+	*x = CreatePsetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MintRequest) GetAssetName() string {
-	if x != nil {
-		return x.AssetName
+func (x *CreatePsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePsetRequest) ProtoMessage() {}
+
+func (x *CreatePsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MintRequest) GetAssetTicker() string {
+// Deprecated: Use CreatePsetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePsetRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreatePsetRequest) GetInputs() []*Input {
 	if x != nil {
-		return x.AssetTicker
+		return x.Inputs
 	}
-	return ""
+	return nil
 }
 
-func (x *MintRequest) GetAssetDomain() string {
+func (x *CreatePsetRequest) GetOutputs() []*Output {
 	if x != nil {
-		return x.AssetDomain
+		return x.Outputs
 	}
-	return ""
+	return nil
 }
 
-func (x *MintRequest) GetMillisatsPerByte() uint64 {
+func (x *CreatePsetRequest) GetLocktime() uint32 {
 	if x != nil {
-		return x.MillisatsPerByte
+		return x.Locktime
 	}
 	return 0
 }
 
-type MintResponse struct {
+type CreatePsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed tx in hex format.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// New partial transaction in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
 }
 
-func (x *MintResponse) Reset() {
-	*x = MintResponse{}
+func (x *CreatePsetResponse) Reset() {
+	*x = CreatePsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[21]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MintResponse) String() string {
+func (x *CreatePsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MintResponse) ProtoMessage() {}
+func (*CreatePsetResponse) ProtoMessage() {}
 
-func (x *MintResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[21]
+func (x *CreatePsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,50 +1350,51 @@ func (x *MintResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MintResponse.ProtoReflect.Descriptor instead.
-func (*MintResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreatePsetResponse.ProtoReflect.Descriptor instead.
+func (*CreatePsetResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MintResponse) GetTxHex() string {
+func (x *CreatePsetResponse) GetPset() string {
 	if x != nil {
-		return x.TxHex
+		return x.Pset
 	}
 	return ""
 }
 
-type RemintRequest struct {
+type UpdatePsetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account name.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// Hash of the asset to remint.
-	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	// Amount to remint.
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// mSats/byte fee ratio.
-	MillisatsPerByte uint64 `protobuf:"varint,4,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// The partial transaction to update in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Inputs to add to the partial transaction.
+	Inputs []*Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Outputs to add to the partil transaction.
+	Outputs []*Output `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Optional locktime of the partial transaction, either a block height or a
+	// timestamp. It must match the one of the pset, if already set.
+	Locktime uint32 `protobuf:"varint,4,opt,name=locktime,proto3" json:"locktime,omitempty"`
 }
 
-func (x *RemintRequest) Reset() {
-	*x = RemintRequest{}
+func (x *UpdatePsetRequest) Reset() {
+	*x = UpdatePsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[22]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemintRequest) String() string {
+func (x *UpdatePsetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemintRequest) ProtoMessage() {}
+func (*UpdatePsetRequest) ProtoMessage() {}
 
-func (x *RemintRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[22]
+func (x *UpdatePsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,65 +1405,65 @@ func (x *RemintRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemintRequest.ProtoReflect.Descriptor instead.
-func (*RemintRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdatePsetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePsetRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RemintRequest) GetAccountName() string {
+func (x *UpdatePsetRequest) GetPset() string {
 	if x != nil {
-		return x.AccountName
+		return x.Pset
 	}
 	return ""
 }
 
-func (x *RemintRequest) GetAsset() string {
+func (x *UpdatePsetRequest) GetInputs() []*Input {
 	if x != nil {
-		return x.Asset
+		return x.Inputs
 	}
-	return ""
+	return nil
 }
 
-func (x *RemintRequest) GetAmount() uint64 {
+func (x *UpdatePsetRequest) GetOutputs() []*Output {
 	if x != nil {
-		return x.Amount
+		return x.Outputs
 	}
-	return 0
+	return nil
 }
 
-func (x *RemintRequest) GetMillisatsPerByte() uint64 {
+func (x *UpdatePsetRequest) GetLocktime() uint32 {
 	if x != nil {
-		return x.MillisatsPerByte
+		return x.Locktime
 	}
 	return 0
 }
 
-type RemintResponse struct {
+type UpdatePsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed tx in hex format.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Updated partial transaction in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
 }
 
-func (x *RemintResponse) Reset() {
-	*x = RemintResponse{}
+func (x *UpdatePsetResponse) Reset() {
+	*x = UpdatePsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[23]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemintResponse) String() string {
+func (x *UpdatePsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemintResponse) ProtoMessage() {}
+func (*UpdatePsetResponse) ProtoMessage() {}
 
-func (x *RemintResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[23]
+func (x *UpdatePsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,49 +1474,49 @@ func (x *RemintResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemintResponse.ProtoReflect.Descriptor instead.
-func (*RemintResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdatePsetResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePsetResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RemintResponse) GetTxHex() string {
+func (x *UpdatePsetResponse) GetPset() string {
 	if x != nil {
-		return x.TxHex
+		return x.Pset
 	}
 	return ""
 }
 
-type BurnRequest struct {
+type BlindPsetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account name.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// Receivers contains a list of asset-amount to burn and their addresses are
-	// ignored and replaced with OP_RETURN output scripts.
-	Receivers []*Output `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
-	// mSats/byte fee ratio.
-	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// The partial transaction with inputs/outputs to blind.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Whether blinding as last blinder.
+	LastBlinder bool `protobuf:"varint,2,opt,name=last_blinder,json=lastBlinder,proto3" json:"last_blinder,omitempty"`
+	// Optional list of unblinded data related to existing pset inputs in order
+	// to make the wallet blind also outputs it wouldn't own otherwise.
+	ExtraUnblindedInputs []*UnblindedInput `protobuf:"bytes,3,rep,name=extra_unblinded_inputs,json=extraUnblindedInputs,proto3" json:"extra_unblinded_inputs,omitempty"`
 }
 
-func (x *BurnRequest) Reset() {
-	*x = BurnRequest{}
+func (x *BlindPsetRequest) Reset() {
+	*x = BlindPsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[24]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BurnRequest) String() string {
+func (x *BlindPsetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BurnRequest) ProtoMessage() {}
+func (*BlindPsetRequest) ProtoMessage() {}
 
-func (x *BurnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[24]
+func (x *BlindPsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,58 +1527,58 @@ func (x *BurnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BurnRequest.ProtoReflect.Descriptor instead.
-func (*BurnRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use BlindPsetRequest.ProtoReflect.Descriptor instead.
+func (*BlindPsetRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *BurnRequest) GetAccountName() string {
+func (x *BlindPsetRequest) GetPset() string {
 	if x != nil {
-		return x.AccountName
+		return x.Pset
 	}
 	return ""
 }
 
-func (x *BurnRequest) GetReceivers() []*Output {
+func (x *BlindPsetRequest) GetLastBlinder() bool {
 	if x != nil {
-		return x.Receivers
+		return x.LastBlinder
 	}
-	return nil
+	return false
 }
 
-func (x *BurnRequest) GetMillisatsPerByte() uint64 {
+func (x *BlindPsetRequest) GetExtraUnblindedInputs() []*UnblindedInput {
 	if x != nil {
-		return x.MillisatsPerByte
+		return x.ExtraUnblindedInputs
 	}
-	return 0
+	return nil
 }
 
-type BurnResponse struct {
+type BlindPsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed tx in hex format.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Updated partial transaction with blinded inputs/outputs in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
 }
 
-func (x *BurnResponse) Reset() {
-	*x = BurnResponse{}
+func (x *BlindPsetResponse) Reset() {
+	*x = BlindPsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[25]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BurnResponse) String() string {
+func (x *BlindPsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BurnResponse) ProtoMessage() {}
+func (*BlindPsetResponse) ProtoMessage() {}
 
-func (x *BurnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[25]
+func (x *BlindPsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,48 +1589,50 @@ func (x *BurnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BurnResponse.ProtoReflect.Descriptor instead.
-func (*BurnResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use BlindPsetResponse.ProtoReflect.Descriptor instead.
+func (*BlindPsetResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *BurnResponse) GetTxHex() string {
+func (x *BlindPsetResponse) GetPset() string {
 	if x != nil {
-		return x.TxHex
+		return x.Pset
 	}
 	return ""
 }
 
-type TransferRequest struct {
+type SignPsetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account name.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// Receivers are the receivers of the funds.
-	Receivers []*Output `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
-	// mSats/byte fee ratio.
-	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// The partial transaction to sign in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// The sighash type. If not specified, SIGHASH_ALL is used for any input
+	// to sign that doesn't already have one set.
+	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Whether to sign the partial transaction even if its fee rate exceeds the
+	// sanity ceiling the daemon is configured with.
+	OverrideFeeGuard bool `protobuf:"varint,3,opt,name=override_fee_guard,json=overrideFeeGuard,proto3" json:"override_fee_guard,omitempty"`
 }
 
-func (x *TransferRequest) Reset() {
-	*x = TransferRequest{}
+func (x *SignPsetRequest) Reset() {
+	*x = SignPsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[26]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferRequest) String() string {
+func (x *SignPsetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferRequest) ProtoMessage() {}
+func (*SignPsetRequest) ProtoMessage() {}
 
-func (x *TransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[26]
+func (x *SignPsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,58 +1643,58 @@ func (x *TransferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
-func (*TransferRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SignPsetRequest.ProtoReflect.Descriptor instead.
+func (*SignPsetRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TransferRequest) GetAccountName() string {
+func (x *SignPsetRequest) GetPset() string {
 	if x != nil {
-		return x.AccountName
+		return x.Pset
 	}
 	return ""
 }
 
-func (x *TransferRequest) GetReceivers() []*Output {
+func (x *SignPsetRequest) GetSighashType() uint32 {
 	if x != nil {
-		return x.Receivers
+		return x.SighashType
 	}
-	return nil
+	return 0
 }
 
-func (x *TransferRequest) GetMillisatsPerByte() uint64 {
+func (x *SignPsetRequest) GetOverrideFeeGuard() bool {
 	if x != nil {
-		return x.MillisatsPerByte
+		return x.OverrideFeeGuard
 	}
-	return 0
+	return false
 }
 
-type TransferResponse struct {
+type SignPsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed tx in hex format.
-	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Signed partial transaction in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
 }
 
-func (x *TransferResponse) Reset() {
-	*x = TransferResponse{}
+func (x *SignPsetResponse) Reset() {
+	*x = SignPsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[27]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferResponse) String() string {
+func (x *SignPsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferResponse) ProtoMessage() {}
+func (*SignPsetResponse) ProtoMessage() {}
 
-func (x *TransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[27]
+func (x *SignPsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,41 +1705,44 @@ func (x *TransferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
-func (*TransferResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SignPsetResponse.ProtoReflect.Descriptor instead.
+func (*SignPsetResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TransferResponse) GetTxHex() string {
+func (x *SignPsetResponse) GetPset() string {
 	if x != nil {
-		return x.TxHex
+		return x.Pset
 	}
 	return ""
 }
 
-type PegInAddressRequest struct {
+type DecodePsetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The partial transaction to decode in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
 }
 
-func (x *PegInAddressRequest) Reset() {
-	*x = PegInAddressRequest{}
+func (x *DecodePsetRequest) Reset() {
+	*x = DecodePsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[28]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PegInAddressRequest) String() string {
+func (x *DecodePsetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PegInAddressRequest) ProtoMessage() {}
+func (*DecodePsetRequest) ProtoMessage() {}
 
-func (x *PegInAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[28]
+func (x *DecodePsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,41 +1753,52 @@ func (x *PegInAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PegInAddressRequest.ProtoReflect.Descriptor instead.
-func (*PegInAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DecodePsetRequest.ProtoReflect.Descriptor instead.
+func (*DecodePsetRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{29}
 }
 
-type PegInAddressResponse struct {
+func (x *DecodePsetRequest) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+type DecodePsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Account name.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// Main-chain deposit address to send bitcoin to.
-	MainChainAddress string `protobuf:"bytes,2,opt,name=main_chain_address,json=mainChainAddress,proto3" json:"main_chain_address,omitempty"`
-	// Claim script committed to by the main-chain address.
-	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+	// List of decoded inputs.
+	Inputs []*DecodedInput `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// List of decoded outputs.
+	Outputs []*DecodedOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Net change of the balance of the accounts involved, by asset.
+	NetAmounts []*AccountNetAmounts `protobuf:"bytes,3,rep,name=net_amounts,json=netAmounts,proto3" json:"net_amounts,omitempty"`
+	// Total amount of the fee outputs.
+	FeeAmount uint64 `protobuf:"varint,4,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	// Whether all the outputs to blind are blinded.
+	BlindingComplete bool `protobuf:"varint,5,opt,name=blinding_complete,json=blindingComplete,proto3" json:"blinding_complete,omitempty"`
 }
 
-func (x *PegInAddressResponse) Reset() {
-	*x = PegInAddressResponse{}
+func (x *DecodePsetResponse) Reset() {
+	*x = DecodePsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[29]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PegInAddressResponse) String() string {
+func (x *DecodePsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PegInAddressResponse) ProtoMessage() {}
+func (*DecodePsetResponse) ProtoMessage() {}
 
-func (x *PegInAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[29]
+func (x *DecodePsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,62 +1809,72 @@ func (x *PegInAddressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PegInAddressResponse.ProtoReflect.Descriptor instead.
-func (*PegInAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DecodePsetResponse.ProtoReflect.Descriptor instead.
+func (*DecodePsetResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *PegInAddressResponse) GetAccountName() string {
+func (x *DecodePsetResponse) GetInputs() []*DecodedInput {
 	if x != nil {
-		return x.AccountName
+		return x.Inputs
 	}
-	return ""
+	return nil
 }
 
-func (x *PegInAddressResponse) GetMainChainAddress() string {
+func (x *DecodePsetResponse) GetOutputs() []*DecodedOutput {
 	if x != nil {
-		return x.MainChainAddress
+		return x.Outputs
 	}
-	return ""
+	return nil
 }
 
-func (x *PegInAddressResponse) GetClaimScript() string {
+func (x *DecodePsetResponse) GetNetAmounts() []*AccountNetAmounts {
 	if x != nil {
-		return x.ClaimScript
+		return x.NetAmounts
 	}
-	return ""
+	return nil
 }
 
-type ClaimPegInRequest struct {
+func (x *DecodePsetResponse) GetFeeAmount() uint64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *DecodePsetResponse) GetBlindingComplete() bool {
+	if x != nil {
+		return x.BlindingComplete
+	}
+	return false
+}
+
+type CombinePsetsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The raw bitcoin transaction (in hex) depositing bitcoin to the main-chain address generated by PegInAddress.
-	BitcoinTx string `protobuf:"bytes,1,opt,name=bitcoin_tx,json=bitcoinTx,proto3" json:"bitcoin_tx,omitempty"`
-	// A raw tx_out_proof (in hex) generated by the main-chain daemon's `gettxoutproof` containing a proof of only bitcoin_tx.
-	TxOutProof string `protobuf:"bytes,2,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
-	// The witness program generated by PegInAddress.
-	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+	// List of copies of the same partial transaction in base64 format.
+	Psets []string `protobuf:"bytes,1,rep,name=psets,proto3" json:"psets,omitempty"`
 }
 
-func (x *ClaimPegInRequest) Reset() {
-	*x = ClaimPegInRequest{}
+func (x *CombinePsetsRequest) Reset() {
+	*x = CombinePsetsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[30]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClaimPegInRequest) String() string {
+func (x *CombinePsetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimPegInRequest) ProtoMessage() {}
+func (*CombinePsetsRequest) ProtoMessage() {}
 
-func (x *ClaimPegInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[30]
+func (x *CombinePsetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,58 +1885,142 @@ func (x *ClaimPegInRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimPegInRequest.ProtoReflect.Descriptor instead.
-func (*ClaimPegInRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CombinePsetsRequest.ProtoReflect.Descriptor instead.
+func (*CombinePsetsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ClaimPegInRequest) GetBitcoinTx() string {
+func (x *CombinePsetsRequest) GetPsets() []string {
 	if x != nil {
-		return x.BitcoinTx
+		return x.Psets
 	}
-	return ""
+	return nil
 }
 
-func (x *ClaimPegInRequest) GetTxOutProof() string {
+type CombinePsetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Combined partial transaction in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+}
+
+func (x *CombinePsetsResponse) Reset() {
+	*x = CombinePsetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombinePsetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombinePsetsResponse) ProtoMessage() {}
+
+func (x *CombinePsetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombinePsetsResponse.ProtoReflect.Descriptor instead.
+func (*CombinePsetsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CombinePsetsResponse) GetPset() string {
 	if x != nil {
-		return x.TxOutProof
+		return x.Pset
 	}
 	return ""
 }
 
-func (x *ClaimPegInRequest) GetClaimScript() string {
+type FinalizePsetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The partial transaction to finalize in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+}
+
+func (x *FinalizePsetRequest) Reset() {
+	*x = FinalizePsetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalizePsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizePsetRequest) ProtoMessage() {}
+
+func (x *FinalizePsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizePsetRequest.ProtoReflect.Descriptor instead.
+func (*FinalizePsetRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FinalizePsetRequest) GetPset() string {
 	if x != nil {
-		return x.ClaimScript
+		return x.Pset
 	}
 	return ""
 }
 
-type ClaimPegInResponse struct {
+type FinalizePsetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Signed tx in hex format.
+	// Finalized raw transaction in hex format.
 	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Hash of the transaction.
+	Txid string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (x *ClaimPegInResponse) Reset() {
-	*x = ClaimPegInResponse{}
+func (x *FinalizePsetResponse) Reset() {
+	*x = FinalizePsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[31]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClaimPegInResponse) String() string {
+func (x *FinalizePsetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimPegInResponse) ProtoMessage() {}
+func (*FinalizePsetResponse) ProtoMessage() {}
 
-func (x *ClaimPegInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[31]
+func (x *FinalizePsetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1876,541 +2031,5935 @@ func (x *ClaimPegInResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimPegInResponse.ProtoReflect.Descriptor instead.
-func (*ClaimPegInResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use FinalizePsetResponse.ProtoReflect.Descriptor instead.
+func (*FinalizePsetResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ClaimPegInResponse) GetTxHex() string {
+func (x *FinalizePsetResponse) GetTxHex() string {
 	if x != nil {
 		return x.TxHex
 	}
 	return ""
 }
 
-type SignPsetWithSchnorrKeyRequest struct {
+func (x *FinalizePsetResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type DecodedInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The partial transaction to sign in base64 format.
-	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// The sighash type. SIGHASH_DEFAULT is used for any input that does not specify one.
-	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Previous output txid.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// Previous tx output index.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Account owning the input, if any.
+	AccountName string `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset of the input, if revealed.
+	Asset string `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Amount of the input, if revealed.
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the spent output is confidential.
+	Confidential bool `protobuf:"varint,6,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	// Sighash type of the input, if set.
+	SighashType uint32 `protobuf:"varint,7,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Whether the input has at least one signature.
+	Signed bool `protobuf:"varint,8,opt,name=signed,proto3" json:"signed,omitempty"`
+	// Whether the input is finalized.
+	Finalized bool `protobuf:"varint,9,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (x *DecodedInput) Reset() {
+	*x = DecodedInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *SignPsetWithSchnorrKeyRequest) Reset() {
-	*x = SignPsetWithSchnorrKeyRequest{}
+func (x *DecodedInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedInput) ProtoMessage() {}
+
+func (x *DecodedInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedInput.ProtoReflect.Descriptor instead.
+func (*DecodedInput) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DecodedInput) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *DecodedInput) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DecodedInput) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DecodedInput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DecodedInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DecodedInput) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *DecodedInput) GetSighashType() uint32 {
+	if x != nil {
+		return x.SighashType
+	}
+	return 0
+}
+
+func (x *DecodedInput) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+func (x *DecodedInput) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
+}
+
+type DecodedOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output script in hex format.
+	Script string `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// Account owning the output, if any.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset of the output, if revealed.
+	Asset string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Amount of the output, if revealed.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the output is to blind.
+	Confidential bool `protobuf:"varint,5,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	// Whether the output is blinded.
+	Blinded bool `protobuf:"varint,6,opt,name=blinded,proto3" json:"blinded,omitempty"`
+}
+
+func (x *DecodedOutput) Reset() {
+	*x = DecodedOutput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[32]
+		mi := &file_ocean_v1_transaction_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignPsetWithSchnorrKeyRequest) String() string {
+func (x *DecodedOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignPsetWithSchnorrKeyRequest) ProtoMessage() {}
+func (*DecodedOutput) ProtoMessage() {}
 
-func (x *SignPsetWithSchnorrKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[32]
+func (x *DecodedOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SignPsetWithSchnorrKeyRequest.ProtoReflect.Descriptor instead.
-func (*SignPsetWithSchnorrKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *SignPsetWithSchnorrKeyRequest) GetTx() string {
-	if x != nil {
-		return x.Tx
-	}
-	return ""
-}
-
-func (x *SignPsetWithSchnorrKeyRequest) GetSighashType() uint32 {
-	if x != nil {
-		return x.SighashType
-	}
-	return 0
-}
-
-type SignPsetWithSchnorrKeyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SignedTx string `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
-}
-
-func (x *SignPsetWithSchnorrKeyResponse) Reset() {
-	*x = SignPsetWithSchnorrKeyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ocean_v1_transaction_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SignPsetWithSchnorrKeyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SignPsetWithSchnorrKeyResponse) ProtoMessage() {}
-
-func (x *SignPsetWithSchnorrKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ocean_v1_transaction_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedOutput.ProtoReflect.Descriptor instead.
+func (*DecodedOutput) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DecodedOutput) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *DecodedOutput) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DecodedOutput) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DecodedOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DecodedOutput) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *DecodedOutput) GetBlinded() bool {
+	if x != nil {
+		return x.Blinded
+	}
+	return false
+}
+
+type AccountNetAmounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Net amounts by asset, negative if the balance decreases.
+	Amounts map[string]int64 `protobuf:"bytes,2,rep,name=amounts,proto3" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *AccountNetAmounts) Reset() {
+	*x = AccountNetAmounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountNetAmounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountNetAmounts) ProtoMessage() {}
+
+func (x *AccountNetAmounts) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountNetAmounts.ProtoReflect.Descriptor instead.
+func (*AccountNetAmounts) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AccountNetAmounts) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountNetAmounts) GetAmounts() map[string]int64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type MintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset amount to mint.
+	AssetAmount uint64 `protobuf:"varint,2,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+	// Token amount to mint.
+	TokenAmount uint64 `protobuf:"varint,3,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"`
+	// Name of the asset.
+	AssetName string `protobuf:"bytes,4,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// Ticker of the asset.
+	AssetTicker string `protobuf:"bytes,5,opt,name=asset_ticker,json=assetTicker,proto3" json:"asset_ticker,omitempty"`
+	// Domain of the asset.
+	AssetDomain string `protobuf:"bytes,6,opt,name=asset_domain,json=assetDomain,proto3" json:"asset_domain,omitempty"`
+	// mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,7,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *MintRequest) Reset() {
+	*x = MintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintRequest) ProtoMessage() {}
+
+func (x *MintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintRequest.ProtoReflect.Descriptor instead.
+func (*MintRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MintRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *MintRequest) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+func (x *MintRequest) GetTokenAmount() uint64 {
+	if x != nil {
+		return x.TokenAmount
+	}
+	return 0
+}
+
+func (x *MintRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *MintRequest) GetAssetTicker() string {
+	if x != nil {
+		return x.AssetTicker
+	}
+	return ""
+}
+
+func (x *MintRequest) GetAssetDomain() string {
+	if x != nil {
+		return x.AssetDomain
+	}
+	return ""
+}
+
+func (x *MintRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type MintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *MintResponse) Reset() {
+	*x = MintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintResponse) ProtoMessage() {}
+
+func (x *MintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintResponse.ProtoReflect.Descriptor instead.
+func (*MintResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MintResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type RemintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Hash of the asset to remint.
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Amount to remint.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,4,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *RemintRequest) Reset() {
+	*x = RemintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemintRequest) ProtoMessage() {}
+
+func (x *RemintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemintRequest.ProtoReflect.Descriptor instead.
+func (*RemintRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RemintRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *RemintRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *RemintRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RemintRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type RemintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *RemintResponse) Reset() {
+	*x = RemintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemintResponse) ProtoMessage() {}
+
+func (x *RemintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemintResponse.ProtoReflect.Descriptor instead.
+func (*RemintResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemintResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type BurnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Receivers contains a list of asset-amount to burn and their addresses are
+	// ignored and replaced with OP_RETURN output scripts.
+	Receivers []*Output `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// mSats/byte fee ratio.
+	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *BurnRequest) Reset() {
+	*x = BurnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnRequest) ProtoMessage() {}
+
+func (x *BurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnRequest.ProtoReflect.Descriptor instead.
+func (*BurnRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BurnRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BurnRequest) GetReceivers() []*Output {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *BurnRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type BurnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *BurnResponse) Reset() {
+	*x = BurnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnResponse) ProtoMessage() {}
+
+func (x *BurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnResponse.ProtoReflect.Descriptor instead.
+func (*BurnResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BurnResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type TransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Receivers are the receivers of the funds.
+	Receivers []*Output `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// mSats/byte fee ratio. If not specified, it is estimated by the daemon.
+	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Minimum number of confirmations for a utxo to be selected. If not
+	// specified, the value the daemon is configured with is used. Unconfirmed
+	// utxos are never selected, therefore 0 is the same as 1.
+	MinConfirmations *uint32 `protobuf:"varint,4,opt,name=min_confirmations,json=minConfirmations,proto3,oneof" json:"min_confirmations,omitempty"`
+	// Utxos to spend. If specified, all of them are spent, otherwise the utxos
+	// are selected by the daemon.
+	Inputs []*Input `protobuf:"bytes,5,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Whether other utxos can be selected in case the given inputs are not
+	// enough to cover the amount to send and fees.
+	AllowExtraInputs bool `protobuf:"varint,6,opt,name=allow_extra_inputs,json=allowExtraInputs,proto3" json:"allow_extra_inputs,omitempty"`
+	// Whether to send the whole spendable balance of the receivers' assets, in
+	// which case the receivers' amounts are ignored and there must be at most
+	// one receiver per asset. The fees are subtracted from the LBTC receiver or,
+	// if there is none, paid with other LBTC funds.
+	SendAll bool `protobuf:"varint,7,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	// Whether to subtract the fees from the LBTC receivers' amounts, equally
+	// split among them, instead of paying them with other funds.
+	SubtractFee bool `protobuf:"varint,8,opt,name=subtract_fee,json=subtractFee,proto3" json:"subtract_fee,omitempty"`
+	// Whether to only preview the transfer. If so, the response doesn't contain
+	// the signed tx but the unsigned pset and the details about it, and neither
+	// utxos are locked nor change addresses are derived.
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Whether to lock the utxos selected by the dry-run so that they can be
+	// spent by a later transfer referencing the returned reservation.
+	Reserve bool `protobuf:"varint,10,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	// Lease id of a reservation returned by a previous dry-run. If specified,
+	// the reserved utxos are spent in addition to the given inputs.
+	LeaseId string `protobuf:"bytes,11,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Optional locktime of the tx, either a block height or a timestamp.
+	Locktime uint32 `protobuf:"varint,12,opt,name=locktime,proto3" json:"locktime,omitempty"`
+	// Whether to sign the transaction even if its fee rate exceeds the sanity
+	// ceiling the daemon is configured with.
+	OverrideFeeGuard bool `protobuf:"varint,13,opt,name=override_fee_guard,json=overrideFeeGuard,proto3" json:"override_fee_guard,omitempty"`
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransferRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetReceivers() []*Output {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *TransferRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetMinConfirmations() uint32 {
+	if x != nil && x.MinConfirmations != nil {
+		return *x.MinConfirmations
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetInputs() []*Input {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *TransferRequest) GetAllowExtraInputs() bool {
+	if x != nil {
+		return x.AllowExtraInputs
+	}
+	return false
+}
+
+func (x *TransferRequest) GetSendAll() bool {
+	if x != nil {
+		return x.SendAll
+	}
+	return false
+}
+
+func (x *TransferRequest) GetSubtractFee() bool {
+	if x != nil {
+		return x.SubtractFee
+	}
+	return false
+}
+
+func (x *TransferRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *TransferRequest) GetReserve() bool {
+	if x != nil {
+		return x.Reserve
+	}
+	return false
+}
+
+func (x *TransferRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetLocktime() uint32 {
+	if x != nil {
+		return x.Locktime
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetOverrideFeeGuard() bool {
+	if x != nil {
+		return x.OverrideFeeGuard
+	}
+	return false
+}
+
+type TransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format. Empty in case of dry-run.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	// Unsigned and unblinded pset in base64 format. Set only in case of dry-run.
+	Pset string `protobuf:"bytes,2,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Utxos selected to be spent. Set only in case of dry-run.
+	Inputs []*Utxo `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Change outputs. Set only in case of dry-run.
+	ChangeOutputs []*Output `protobuf:"bytes,4,rep,name=change_outputs,json=changeOutputs,proto3" json:"change_outputs,omitempty"`
+	// Fee amount paid by the tx. Set only in case of dry-run.
+	FeeAmount uint64 `protobuf:"varint,5,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	// Lease locking the selected utxos. Set only in case of dry-run with
+	// reservation.
+	Reservation *Lease `protobuf:"bytes,6,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TransferResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+func (x *TransferResponse) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+func (x *TransferResponse) GetInputs() []*Utxo {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetChangeOutputs() []*Output {
+	if x != nil {
+		return x.ChangeOutputs
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetFeeAmount() uint64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetReservation() *Lease {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type BumpFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the transaction to replace.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The new mSats/byte fee ratio, must be higher than the one of the replaced
+	// transaction. Estimated if not specified.
+	MillisatsPerByte uint64 `protobuf:"varint,2,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *BumpFeeRequest) Reset() {
+	*x = BumpFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeRequest) ProtoMessage() {}
+
+func (x *BumpFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BumpFeeRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *BumpFeeRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type BumpFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the replacement transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *BumpFeeResponse) Reset() {
+	*x = BumpFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeResponse) ProtoMessage() {}
+
+func (x *BumpFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BumpFeeResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type AccelerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the transaction to accelerate.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The target mSats/byte fee ratio of the package made of the parent and
+	// child transactions, must be higher than the one of the parent.
+	// Estimated if not specified.
+	MillisatsPerByte uint64 `protobuf:"varint,2,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *AccelerateRequest) Reset() {
+	*x = AccelerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccelerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccelerateRequest) ProtoMessage() {}
+
+func (x *AccelerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccelerateRequest.ProtoReflect.Descriptor instead.
+func (*AccelerateRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AccelerateRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *AccelerateRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type AccelerateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the child transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *AccelerateResponse) Reset() {
+	*x = AccelerateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccelerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccelerateResponse) ProtoMessage() {}
+
+func (x *AccelerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccelerateResponse.ProtoReflect.Descriptor instead.
+func (*AccelerateResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AccelerateResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type MoveFundsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the account sending the funds.
+	FromAccount string `protobuf:"bytes,1,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	// Name of the account receiving the funds.
+	ToAccount string `protobuf:"bytes,2,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	// Asset to move.
+	Asset string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Amount to move.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional fee rate in millisats per byte.
+	MillisatsPerByte uint64 `protobuf:"varint,5,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *MoveFundsRequest) Reset() {
+	*x = MoveFundsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFundsRequest) ProtoMessage() {}
+
+func (x *MoveFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFundsRequest.ProtoReflect.Descriptor instead.
+func (*MoveFundsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MoveFundsRequest) GetFromAccount() string {
+	if x != nil {
+		return x.FromAccount
+	}
+	return ""
+}
+
+func (x *MoveFundsRequest) GetToAccount() string {
+	if x != nil {
+		return x.ToAccount
+	}
+	return ""
+}
+
+func (x *MoveFundsRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *MoveFundsRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MoveFundsRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type MoveFundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *MoveFundsResponse) Reset() {
+	*x = MoveFundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFundsResponse) ProtoMessage() {}
+
+func (x *MoveFundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFundsResponse.ProtoReflect.Descriptor instead.
+func (*MoveFundsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MoveFundsResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type FragmentUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset of the balance to split.
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Number of fragments. If not specified, the balance is split into as many
+	// fragments of the given amount as possible.
+	NumFragments uint32 `protobuf:"varint,3,opt,name=num_fragments,json=numFragments,proto3" json:"num_fragments,omitempty"`
+	// Amount of every fragment. If not specified, the balance is split into the
+	// given number of geometric buckets, each holding half the amount of the
+	// previous one.
+	FragmentAmount uint64 `protobuf:"varint,4,opt,name=fragment_amount,json=fragmentAmount,proto3" json:"fragment_amount,omitempty"`
+	// mSats/byte fee ratio. If not specified, it is estimated by the daemon.
+	MillisatsPerByte uint64 `protobuf:"varint,5,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Minimum number of confirmations for a utxo to be selected. If not
+	// specified, the value the daemon is configured with is used. Unconfirmed
+	// utxos are never selected, therefore 0 is the same as 1.
+	MinConfirmations *uint32 `protobuf:"varint,6,opt,name=min_confirmations,json=minConfirmations,proto3,oneof" json:"min_confirmations,omitempty"`
+}
+
+func (x *FragmentUtxosRequest) Reset() {
+	*x = FragmentUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FragmentUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FragmentUtxosRequest) ProtoMessage() {}
+
+func (x *FragmentUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FragmentUtxosRequest.ProtoReflect.Descriptor instead.
+func (*FragmentUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FragmentUtxosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *FragmentUtxosRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FragmentUtxosRequest) GetNumFragments() uint32 {
+	if x != nil {
+		return x.NumFragments
+	}
+	return 0
+}
+
+func (x *FragmentUtxosRequest) GetFragmentAmount() uint64 {
+	if x != nil {
+		return x.FragmentAmount
+	}
+	return 0
+}
+
+func (x *FragmentUtxosRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+func (x *FragmentUtxosRequest) GetMinConfirmations() uint32 {
+	if x != nil && x.MinConfirmations != nil {
+		return *x.MinConfirmations
+	}
+	return 0
+}
+
+type FragmentUtxosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *FragmentUtxosResponse) Reset() {
+	*x = FragmentUtxosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FragmentUtxosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FragmentUtxosResponse) ProtoMessage() {}
+
+func (x *FragmentUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FragmentUtxosResponse.ProtoReflect.Descriptor instead.
+func (*FragmentUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FragmentUtxosResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type ConsolidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset of the utxos to consolidate.
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Max number of utxos to consolidate.
+	MaxInputs uint32 `protobuf:"varint,3,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`
+	// Max value of a utxo to be consolidated. If not specified, any utxo can be
+	// consolidated.
+	MaxUtxoValue uint64 `protobuf:"varint,4,opt,name=max_utxo_value,json=maxUtxoValue,proto3" json:"max_utxo_value,omitempty"`
+	// mSats/byte fee ratio. If not specified, it is estimated by the daemon.
+	MillisatsPerByte uint64 `protobuf:"varint,5,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// mSats/byte fee ratio ceiling, the consolidation is refused if the fee ratio
+	// is above it. If not specified, there's no ceiling.
+	MaxMillisatsPerByte uint64 `protobuf:"varint,6,opt,name=max_millisats_per_byte,json=maxMillisatsPerByte,proto3" json:"max_millisats_per_byte,omitempty"`
+}
+
+func (x *ConsolidateRequest) Reset() {
+	*x = ConsolidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidateRequest) ProtoMessage() {}
+
+func (x *ConsolidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidateRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidateRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ConsolidateRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ConsolidateRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ConsolidateRequest) GetMaxInputs() uint32 {
+	if x != nil {
+		return x.MaxInputs
+	}
+	return 0
+}
+
+func (x *ConsolidateRequest) GetMaxUtxoValue() uint64 {
+	if x != nil {
+		return x.MaxUtxoValue
+	}
+	return 0
+}
+
+func (x *ConsolidateRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+func (x *ConsolidateRequest) GetMaxMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MaxMillisatsPerByte
+	}
+	return 0
+}
+
+type ConsolidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *ConsolidateResponse) Reset() {
+	*x = ConsolidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidateResponse) ProtoMessage() {}
+
+func (x *ConsolidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidateResponse.ProtoReflect.Descriptor instead.
+func (*ConsolidateResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ConsolidateResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type EnqueuePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Receiver of the payment.
+	Receiver *Output `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (x *EnqueuePaymentRequest) Reset() {
+	*x = EnqueuePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueuePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePaymentRequest) ProtoMessage() {}
+
+func (x *EnqueuePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePaymentRequest.ProtoReflect.Descriptor instead.
+func (*EnqueuePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EnqueuePaymentRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *EnqueuePaymentRequest) GetReceiver() *Output {
+	if x != nil {
+		return x.Receiver
+	}
+	return nil
+}
+
+type EnqueuePaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the queued payment.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EnqueuePaymentResponse) Reset() {
+	*x = EnqueuePaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueuePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePaymentResponse) ProtoMessage() {}
+
+func (x *EnqueuePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePaymentResponse.ProtoReflect.Descriptor instead.
+func (*EnqueuePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EnqueuePaymentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the payment to cancel.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelPaymentRequest) Reset() {
+	*x = CancelPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentRequest) ProtoMessage() {}
+
+func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CancelPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelPaymentResponse) Reset() {
+	*x = CancelPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentResponse) ProtoMessage() {}
+
+func (x *CancelPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CancelPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{59}
+}
+
+type ListQueuedPaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional: account name for which filtering the list of payments.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *ListQueuedPaymentsRequest) Reset() {
+	*x = ListQueuedPaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueuedPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedPaymentsRequest) ProtoMessage() {}
+
+func (x *ListQueuedPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuedPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListQueuedPaymentsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ListQueuedPaymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of queued payments, from the oldest to the newest.
+	Payments []*QueuedPayment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (x *ListQueuedPaymentsResponse) Reset() {
+	*x = ListQueuedPaymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQueuedPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuedPaymentsResponse) ProtoMessage() {}
+
+func (x *ListQueuedPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuedPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuedPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListQueuedPaymentsResponse) GetPayments() []*QueuedPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type QueuedPayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the payment.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Account namespace.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Receiver of the payment.
+	Receiver *Output `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Timestamp of when the payment was queued.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hash of the batched transaction the payment is being sent with, if any.
+	Txid string `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`
+	// Reason why the payment can't be sent, if it failed. Failed payments are
+	// not retried and must be canceled to be removed from the queue.
+	FailureReason string `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (x *QueuedPayment) Reset() {
+	*x = QueuedPayment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedPayment) ProtoMessage() {}
+
+func (x *QueuedPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedPayment.ProtoReflect.Descriptor instead.
+func (*QueuedPayment) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *QueuedPayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueuedPayment) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *QueuedPayment) GetReceiver() *Output {
+	if x != nil {
+		return x.Receiver
+	}
+	return nil
+}
+
+func (x *QueuedPayment) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *QueuedPayment) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *QueuedPayment) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type CreateSwapOfferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset given by the account.
+	GiveAsset string `protobuf:"bytes,2,opt,name=give_asset,json=giveAsset,proto3" json:"give_asset,omitempty"`
+	// Amount given by the account.
+	GiveAmount uint64 `protobuf:"varint,3,opt,name=give_amount,json=giveAmount,proto3" json:"give_amount,omitempty"`
+	// Asset wanted in exchange.
+	WantAsset string `protobuf:"bytes,4,opt,name=want_asset,json=wantAsset,proto3" json:"want_asset,omitempty"`
+	// Amount wanted in exchange.
+	WantAmount uint64 `protobuf:"varint,5,opt,name=want_amount,json=wantAmount,proto3" json:"want_amount,omitempty"`
+}
+
+func (x *CreateSwapOfferRequest) Reset() {
+	*x = CreateSwapOfferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSwapOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwapOfferRequest) ProtoMessage() {}
+
+func (x *CreateSwapOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSwapOfferRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwapOfferRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateSwapOfferRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateSwapOfferRequest) GetGiveAsset() string {
+	if x != nil {
+		return x.GiveAsset
+	}
+	return ""
+}
+
+func (x *CreateSwapOfferRequest) GetGiveAmount() uint64 {
+	if x != nil {
+		return x.GiveAmount
+	}
+	return 0
+}
+
+func (x *CreateSwapOfferRequest) GetWantAsset() string {
+	if x != nil {
+		return x.WantAsset
+	}
+	return ""
+}
+
+func (x *CreateSwapOfferRequest) GetWantAmount() uint64 {
+	if x != nil {
+		return x.WantAmount
+	}
+	return 0
+}
+
+type CreateSwapOfferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The swap offer.
+	Offer *SwapOffer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *CreateSwapOfferResponse) Reset() {
+	*x = CreateSwapOfferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSwapOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwapOfferResponse) ProtoMessage() {}
+
+func (x *CreateSwapOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSwapOfferResponse.ProtoReflect.Descriptor instead.
+func (*CreateSwapOfferResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateSwapOfferResponse) GetOffer() *SwapOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type AcceptSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Partial transaction of the swap offer in base64 format.
+	Pset string `protobuf:"bytes,2,opt,name=pset,proto3" json:"pset,omitempty"`
+	// mSats/byte fee ratio. If not specified, it is estimated by the daemon.
+	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+}
+
+func (x *AcceptSwapRequest) Reset() {
+	*x = AcceptSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSwapRequest) ProtoMessage() {}
+
+func (x *AcceptSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSwapRequest.ProtoReflect.Descriptor instead.
+func (*AcceptSwapRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AcceptSwapRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AcceptSwapRequest) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+func (x *AcceptSwapRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+type AcceptSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The accepted swap offer.
+	Offer *SwapOffer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *AcceptSwapResponse) Reset() {
+	*x = AcceptSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSwapResponse) ProtoMessage() {}
+
+func (x *AcceptSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSwapResponse.ProtoReflect.Descriptor instead.
+func (*AcceptSwapResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AcceptSwapResponse) GetOffer() *SwapOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type CompleteSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the swap.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Partial transaction of the swap in base64 format.
+	Pset string `protobuf:"bytes,2,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Inputs revealed by the counterparty, required to blind the transaction
+	// if not yet blinded.
+	UnblindedInputs []*UnblindedInput `protobuf:"bytes,3,rep,name=unblinded_inputs,json=unblindedInputs,proto3" json:"unblinded_inputs,omitempty"`
+	// Whether to sign the partial transaction even if its fee rate exceeds the
+	// sanity ceiling the daemon is configured with.
+	OverrideFeeGuard bool `protobuf:"varint,4,opt,name=override_fee_guard,json=overrideFeeGuard,proto3" json:"override_fee_guard,omitempty"`
+}
+
+func (x *CompleteSwapRequest) Reset() {
+	*x = CompleteSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSwapRequest) ProtoMessage() {}
+
+func (x *CompleteSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSwapRequest.ProtoReflect.Descriptor instead.
+func (*CompleteSwapRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CompleteSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteSwapRequest) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+func (x *CompleteSwapRequest) GetUnblindedInputs() []*UnblindedInput {
+	if x != nil {
+		return x.UnblindedInputs
+	}
+	return nil
+}
+
+func (x *CompleteSwapRequest) GetOverrideFeeGuard() bool {
+	if x != nil {
+		return x.OverrideFeeGuard
+	}
+	return false
+}
+
+type CompleteSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed partial transaction in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Final transaction in hex format, if all inputs are signed.
+	TxHex string `protobuf:"bytes,2,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *CompleteSwapResponse) Reset() {
+	*x = CompleteSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSwapResponse) ProtoMessage() {}
+
+func (x *CompleteSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSwapResponse.ProtoReflect.Descriptor instead.
+func (*CompleteSwapResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CompleteSwapResponse) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+func (x *CompleteSwapResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type SwapOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the swap, same of the lease locking the utxos.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Account namespace.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Asset given by the account.
+	GiveAsset string `protobuf:"bytes,3,opt,name=give_asset,json=giveAsset,proto3" json:"give_asset,omitempty"`
+	// Amount given by the account.
+	GiveAmount uint64 `protobuf:"varint,4,opt,name=give_amount,json=giveAmount,proto3" json:"give_amount,omitempty"`
+	// Asset wanted in exchange.
+	WantAsset string `protobuf:"bytes,5,opt,name=want_asset,json=wantAsset,proto3" json:"want_asset,omitempty"`
+	// Amount wanted in exchange.
+	WantAmount uint64 `protobuf:"varint,6,opt,name=want_amount,json=wantAmount,proto3" json:"want_amount,omitempty"`
+	// LBTC amount paid by the account for the network fees.
+	FeeAmount uint64 `protobuf:"varint,7,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	// Partial transaction to pass to the counterparty in base64 format.
+	Pset string `protobuf:"bytes,8,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Inputs of the account to reveal to the counterparty.
+	UnblindedInputs []*UnblindedInput `protobuf:"bytes,9,rep,name=unblinded_inputs,json=unblindedInputs,proto3" json:"unblinded_inputs,omitempty"`
+	// Timestamp of when the swap expires.
+	ExpiryTimestamp int64 `protobuf:"varint,10,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+}
+
+func (x *SwapOffer) Reset() {
+	*x = SwapOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapOffer) ProtoMessage() {}
+
+func (x *SwapOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapOffer.ProtoReflect.Descriptor instead.
+func (*SwapOffer) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SwapOffer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SwapOffer) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SwapOffer) GetGiveAsset() string {
+	if x != nil {
+		return x.GiveAsset
+	}
+	return ""
+}
+
+func (x *SwapOffer) GetGiveAmount() uint64 {
+	if x != nil {
+		return x.GiveAmount
+	}
+	return 0
+}
+
+func (x *SwapOffer) GetWantAsset() string {
+	if x != nil {
+		return x.WantAsset
+	}
+	return ""
+}
+
+func (x *SwapOffer) GetWantAmount() uint64 {
+	if x != nil {
+		return x.WantAmount
+	}
+	return 0
+}
+
+func (x *SwapOffer) GetFeeAmount() uint64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *SwapOffer) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+func (x *SwapOffer) GetUnblindedInputs() []*UnblindedInput {
+	if x != nil {
+		return x.UnblindedInputs
+	}
+	return nil
+}
+
+func (x *SwapOffer) GetExpiryTimestamp() int64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+type CreateFragmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Utxo to spend.
+	Utxo *Input `protobuf:"bytes,2,opt,name=utxo,proto3" json:"utxo,omitempty"`
+	// Asset wanted in exchange.
+	WantAsset string `protobuf:"bytes,3,opt,name=want_asset,json=wantAsset,proto3" json:"want_asset,omitempty"`
+	// Amount wanted in exchange.
+	WantAmount uint64 `protobuf:"varint,4,opt,name=want_amount,json=wantAmount,proto3" json:"want_amount,omitempty"`
+	// Whether the output receiving the wanted amount must be blinded.
+	BlindOutput bool `protobuf:"varint,5,opt,name=blind_output,json=blindOutput,proto3" json:"blind_output,omitempty"`
+}
+
+func (x *CreateFragmentRequest) Reset() {
+	*x = CreateFragmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFragmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFragmentRequest) ProtoMessage() {}
+
+func (x *CreateFragmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFragmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateFragmentRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateFragmentRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateFragmentRequest) GetUtxo() *Input {
+	if x != nil {
+		return x.Utxo
+	}
+	return nil
+}
+
+func (x *CreateFragmentRequest) GetWantAsset() string {
+	if x != nil {
+		return x.WantAsset
+	}
+	return ""
+}
+
+func (x *CreateFragmentRequest) GetWantAmount() uint64 {
+	if x != nil {
+		return x.WantAmount
+	}
+	return 0
+}
+
+func (x *CreateFragmentRequest) GetBlindOutput() bool {
+	if x != nil {
+		return x.BlindOutput
+	}
+	return false
+}
+
+type CreateFragmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed fragment.
+	Fragment *Fragment `protobuf:"bytes,1,opt,name=fragment,proto3" json:"fragment,omitempty"`
+}
+
+func (x *CreateFragmentResponse) Reset() {
+	*x = CreateFragmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFragmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFragmentResponse) ProtoMessage() {}
+
+func (x *CreateFragmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFragmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateFragmentResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateFragmentResponse) GetFragment() *Fragment {
+	if x != nil {
+		return x.Fragment
+	}
+	return nil
+}
+
+type FillFragmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account namespace.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Fragments to fill.
+	Fragments []*Fragment `protobuf:"bytes,2,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	// mSats/byte fee ratio. If not specified, it is estimated by the daemon.
+	MillisatsPerByte uint64 `protobuf:"varint,3,opt,name=millisats_per_byte,json=millisatsPerByte,proto3" json:"millisats_per_byte,omitempty"`
+	// Whether to sign the transaction even if its fee rate exceeds the sanity
+	// ceiling the daemon is configured with.
+	OverrideFeeGuard bool `protobuf:"varint,4,opt,name=override_fee_guard,json=overrideFeeGuard,proto3" json:"override_fee_guard,omitempty"`
+}
+
+func (x *FillFragmentsRequest) Reset() {
+	*x = FillFragmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillFragmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillFragmentsRequest) ProtoMessage() {}
+
+func (x *FillFragmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillFragmentsRequest.ProtoReflect.Descriptor instead.
+func (*FillFragmentsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FillFragmentsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *FillFragmentsRequest) GetFragments() []*Fragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+func (x *FillFragmentsRequest) GetMillisatsPerByte() uint64 {
+	if x != nil {
+		return x.MillisatsPerByte
+	}
+	return 0
+}
+
+func (x *FillFragmentsRequest) GetOverrideFeeGuard() bool {
+	if x != nil {
+		return x.OverrideFeeGuard
+	}
+	return false
+}
+
+type FillFragmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Final transaction in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *FillFragmentsResponse) Reset() {
+	*x = FillFragmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FillFragmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillFragmentsResponse) ProtoMessage() {}
+
+func (x *FillFragmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillFragmentsResponse.ProtoReflect.Descriptor instead.
+func (*FillFragmentsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FillFragmentsResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type Fragment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partial transaction in base64 format.
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Revealed input of the fragment.
+	UnblindedInput *UnblindedInput `protobuf:"bytes,2,opt,name=unblinded_input,json=unblindedInput,proto3" json:"unblinded_input,omitempty"`
+	// Asset blinder of the output, if blinded.
+	OutputAssetBlinder string `protobuf:"bytes,3,opt,name=output_asset_blinder,json=outputAssetBlinder,proto3" json:"output_asset_blinder,omitempty"`
+	// Amount blinder of the output, if blinded.
+	OutputAmountBlinder string `protobuf:"bytes,4,opt,name=output_amount_blinder,json=outputAmountBlinder,proto3" json:"output_amount_blinder,omitempty"`
+	// Id of the lease locking the spent utxo.
+	LeaseId string `protobuf:"bytes,5,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Timestamp of when the lease expires.
+	ExpiryTimestamp int64 `protobuf:"varint,6,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+}
+
+func (x *Fragment) Reset() {
+	*x = Fragment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fragment) ProtoMessage() {}
+
+func (x *Fragment) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fragment.ProtoReflect.Descriptor instead.
+func (*Fragment) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Fragment) GetPset() string {
+	if x != nil {
+		return x.Pset
+	}
+	return ""
+}
+
+func (x *Fragment) GetUnblindedInput() *UnblindedInput {
+	if x != nil {
+		return x.UnblindedInput
+	}
+	return nil
+}
+
+func (x *Fragment) GetOutputAssetBlinder() string {
+	if x != nil {
+		return x.OutputAssetBlinder
+	}
+	return ""
+}
+
+func (x *Fragment) GetOutputAmountBlinder() string {
+	if x != nil {
+		return x.OutputAmountBlinder
+	}
+	return ""
+}
+
+func (x *Fragment) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *Fragment) GetExpiryTimestamp() int64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+type SetSpendingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spending policy.
+	Policy *SpendingPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The wallet password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *SetSpendingPolicyRequest) Reset() {
+	*x = SetSpendingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSpendingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingPolicyRequest) ProtoMessage() {}
+
+func (x *SetSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSpendingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetSpendingPolicyRequest) GetPolicy() *SpendingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *SetSpendingPolicyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SetSpendingPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetSpendingPolicyResponse) Reset() {
+	*x = SetSpendingPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSpendingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingPolicyResponse) ProtoMessage() {}
+
+func (x *SetSpendingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSpendingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{76}
+}
+
+type GetSpendingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *GetSpendingPolicyRequest) Reset() {
+	*x = GetSpendingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingPolicyRequest) ProtoMessage() {}
+
+func (x *GetSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetSpendingPolicyRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type GetSpendingPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spending policy.
+	Policy *SpendingPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *GetSpendingPolicyResponse) Reset() {
+	*x = GetSpendingPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingPolicyResponse) ProtoMessage() {}
+
+func (x *GetSpendingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetSpendingPolicyResponse) GetPolicy() *SpendingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeleteSpendingPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The wallet password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *DeleteSpendingPolicyRequest) Reset() {
+	*x = DeleteSpendingPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSpendingPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpendingPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpendingPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteSpendingPolicyRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DeleteSpendingPolicyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteSpendingPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSpendingPolicyResponse) Reset() {
+	*x = DeleteSpendingPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSpendingPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpendingPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteSpendingPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpendingPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSpendingPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{80}
+}
+
+type ApproveSpendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the spending pending for approval.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Public key (in hex format) of who approves the spending, must be one of
+	// the approvers of the spending policy.
+	Approver string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	// DER encoded ECDSA signature (in hex format) of the spending digest.
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ApproveSpendingRequest) Reset() {
+	*x = ApproveSpendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSpendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSpendingRequest) ProtoMessage() {}
+
+func (x *ApproveSpendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSpendingRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSpendingRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ApproveSpendingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveSpendingRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApproveSpendingRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ApproveSpendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The approved spending.
+	Spending *Spending `protobuf:"bytes,1,opt,name=spending,proto3" json:"spending,omitempty"`
+}
+
+func (x *ApproveSpendingResponse) Reset() {
+	*x = ApproveSpendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSpendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSpendingResponse) ProtoMessage() {}
+
+func (x *ApproveSpendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSpendingResponse.ProtoReflect.Descriptor instead.
+func (*ApproveSpendingResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ApproveSpendingResponse) GetSpending() *Spending {
+	if x != nil {
+		return x.Spending
+	}
+	return nil
+}
+
+type ListSpendingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *ListSpendingsRequest) Reset() {
+	*x = ListSpendingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSpendingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendingsRequest) ProtoMessage() {}
+
+func (x *ListSpendingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSpendingsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListSpendingsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ListSpendingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of spendings, from the oldest to the newest.
+	Spendings []*Spending `protobuf:"bytes,1,rep,name=spendings,proto3" json:"spendings,omitempty"`
+}
+
+func (x *ListSpendingsResponse) Reset() {
+	*x = ListSpendingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSpendingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpendingsResponse) ProtoMessage() {}
+
+func (x *ListSpendingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpendingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSpendingsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListSpendingsResponse) GetSpendings() []*Spending {
+	if x != nil {
+		return x.Spendings
+	}
+	return nil
+}
+
+type ListPolicyViolationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+}
+
+func (x *ListPolicyViolationsRequest) Reset() {
+	*x = ListPolicyViolationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPolicyViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPolicyViolationsRequest) ProtoMessage() {}
+
+func (x *ListPolicyViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPolicyViolationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPolicyViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListPolicyViolationsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ListPolicyViolationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of violations, from the oldest to the newest.
+	Violations []*PolicyViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *ListPolicyViolationsResponse) Reset() {
+	*x = ListPolicyViolationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPolicyViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPolicyViolationsResponse) ProtoMessage() {}
+
+func (x *ListPolicyViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPolicyViolationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPolicyViolationsResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListPolicyViolationsResponse) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type SpendingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Limits on the amounts spent per asset.
+	AssetLimits []*AssetSpendingLimit `protobuf:"bytes,2,rep,name=asset_limits,json=assetLimits,proto3" json:"asset_limits,omitempty"`
+	// Optional list of the only output scripts (in hex format) funds can be
+	// sent to.
+	AllowedScripts []string `protobuf:"bytes,3,rep,name=allowed_scripts,json=allowedScripts,proto3" json:"allowed_scripts,omitempty"`
+	// Optional list of the output scripts (in hex format) funds can't be sent
+	// to.
+	DeniedScripts []string `protobuf:"bytes,4,rep,name=denied_scripts,json=deniedScripts,proto3" json:"denied_scripts,omitempty"`
+	// Optional max mSats/byte fee ratio.
+	MaxFeeRate uint64 `protobuf:"varint,5,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty"`
+	// Optional max LBTC fee amount.
+	MaxFeeAmount uint64 `protobuf:"varint,6,opt,name=max_fee_amount,json=maxFeeAmount,proto3" json:"max_fee_amount,omitempty"`
+	// Number of approvals required to spend more than an approval threshold.
+	RequiredApprovals uint32 `protobuf:"varint,7,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	// Compressed public keys (in hex format) of who can approve the spendings.
+	Approvers []string `protobuf:"bytes,8,rep,name=approvers,proto3" json:"approvers,omitempty"`
+}
+
+func (x *SpendingPolicy) Reset() {
+	*x = SpendingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpendingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingPolicy) ProtoMessage() {}
+
+func (x *SpendingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingPolicy.ProtoReflect.Descriptor instead.
+func (*SpendingPolicy) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SpendingPolicy) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SpendingPolicy) GetAssetLimits() []*AssetSpendingLimit {
+	if x != nil {
+		return x.AssetLimits
+	}
+	return nil
+}
+
+func (x *SpendingPolicy) GetAllowedScripts() []string {
+	if x != nil {
+		return x.AllowedScripts
+	}
+	return nil
+}
+
+func (x *SpendingPolicy) GetDeniedScripts() []string {
+	if x != nil {
+		return x.DeniedScripts
+	}
+	return nil
+}
+
+func (x *SpendingPolicy) GetMaxFeeRate() uint64 {
+	if x != nil {
+		return x.MaxFeeRate
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetMaxFeeAmount() uint64 {
+	if x != nil {
+		return x.MaxFeeAmount
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetRequiredApprovals() uint32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+type AssetSpendingLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Asset hash.
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Optional max amount spent with a single transaction.
+	MaxAmountPerTx uint64 `protobuf:"varint,2,opt,name=max_amount_per_tx,json=maxAmountPerTx,proto3" json:"max_amount_per_tx,omitempty"`
+	// Optional max amount spent within the last 24 hours.
+	MaxAmountPerDay uint64 `protobuf:"varint,3,opt,name=max_amount_per_day,json=maxAmountPerDay,proto3" json:"max_amount_per_day,omitempty"`
+	// Optional amount above which a spending requires approval.
+	ApprovalThreshold uint64 `protobuf:"varint,4,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+}
+
+func (x *AssetSpendingLimit) Reset() {
+	*x = AssetSpendingLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetSpendingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetSpendingLimit) ProtoMessage() {}
+
+func (x *AssetSpendingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetSpendingLimit.ProtoReflect.Descriptor instead.
+func (*AssetSpendingLimit) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AssetSpendingLimit) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AssetSpendingLimit) GetMaxAmountPerTx() uint64 {
+	if x != nil {
+		return x.MaxAmountPerTx
+	}
+	return 0
+}
+
+func (x *AssetSpendingLimit) GetMaxAmountPerDay() uint64 {
+	if x != nil {
+		return x.MaxAmountPerDay
+	}
+	return 0
+}
+
+func (x *AssetSpendingLimit) GetApprovalThreshold() uint64 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
+}
+
+type Spending struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the spending.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Account name.
+	AccountName string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Amounts spent per asset.
+	Amounts map[string]uint64 `protobuf:"bytes,3,rep,name=amounts,proto3" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Public keys of who approved the spending.
+	Approvers []string `protobuf:"bytes,4,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// Timestamp of when the spending was created.
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp of when the spending was signed, 0 if pending for approval.
+	SignedAt int64 `protobuf:"varint,6,opt,name=signed_at,json=signedAt,proto3" json:"signed_at,omitempty"`
+	// Digest (in hex format) the approvers must sign to approve the spending.
+	Digest string `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *Spending) Reset() {
+	*x = Spending{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Spending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spending) ProtoMessage() {}
+
+func (x *Spending) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spending.ProtoReflect.Descriptor instead.
+func (*Spending) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Spending) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Spending) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Spending) GetAmounts() map[string]uint64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *Spending) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *Spending) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Spending) GetSignedAt() int64 {
+	if x != nil {
+		return x.SignedAt
+	}
+	return 0
+}
+
+func (x *Spending) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type PegInAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PegInAddressRequest) Reset() {
+	*x = PegInAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PegInAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PegInAddressRequest) ProtoMessage() {}
+
+func (x *PegInAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PegInAddressRequest.ProtoReflect.Descriptor instead.
+func (*PegInAddressRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{90}
+}
+
+type PegInAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Account name.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Main-chain deposit address to send bitcoin to.
+	MainChainAddress string `protobuf:"bytes,2,opt,name=main_chain_address,json=mainChainAddress,proto3" json:"main_chain_address,omitempty"`
+	// Claim script committed to by the main-chain address.
+	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+}
+
+func (x *PegInAddressResponse) Reset() {
+	*x = PegInAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PegInAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PegInAddressResponse) ProtoMessage() {}
+
+func (x *PegInAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PegInAddressResponse.ProtoReflect.Descriptor instead.
+func (*PegInAddressResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PegInAddressResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *PegInAddressResponse) GetMainChainAddress() string {
+	if x != nil {
+		return x.MainChainAddress
+	}
+	return ""
+}
+
+func (x *PegInAddressResponse) GetClaimScript() string {
+	if x != nil {
+		return x.ClaimScript
+	}
+	return ""
+}
+
+type ClaimPegInRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw bitcoin transaction (in hex) depositing bitcoin to the main-chain address generated by PegInAddress.
+	BitcoinTx string `protobuf:"bytes,1,opt,name=bitcoin_tx,json=bitcoinTx,proto3" json:"bitcoin_tx,omitempty"`
+	// A raw tx_out_proof (in hex) generated by the main-chain daemon's `gettxoutproof` containing a proof of only bitcoin_tx.
+	TxOutProof string `protobuf:"bytes,2,opt,name=tx_out_proof,json=txOutProof,proto3" json:"tx_out_proof,omitempty"`
+	// The witness program generated by PegInAddress.
+	ClaimScript string `protobuf:"bytes,3,opt,name=claim_script,json=claimScript,proto3" json:"claim_script,omitempty"`
+}
+
+func (x *ClaimPegInRequest) Reset() {
+	*x = ClaimPegInRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimPegInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimPegInRequest) ProtoMessage() {}
+
+func (x *ClaimPegInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimPegInRequest.ProtoReflect.Descriptor instead.
+func (*ClaimPegInRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ClaimPegInRequest) GetBitcoinTx() string {
+	if x != nil {
+		return x.BitcoinTx
+	}
+	return ""
+}
+
+func (x *ClaimPegInRequest) GetTxOutProof() string {
+	if x != nil {
+		return x.TxOutProof
+	}
+	return ""
+}
+
+func (x *ClaimPegInRequest) GetClaimScript() string {
+	if x != nil {
+		return x.ClaimScript
+	}
+	return ""
+}
+
+type ClaimPegInResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed tx in hex format.
+	TxHex string `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+}
+
+func (x *ClaimPegInResponse) Reset() {
+	*x = ClaimPegInResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimPegInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimPegInResponse) ProtoMessage() {}
+
+func (x *ClaimPegInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimPegInResponse.ProtoReflect.Descriptor instead.
+func (*ClaimPegInResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ClaimPegInResponse) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type SignPsetWithSchnorrKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The partial transaction to sign in base64 format.
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// The sighash type. SIGHASH_DEFAULT is used for any input that does not specify one.
+	SighashType uint32 `protobuf:"varint,2,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	// Whether to sign the partial transaction even if its fee rate exceeds the
+	// sanity ceiling the daemon is configured with.
+	OverrideFeeGuard bool `protobuf:"varint,3,opt,name=override_fee_guard,json=overrideFeeGuard,proto3" json:"override_fee_guard,omitempty"`
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) Reset() {
+	*x = SignPsetWithSchnorrKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPsetWithSchnorrKeyRequest) ProtoMessage() {}
+
+func (x *SignPsetWithSchnorrKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPsetWithSchnorrKeyRequest.ProtoReflect.Descriptor instead.
+func (*SignPsetWithSchnorrKeyRequest) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) GetSighashType() uint32 {
+	if x != nil {
+		return x.SighashType
+	}
+	return 0
+}
+
+func (x *SignPsetWithSchnorrKeyRequest) GetOverrideFeeGuard() bool {
+	if x != nil {
+		return x.OverrideFeeGuard
+	}
+	return false
+}
+
+type SignPsetWithSchnorrKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedTx string `protobuf:"bytes,1,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+}
+
+func (x *SignPsetWithSchnorrKeyResponse) Reset() {
+	*x = SignPsetWithSchnorrKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocean_v1_transaction_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignPsetWithSchnorrKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPsetWithSchnorrKeyResponse) ProtoMessage() {}
+
+func (x *SignPsetWithSchnorrKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocean_v1_transaction_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPsetWithSchnorrKeyResponse.ProtoReflect.Descriptor instead.
+func (*SignPsetWithSchnorrKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SignPsetWithSchnorrKeyResponse) GetSignedTx() string {
+	if x != nil {
+		return x.SignedTx
+	}
+	return ""
+}
+
+var File_ocean_v1_transaction_proto protoreflect.FileDescriptor
+
+var file_ocean_v1_transaction_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xe2, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x30, 0x0a, 0x11,
+	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x22, 0x56,
+	0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x52, 0x41, 0x47,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x97, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75,
+	0x74, 0x78, 0x6f, 0x73, 0x22, 0x57, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a,
+	0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05,
+	0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x12,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x13, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x22, 0xbc, 0x01, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0x98, 0x01, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x39, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x47, 0x0a, 0x17,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61,
+	0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73,
+	0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x46, 0x65, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x34, 0x0a, 0x1b, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f,
+	0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78,
+	0x22, 0x32, 0x0a, 0x1c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12,
+	0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x28, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x42,
+	0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x42,
+	0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x16, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x14, 0x65, 0x78, 0x74, 0x72, 0x61, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22,
+	0x76, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73,
+	0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69,
+	0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46,
+	0x65, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x50,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22,
+	0x27, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x31, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x6c, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x2b, 0x0a, 0x13,
+	0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x50, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x73, 0x65, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x65, 0x50, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74,
+	0x22, 0x41, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x50, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x68, 0x61,
+	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0xb6, 0x01, 0x0a,
+	0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42,
+	0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89,
+	0x02, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x0c, 0x4d, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65,
+	0x78, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79,
+	0x74, 0x65, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x8e, 0x01, 0x0a, 0x0b,
+	0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x0c,
+	0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78,
+	0x48, 0x65, 0x78, 0x22, 0x87, 0x04, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x65, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46,
+	0x65, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf0, 0x01,
+	0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a,
+	0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x06, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x52, 0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x79, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x11, 0x41,
+	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79,
+	0x74, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0xb0, 0x01, 0x0a,
+	0x10, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22,
+	0x27, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x93, 0x02, 0x0a, 0x14, 0x46, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75,
+	0x6d, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2e,
+	0x0a, 0x15, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0xf5,
+	0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x55, 0x74, 0x78, 0x6f, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74,
+	0x65, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x2c, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x78, 0x48, 0x65, 0x78, 0x22, 0x68, 0x0a, 0x15, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0x28,
+	0x0a, 0x16, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc9, 0x01, 0x0a,
+	0x0d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x76, 0x65, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x76, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x69, 0x76, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x61, 0x6e, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x78, 0x0a, 0x11,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x3f, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x73, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x65,
+	0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73,
+	0x65, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0xe1, 0x02, 0x0a, 0x09, 0x53, 0x77,
+	0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69,
+	0x76, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x67, 0x69, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69, 0x76,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x67, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61,
+	0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x77, 0x61, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x77, 0x61, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x43, 0x0a,
+	0x10, 0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xc2, 0x01,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x74,
+	0x78, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x04, 0x75, 0x74, 0x78, 0x6f, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x61, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc7, 0x01, 0x0a,
+	0x14, 0x46, 0x69, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x65,
+	0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x22, 0x2e, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x6c, 0x46, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x8d, 0x02, 0x0a, 0x08, 0x46, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x75, 0x6e, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0e, 0x75, 0x6e, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x5c, 0x0a, 0x1b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x16, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x49, 0x0a,
+	0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x39, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x40,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x59, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x0e,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12,
+	0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x12,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa6, 0x02, 0x0a, 0x08,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x14,
+	0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x77, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x78, 0x12, 0x20, 0x0a, 0x0c,
+	0x74, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x78, 0x4f, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x22, 0x2b, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x80,
+	0x01, 0x0a, 0x1d, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x78,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x46, 0x65, 0x65, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x22, 0x3d, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78,
+	0x32, 0xf7, 0x1a, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x6b,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1c, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0c, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x0f, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x14, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x50, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x69, 0x6e,
+	0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x73, 0x65, 0x74, 0x12, 0x1b,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x65, 0x50, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x50, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x50, 0x73, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x50, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x50, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12,
+	0x15, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x42,
+	0x75, 0x72, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x19,
+	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65,
+	0x12, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x6c, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x23, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x20, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1d, 0x2e, 0x6f, 0x63,
+	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c,
+	0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6c,
+	0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x65,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f,
+	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x65, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67,
+	0x49, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x16, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68,
+	0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa9, 0x01, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x75, 0x6c, 0x70,
+	0x65, 0x6d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x6f, 0x63, 0x65, 0x61, 0x6e,
+	0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x3b,
+	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f, 0x58, 0x58, 0xaa, 0x02, 0x08,
+	0x4f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x4f, 0x63, 0x65, 0x61, 0x6e,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x4f, 0x63, 0x65,
+	0x61, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ocean_v1_transaction_proto_rawDescOnce sync.Once
+	file_ocean_v1_transaction_proto_rawDescData = file_ocean_v1_transaction_proto_rawDesc
+)
+
+func file_ocean_v1_transaction_proto_rawDescGZIP() []byte {
+	file_ocean_v1_transaction_proto_rawDescOnce.Do(func() {
+		file_ocean_v1_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_ocean_v1_transaction_proto_rawDescData)
+	})
+	return file_ocean_v1_transaction_proto_rawDescData
+}
+
+var file_ocean_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ocean_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_ocean_v1_transaction_proto_goTypes = []interface{}{
+	(SelectUtxosRequest_Strategy)(0),       // 0: ocean.v1.SelectUtxosRequest.Strategy
+	(*GetTransactionRequest)(nil),          // 1: ocean.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),         // 2: ocean.v1.GetTransactionResponse
+	(*SelectUtxosRequest)(nil),             // 3: ocean.v1.SelectUtxosRequest
+	(*SelectUtxosResponse)(nil),            // 4: ocean.v1.SelectUtxosResponse
+	(*LockUtxosRequest)(nil),               // 5: ocean.v1.LockUtxosRequest
+	(*LockUtxosResponse)(nil),              // 6: ocean.v1.LockUtxosResponse
+	(*UnlockUtxosRequest)(nil),             // 7: ocean.v1.UnlockUtxosRequest
+	(*UnlockUtxosResponse)(nil),            // 8: ocean.v1.UnlockUtxosResponse
+	(*ExtendLeaseRequest)(nil),             // 9: ocean.v1.ExtendLeaseRequest
+	(*ExtendLeaseResponse)(nil),            // 10: ocean.v1.ExtendLeaseResponse
+	(*ListLeasesRequest)(nil),              // 11: ocean.v1.ListLeasesRequest
+	(*ListLeasesResponse)(nil),             // 12: ocean.v1.ListLeasesResponse
+	(*Lease)(nil),                          // 13: ocean.v1.Lease
+	(*EstimateFeesRequest)(nil),            // 14: ocean.v1.EstimateFeesRequest
+	(*EstimateFeesResponse)(nil),           // 15: ocean.v1.EstimateFeesResponse
+	(*EstimateFeeRateRequest)(nil),         // 16: ocean.v1.EstimateFeeRateRequest
+	(*EstimateFeeRateResponse)(nil),        // 17: ocean.v1.EstimateFeeRateResponse
+	(*SignTransactionRequest)(nil),         // 18: ocean.v1.SignTransactionRequest
+	(*SignTransactionResponse)(nil),        // 19: ocean.v1.SignTransactionResponse
+	(*BroadcastTransactionRequest)(nil),    // 20: ocean.v1.BroadcastTransactionRequest
+	(*BroadcastTransactionResponse)(nil),   // 21: ocean.v1.BroadcastTransactionResponse
+	(*CreatePsetRequest)(nil),              // 22: ocean.v1.CreatePsetRequest
+	(*CreatePsetResponse)(nil),             // 23: ocean.v1.CreatePsetResponse
+	(*UpdatePsetRequest)(nil),              // 24: ocean.v1.UpdatePsetRequest
+	(*UpdatePsetResponse)(nil),             // 25: ocean.v1.UpdatePsetResponse
+	(*BlindPsetRequest)(nil),               // 26: ocean.v1.BlindPsetRequest
+	(*BlindPsetResponse)(nil),              // 27: ocean.v1.BlindPsetResponse
+	(*SignPsetRequest)(nil),                // 28: ocean.v1.SignPsetRequest
+	(*SignPsetResponse)(nil),               // 29: ocean.v1.SignPsetResponse
+	(*DecodePsetRequest)(nil),              // 30: ocean.v1.DecodePsetRequest
+	(*DecodePsetResponse)(nil),             // 31: ocean.v1.DecodePsetResponse
+	(*CombinePsetsRequest)(nil),            // 32: ocean.v1.CombinePsetsRequest
+	(*CombinePsetsResponse)(nil),           // 33: ocean.v1.CombinePsetsResponse
+	(*FinalizePsetRequest)(nil),            // 34: ocean.v1.FinalizePsetRequest
+	(*FinalizePsetResponse)(nil),           // 35: ocean.v1.FinalizePsetResponse
+	(*DecodedInput)(nil),                   // 36: ocean.v1.DecodedInput
+	(*DecodedOutput)(nil),                  // 37: ocean.v1.DecodedOutput
+	(*AccountNetAmounts)(nil),              // 38: ocean.v1.AccountNetAmounts
+	(*MintRequest)(nil),                    // 39: ocean.v1.MintRequest
+	(*MintResponse)(nil),                   // 40: ocean.v1.MintResponse
+	(*RemintRequest)(nil),                  // 41: ocean.v1.RemintRequest
+	(*RemintResponse)(nil),                 // 42: ocean.v1.RemintResponse
+	(*BurnRequest)(nil),                    // 43: ocean.v1.BurnRequest
+	(*BurnResponse)(nil),                   // 44: ocean.v1.BurnResponse
+	(*TransferRequest)(nil),                // 45: ocean.v1.TransferRequest
+	(*TransferResponse)(nil),               // 46: ocean.v1.TransferResponse
+	(*BumpFeeRequest)(nil),                 // 47: ocean.v1.BumpFeeRequest
+	(*BumpFeeResponse)(nil),                // 48: ocean.v1.BumpFeeResponse
+	(*AccelerateRequest)(nil),              // 49: ocean.v1.AccelerateRequest
+	(*AccelerateResponse)(nil),             // 50: ocean.v1.AccelerateResponse
+	(*MoveFundsRequest)(nil),               // 51: ocean.v1.MoveFundsRequest
+	(*MoveFundsResponse)(nil),              // 52: ocean.v1.MoveFundsResponse
+	(*FragmentUtxosRequest)(nil),           // 53: ocean.v1.FragmentUtxosRequest
+	(*FragmentUtxosResponse)(nil),          // 54: ocean.v1.FragmentUtxosResponse
+	(*ConsolidateRequest)(nil),             // 55: ocean.v1.ConsolidateRequest
+	(*ConsolidateResponse)(nil),            // 56: ocean.v1.ConsolidateResponse
+	(*EnqueuePaymentRequest)(nil),          // 57: ocean.v1.EnqueuePaymentRequest
+	(*EnqueuePaymentResponse)(nil),         // 58: ocean.v1.EnqueuePaymentResponse
+	(*CancelPaymentRequest)(nil),           // 59: ocean.v1.CancelPaymentRequest
+	(*CancelPaymentResponse)(nil),          // 60: ocean.v1.CancelPaymentResponse
+	(*ListQueuedPaymentsRequest)(nil),      // 61: ocean.v1.ListQueuedPaymentsRequest
+	(*ListQueuedPaymentsResponse)(nil),     // 62: ocean.v1.ListQueuedPaymentsResponse
+	(*QueuedPayment)(nil),                  // 63: ocean.v1.QueuedPayment
+	(*CreateSwapOfferRequest)(nil),         // 64: ocean.v1.CreateSwapOfferRequest
+	(*CreateSwapOfferResponse)(nil),        // 65: ocean.v1.CreateSwapOfferResponse
+	(*AcceptSwapRequest)(nil),              // 66: ocean.v1.AcceptSwapRequest
+	(*AcceptSwapResponse)(nil),             // 67: ocean.v1.AcceptSwapResponse
+	(*CompleteSwapRequest)(nil),            // 68: ocean.v1.CompleteSwapRequest
+	(*CompleteSwapResponse)(nil),           // 69: ocean.v1.CompleteSwapResponse
+	(*SwapOffer)(nil),                      // 70: ocean.v1.SwapOffer
+	(*CreateFragmentRequest)(nil),          // 71: ocean.v1.CreateFragmentRequest
+	(*CreateFragmentResponse)(nil),         // 72: ocean.v1.CreateFragmentResponse
+	(*FillFragmentsRequest)(nil),           // 73: ocean.v1.FillFragmentsRequest
+	(*FillFragmentsResponse)(nil),          // 74: ocean.v1.FillFragmentsResponse
+	(*Fragment)(nil),                       // 75: ocean.v1.Fragment
+	(*SetSpendingPolicyRequest)(nil),       // 76: ocean.v1.SetSpendingPolicyRequest
+	(*SetSpendingPolicyResponse)(nil),      // 77: ocean.v1.SetSpendingPolicyResponse
+	(*GetSpendingPolicyRequest)(nil),       // 78: ocean.v1.GetSpendingPolicyRequest
+	(*GetSpendingPolicyResponse)(nil),      // 79: ocean.v1.GetSpendingPolicyResponse
+	(*DeleteSpendingPolicyRequest)(nil),    // 80: ocean.v1.DeleteSpendingPolicyRequest
+	(*DeleteSpendingPolicyResponse)(nil),   // 81: ocean.v1.DeleteSpendingPolicyResponse
+	(*ApproveSpendingRequest)(nil),         // 82: ocean.v1.ApproveSpendingRequest
+	(*ApproveSpendingResponse)(nil),        // 83: ocean.v1.ApproveSpendingResponse
+	(*ListSpendingsRequest)(nil),           // 84: ocean.v1.ListSpendingsRequest
+	(*ListSpendingsResponse)(nil),          // 85: ocean.v1.ListSpendingsResponse
+	(*ListPolicyViolationsRequest)(nil),    // 86: ocean.v1.ListPolicyViolationsRequest
+	(*ListPolicyViolationsResponse)(nil),   // 87: ocean.v1.ListPolicyViolationsResponse
+	(*SpendingPolicy)(nil),                 // 88: ocean.v1.SpendingPolicy
+	(*AssetSpendingLimit)(nil),             // 89: ocean.v1.AssetSpendingLimit
+	(*Spending)(nil),                       // 90: ocean.v1.Spending
+	(*PegInAddressRequest)(nil),            // 91: ocean.v1.PegInAddressRequest
+	(*PegInAddressResponse)(nil),           // 92: ocean.v1.PegInAddressResponse
+	(*ClaimPegInRequest)(nil),              // 93: ocean.v1.ClaimPegInRequest
+	(*ClaimPegInResponse)(nil),             // 94: ocean.v1.ClaimPegInResponse
+	(*SignPsetWithSchnorrKeyRequest)(nil),  // 95: ocean.v1.SignPsetWithSchnorrKeyRequest
+	(*SignPsetWithSchnorrKeyResponse)(nil), // 96: ocean.v1.SignPsetWithSchnorrKeyResponse
+	nil,                                    // 97: ocean.v1.AccountNetAmounts.AmountsEntry
+	nil,                                    // 98: ocean.v1.Spending.AmountsEntry
+	(*BlockDetails)(nil),                   // 99: ocean.v1.BlockDetails
+	(*Utxo)(nil),                           // 100: ocean.v1.Utxo
+	(*Input)(nil),                          // 101: ocean.v1.Input
+	(*Output)(nil),                         // 102: ocean.v1.Output
+	(*UnblindedInput)(nil),                 // 103: ocean.v1.UnblindedInput
+	(*PolicyViolation)(nil),                // 104: ocean.v1.PolicyViolation
+}
+var file_ocean_v1_transaction_proto_depIdxs = []int32{
+	99,  // 0: ocean.v1.GetTransactionResponse.block_details:type_name -> ocean.v1.BlockDetails
+	0,   // 1: ocean.v1.SelectUtxosRequest.strategy:type_name -> ocean.v1.SelectUtxosRequest.Strategy
+	100, // 2: ocean.v1.SelectUtxosResponse.utxos:type_name -> ocean.v1.Utxo
+	101, // 3: ocean.v1.LockUtxosRequest.utxos:type_name -> ocean.v1.Input
+	101, // 4: ocean.v1.UnlockUtxosRequest.utxos:type_name -> ocean.v1.Input
+	13,  // 5: ocean.v1.ListLeasesResponse.leases:type_name -> ocean.v1.Lease
+	101, // 6: ocean.v1.Lease.utxos:type_name -> ocean.v1.Input
+	101, // 7: ocean.v1.EstimateFeesRequest.inputs:type_name -> ocean.v1.Input
+	102, // 8: ocean.v1.EstimateFeesRequest.outputs:type_name -> ocean.v1.Output
+	101, // 9: ocean.v1.CreatePsetRequest.inputs:type_name -> ocean.v1.Input
+	102, // 10: ocean.v1.CreatePsetRequest.outputs:type_name -> ocean.v1.Output
+	101, // 11: ocean.v1.UpdatePsetRequest.inputs:type_name -> ocean.v1.Input
+	102, // 12: ocean.v1.UpdatePsetRequest.outputs:type_name -> ocean.v1.Output
+	103, // 13: ocean.v1.BlindPsetRequest.extra_unblinded_inputs:type_name -> ocean.v1.UnblindedInput
+	36,  // 14: ocean.v1.DecodePsetResponse.inputs:type_name -> ocean.v1.DecodedInput
+	37,  // 15: ocean.v1.DecodePsetResponse.outputs:type_name -> ocean.v1.DecodedOutput
+	38,  // 16: ocean.v1.DecodePsetResponse.net_amounts:type_name -> ocean.v1.AccountNetAmounts
+	97,  // 17: ocean.v1.AccountNetAmounts.amounts:type_name -> ocean.v1.AccountNetAmounts.AmountsEntry
+	102, // 18: ocean.v1.BurnRequest.receivers:type_name -> ocean.v1.Output
+	102, // 19: ocean.v1.TransferRequest.receivers:type_name -> ocean.v1.Output
+	101, // 20: ocean.v1.TransferRequest.inputs:type_name -> ocean.v1.Input
+	100, // 21: ocean.v1.TransferResponse.inputs:type_name -> ocean.v1.Utxo
+	102, // 22: ocean.v1.TransferResponse.change_outputs:type_name -> ocean.v1.Output
+	13,  // 23: ocean.v1.TransferResponse.reservation:type_name -> ocean.v1.Lease
+	102, // 24: ocean.v1.EnqueuePaymentRequest.receiver:type_name -> ocean.v1.Output
+	63,  // 25: ocean.v1.ListQueuedPaymentsResponse.payments:type_name -> ocean.v1.QueuedPayment
+	102, // 26: ocean.v1.QueuedPayment.receiver:type_name -> ocean.v1.Output
+	70,  // 27: ocean.v1.CreateSwapOfferResponse.offer:type_name -> ocean.v1.SwapOffer
+	70,  // 28: ocean.v1.AcceptSwapResponse.offer:type_name -> ocean.v1.SwapOffer
+	103, // 29: ocean.v1.CompleteSwapRequest.unblinded_inputs:type_name -> ocean.v1.UnblindedInput
+	103, // 30: ocean.v1.SwapOffer.unblinded_inputs:type_name -> ocean.v1.UnblindedInput
+	101, // 31: ocean.v1.CreateFragmentRequest.utxo:type_name -> ocean.v1.Input
+	75,  // 32: ocean.v1.CreateFragmentResponse.fragment:type_name -> ocean.v1.Fragment
+	75,  // 33: ocean.v1.FillFragmentsRequest.fragments:type_name -> ocean.v1.Fragment
+	103, // 34: ocean.v1.Fragment.unblinded_input:type_name -> ocean.v1.UnblindedInput
+	88,  // 35: ocean.v1.SetSpendingPolicyRequest.policy:type_name -> ocean.v1.SpendingPolicy
+	88,  // 36: ocean.v1.GetSpendingPolicyResponse.policy:type_name -> ocean.v1.SpendingPolicy
+	90,  // 37: ocean.v1.ApproveSpendingResponse.spending:type_name -> ocean.v1.Spending
+	90,  // 38: ocean.v1.ListSpendingsResponse.spendings:type_name -> ocean.v1.Spending
+	104, // 39: ocean.v1.ListPolicyViolationsResponse.violations:type_name -> ocean.v1.PolicyViolation
+	89,  // 40: ocean.v1.SpendingPolicy.asset_limits:type_name -> ocean.v1.AssetSpendingLimit
+	98,  // 41: ocean.v1.Spending.amounts:type_name -> ocean.v1.Spending.AmountsEntry
+	1,   // 42: ocean.v1.TransactionService.GetTransaction:input_type -> ocean.v1.GetTransactionRequest
+	3,   // 43: ocean.v1.TransactionService.SelectUtxos:input_type -> ocean.v1.SelectUtxosRequest
+	5,   // 44: ocean.v1.TransactionService.LockUtxos:input_type -> ocean.v1.LockUtxosRequest
+	7,   // 45: ocean.v1.TransactionService.UnlockUtxos:input_type -> ocean.v1.UnlockUtxosRequest
+	9,   // 46: ocean.v1.TransactionService.ExtendLease:input_type -> ocean.v1.ExtendLeaseRequest
+	11,  // 47: ocean.v1.TransactionService.ListLeases:input_type -> ocean.v1.ListLeasesRequest
+	14,  // 48: ocean.v1.TransactionService.EstimateFees:input_type -> ocean.v1.EstimateFeesRequest
+	16,  // 49: ocean.v1.TransactionService.EstimateFeeRate:input_type -> ocean.v1.EstimateFeeRateRequest
+	18,  // 50: ocean.v1.TransactionService.SignTransaction:input_type -> ocean.v1.SignTransactionRequest
+	20,  // 51: ocean.v1.TransactionService.BroadcastTransaction:input_type -> ocean.v1.BroadcastTransactionRequest
+	22,  // 52: ocean.v1.TransactionService.CreatePset:input_type -> ocean.v1.CreatePsetRequest
+	24,  // 53: ocean.v1.TransactionService.UpdatePset:input_type -> ocean.v1.UpdatePsetRequest
+	26,  // 54: ocean.v1.TransactionService.BlindPset:input_type -> ocean.v1.BlindPsetRequest
+	28,  // 55: ocean.v1.TransactionService.SignPset:input_type -> ocean.v1.SignPsetRequest
+	30,  // 56: ocean.v1.TransactionService.DecodePset:input_type -> ocean.v1.DecodePsetRequest
+	32,  // 57: ocean.v1.TransactionService.CombinePsets:input_type -> ocean.v1.CombinePsetsRequest
+	34,  // 58: ocean.v1.TransactionService.FinalizePset:input_type -> ocean.v1.FinalizePsetRequest
+	39,  // 59: ocean.v1.TransactionService.Mint:input_type -> ocean.v1.MintRequest
+	41,  // 60: ocean.v1.TransactionService.Remint:input_type -> ocean.v1.RemintRequest
+	43,  // 61: ocean.v1.TransactionService.Burn:input_type -> ocean.v1.BurnRequest
+	45,  // 62: ocean.v1.TransactionService.Transfer:input_type -> ocean.v1.TransferRequest
+	47,  // 63: ocean.v1.TransactionService.BumpFee:input_type -> ocean.v1.BumpFeeRequest
+	49,  // 64: ocean.v1.TransactionService.Accelerate:input_type -> ocean.v1.AccelerateRequest
+	51,  // 65: ocean.v1.TransactionService.MoveFunds:input_type -> ocean.v1.MoveFundsRequest
+	53,  // 66: ocean.v1.TransactionService.FragmentUtxos:input_type -> ocean.v1.FragmentUtxosRequest
+	55,  // 67: ocean.v1.TransactionService.Consolidate:input_type -> ocean.v1.ConsolidateRequest
+	57,  // 68: ocean.v1.TransactionService.EnqueuePayment:input_type -> ocean.v1.EnqueuePaymentRequest
+	59,  // 69: ocean.v1.TransactionService.CancelPayment:input_type -> ocean.v1.CancelPaymentRequest
+	61,  // 70: ocean.v1.TransactionService.ListQueuedPayments:input_type -> ocean.v1.ListQueuedPaymentsRequest
+	64,  // 71: ocean.v1.TransactionService.CreateSwapOffer:input_type -> ocean.v1.CreateSwapOfferRequest
+	66,  // 72: ocean.v1.TransactionService.AcceptSwap:input_type -> ocean.v1.AcceptSwapRequest
+	68,  // 73: ocean.v1.TransactionService.CompleteSwap:input_type -> ocean.v1.CompleteSwapRequest
+	71,  // 74: ocean.v1.TransactionService.CreateFragment:input_type -> ocean.v1.CreateFragmentRequest
+	73,  // 75: ocean.v1.TransactionService.FillFragments:input_type -> ocean.v1.FillFragmentsRequest
+	76,  // 76: ocean.v1.TransactionService.SetSpendingPolicy:input_type -> ocean.v1.SetSpendingPolicyRequest
+	78,  // 77: ocean.v1.TransactionService.GetSpendingPolicy:input_type -> ocean.v1.GetSpendingPolicyRequest
+	80,  // 78: ocean.v1.TransactionService.DeleteSpendingPolicy:input_type -> ocean.v1.DeleteSpendingPolicyRequest
+	82,  // 79: ocean.v1.TransactionService.ApproveSpending:input_type -> ocean.v1.ApproveSpendingRequest
+	84,  // 80: ocean.v1.TransactionService.ListSpendings:input_type -> ocean.v1.ListSpendingsRequest
+	86,  // 81: ocean.v1.TransactionService.ListPolicyViolations:input_type -> ocean.v1.ListPolicyViolationsRequest
+	91,  // 82: ocean.v1.TransactionService.PegInAddress:input_type -> ocean.v1.PegInAddressRequest
+	93,  // 83: ocean.v1.TransactionService.ClaimPegIn:input_type -> ocean.v1.ClaimPegInRequest
+	95,  // 84: ocean.v1.TransactionService.SignPsetWithSchnorrKey:input_type -> ocean.v1.SignPsetWithSchnorrKeyRequest
+	2,   // 85: ocean.v1.TransactionService.GetTransaction:output_type -> ocean.v1.GetTransactionResponse
+	4,   // 86: ocean.v1.TransactionService.SelectUtxos:output_type -> ocean.v1.SelectUtxosResponse
+	6,   // 87: ocean.v1.TransactionService.LockUtxos:output_type -> ocean.v1.LockUtxosResponse
+	8,   // 88: ocean.v1.TransactionService.UnlockUtxos:output_type -> ocean.v1.UnlockUtxosResponse
+	10,  // 89: ocean.v1.TransactionService.ExtendLease:output_type -> ocean.v1.ExtendLeaseResponse
+	12,  // 90: ocean.v1.TransactionService.ListLeases:output_type -> ocean.v1.ListLeasesResponse
+	15,  // 91: ocean.v1.TransactionService.EstimateFees:output_type -> ocean.v1.EstimateFeesResponse
+	17,  // 92: ocean.v1.TransactionService.EstimateFeeRate:output_type -> ocean.v1.EstimateFeeRateResponse
+	19,  // 93: ocean.v1.TransactionService.SignTransaction:output_type -> ocean.v1.SignTransactionResponse
+	21,  // 94: ocean.v1.TransactionService.BroadcastTransaction:output_type -> ocean.v1.BroadcastTransactionResponse
+	23,  // 95: ocean.v1.TransactionService.CreatePset:output_type -> ocean.v1.CreatePsetResponse
+	25,  // 96: ocean.v1.TransactionService.UpdatePset:output_type -> ocean.v1.UpdatePsetResponse
+	27,  // 97: ocean.v1.TransactionService.BlindPset:output_type -> ocean.v1.BlindPsetResponse
+	29,  // 98: ocean.v1.TransactionService.SignPset:output_type -> ocean.v1.SignPsetResponse
+	31,  // 99: ocean.v1.TransactionService.DecodePset:output_type -> ocean.v1.DecodePsetResponse
+	33,  // 100: ocean.v1.TransactionService.CombinePsets:output_type -> ocean.v1.CombinePsetsResponse
+	35,  // 101: ocean.v1.TransactionService.FinalizePset:output_type -> ocean.v1.FinalizePsetResponse
+	40,  // 102: ocean.v1.TransactionService.Mint:output_type -> ocean.v1.MintResponse
+	42,  // 103: ocean.v1.TransactionService.Remint:output_type -> ocean.v1.RemintResponse
+	44,  // 104: ocean.v1.TransactionService.Burn:output_type -> ocean.v1.BurnResponse
+	46,  // 105: ocean.v1.TransactionService.Transfer:output_type -> ocean.v1.TransferResponse
+	48,  // 106: ocean.v1.TransactionService.BumpFee:output_type -> ocean.v1.BumpFeeResponse
+	50,  // 107: ocean.v1.TransactionService.Accelerate:output_type -> ocean.v1.AccelerateResponse
+	52,  // 108: ocean.v1.TransactionService.MoveFunds:output_type -> ocean.v1.MoveFundsResponse
+	54,  // 109: ocean.v1.TransactionService.FragmentUtxos:output_type -> ocean.v1.FragmentUtxosResponse
+	56,  // 110: ocean.v1.TransactionService.Consolidate:output_type -> ocean.v1.ConsolidateResponse
+	58,  // 111: ocean.v1.TransactionService.EnqueuePayment:output_type -> ocean.v1.EnqueuePaymentResponse
+	60,  // 112: ocean.v1.TransactionService.CancelPayment:output_type -> ocean.v1.CancelPaymentResponse
+	62,  // 113: ocean.v1.TransactionService.ListQueuedPayments:output_type -> ocean.v1.ListQueuedPaymentsResponse
+	65,  // 114: ocean.v1.TransactionService.CreateSwapOffer:output_type -> ocean.v1.CreateSwapOfferResponse
+	67,  // 115: ocean.v1.TransactionService.AcceptSwap:output_type -> ocean.v1.AcceptSwapResponse
+	69,  // 116: ocean.v1.TransactionService.CompleteSwap:output_type -> ocean.v1.CompleteSwapResponse
+	72,  // 117: ocean.v1.TransactionService.CreateFragment:output_type -> ocean.v1.CreateFragmentResponse
+	74,  // 118: ocean.v1.TransactionService.FillFragments:output_type -> ocean.v1.FillFragmentsResponse
+	77,  // 119: ocean.v1.TransactionService.SetSpendingPolicy:output_type -> ocean.v1.SetSpendingPolicyResponse
+	79,  // 120: ocean.v1.TransactionService.GetSpendingPolicy:output_type -> ocean.v1.GetSpendingPolicyResponse
+	81,  // 121: ocean.v1.TransactionService.DeleteSpendingPolicy:output_type -> ocean.v1.DeleteSpendingPolicyResponse
+	83,  // 122: ocean.v1.TransactionService.ApproveSpending:output_type -> ocean.v1.ApproveSpendingResponse
+	85,  // 123: ocean.v1.TransactionService.ListSpendings:output_type -> ocean.v1.ListSpendingsResponse
+	87,  // 124: ocean.v1.TransactionService.ListPolicyViolations:output_type -> ocean.v1.ListPolicyViolationsResponse
+	92,  // 125: ocean.v1.TransactionService.PegInAddress:output_type -> ocean.v1.PegInAddressResponse
+	94,  // 126: ocean.v1.TransactionService.ClaimPegIn:output_type -> ocean.v1.ClaimPegInResponse
+	96,  // 127: ocean.v1.TransactionService.SignPsetWithSchnorrKey:output_type -> ocean.v1.SignPsetWithSchnorrKeyResponse
+	85,  // [85:128] is the sub-list for method output_type
+	42,  // [42:85] is the sub-list for method input_type
+	42,  // [42:42] is the sub-list for extension type_name
+	42,  // [42:42] is the sub-list for extension extendee
+	0,   // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_ocean_v1_transaction_proto_init() }
+func file_ocean_v1_transaction_proto_init() {
+	if File_ocean_v1_transaction_proto != nil {
+		return
+	}
+	file_ocean_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ocean_v1_transaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeRateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeRateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlindPsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlindPsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignPsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignPsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodePsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodePsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinePsetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinePsetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizePsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizePsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountNetAmounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccelerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccelerateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFundsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFundsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FragmentUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FragmentUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueuePaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueuePaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SignPsetWithSchnorrKeyResponse.ProtoReflect.Descriptor instead.
-func (*SignPsetWithSchnorrKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ocean_v1_transaction_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *SignPsetWithSchnorrKeyResponse) GetSignedTx() string {
-	if x != nil {
-		return x.SignedTx
-	}
-	return ""
-}
-
-var File_ocean_v1_transaction_proto protoreflect.FileDescriptor
-
-var file_ocean_v1_transaction_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6f, 0x63,
-	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x63,
-	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78,
-	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x56, 0x0a, 0x08,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41,
-	0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x42,
-	0x52, 0x41, 0x4e, 0x43, 0x48, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a,
-	0x11, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45,
-	0x4e, 0x54, 0x10, 0x02, 0x22, 0x7c, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74,
-	0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x75,
-	0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x63, 0x65,
-	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x74, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x74, 0x78,
-	0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73,
-	0x22, 0x3c, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x98,
-	0x01, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
-	0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
-	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61,
-	0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x45, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x52, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78,
-	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65,
-	0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x30, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x34, 0x0a, 0x1b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x32, 0x0a, 0x1c,
-	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
-	0x22, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a,
-	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x73, 0x65, 0x74, 0x22, 0x7c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a,
-	0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x22, 0x99, 0x01, 0x0a,
-	0x10, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c,
-	0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x61, 0x73,
-	0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x16, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x5f, 0x75, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x52, 0x14, 0x65, 0x78, 0x74, 0x72, 0x61, 0x55, 0x6e, 0x62, 0x6c, 0x69, 0x6e, 0x64,
-	0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x42, 0x6c, 0x69, 0x6e,
-	0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65,
-	0x74, 0x22, 0x48, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68,
-	0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x53,
-	0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x73, 0x65, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
-	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22,
-	0x25, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c,
-	0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73,
-	0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f,
-	0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78,
-	0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74,
-	0x65, 0x22, 0x25, 0x0a, 0x0c, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12,
-	0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c,
-	0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x29, 0x0a,
-	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x65, 0x67, 0x49,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x8a, 0x01, 0x0a, 0x14, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
-	0x61, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61,
-	0x69, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x77, 0x0a, 0x11,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x78,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x78, 0x4f, 0x75, 0x74, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x2b, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65,
-	0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74,
-	0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48,
-	0x65, 0x78, 0x22, 0x52, 0x0a, 0x1d, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x74, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x68, 0x61,
-	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3d, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73,
-	0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x54, 0x78, 0x32, 0x97, 0x0a, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x65,
-	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46,
-	0x65, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x14, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x63, 0x65, 0x61,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x12,
-	0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f,
-	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65, 0x74,
-	0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x69, 0x6e,
-	0x64, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f,
-	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x50, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x53, 0x69, 0x67,
-	0x6e, 0x50, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x50, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04,
-	0x4d, 0x69, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f, 0x63,
-	0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x2e,
-	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x35, 0x0a, 0x04, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x65,
-	0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x65,
-	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x65, 0x61,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6c, 0x61,
-	0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x50, 0x65, 0x67, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6b, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x2e, 0x6f,
-	0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68,
-	0x6e, 0x6f, 0x72, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0xa9, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x76, 0x75, 0x6c, 0x70, 0x65, 0x6d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f,
-	0x6f, 0x63, 0x65, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x63, 0x65, 0x61,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x63, 0x65, 0x61, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4f,
-	0x58, 0x58, 0xaa, 0x02, 0x08, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08,
-	0x4f, 0x63, 0x65, 0x61, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x4f, 0x63, 0x65, 0x61, 0x6e,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x09, 0x4f, 0x63, 0x65, 0x61, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_ocean_v1_transaction_proto_rawDescOnce sync.Once
-	file_ocean_v1_transaction_proto_rawDescData = file_ocean_v1_transaction_proto_rawDesc
-)
-
-func file_ocean_v1_transaction_proto_rawDescGZIP() []byte {
-	file_ocean_v1_transaction_proto_rawDescOnce.Do(func() {
-		file_ocean_v1_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_ocean_v1_transaction_proto_rawDescData)
-	})
-	return file_ocean_v1_transaction_proto_rawDescData
-}
-
-var file_ocean_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ocean_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_ocean_v1_transaction_proto_goTypes = []interface{}{
-	(SelectUtxosRequest_Strategy)(0),       // 0: ocean.v1.SelectUtxosRequest.Strategy
-	(*GetTransactionRequest)(nil),          // 1: ocean.v1.GetTransactionRequest
-	(*GetTransactionResponse)(nil),         // 2: ocean.v1.GetTransactionResponse
-	(*SelectUtxosRequest)(nil),             // 3: ocean.v1.SelectUtxosRequest
-	(*SelectUtxosResponse)(nil),            // 4: ocean.v1.SelectUtxosResponse
-	(*LockUtxosRequest)(nil),               // 5: ocean.v1.LockUtxosRequest
-	(*LockUtxosResponse)(nil),              // 6: ocean.v1.LockUtxosResponse
-	(*EstimateFeesRequest)(nil),            // 7: ocean.v1.EstimateFeesRequest
-	(*EstimateFeesResponse)(nil),           // 8: ocean.v1.EstimateFeesResponse
-	(*SignTransactionRequest)(nil),         // 9: ocean.v1.SignTransactionRequest
-	(*SignTransactionResponse)(nil),        // 10: ocean.v1.SignTransactionResponse
-	(*BroadcastTransactionRequest)(nil),    // 11: ocean.v1.BroadcastTransactionRequest
-	(*BroadcastTransactionResponse)(nil),   // 12: ocean.v1.BroadcastTransactionResponse
-	(*CreatePsetRequest)(nil),              // 13: ocean.v1.CreatePsetRequest
-	(*CreatePsetResponse)(nil),             // 14: ocean.v1.CreatePsetResponse
-	(*UpdatePsetRequest)(nil),              // 15: ocean.v1.UpdatePsetRequest
-	(*UpdatePsetResponse)(nil),             // 16: ocean.v1.UpdatePsetResponse
-	(*BlindPsetRequest)(nil),               // 17: ocean.v1.BlindPsetRequest
-	(*BlindPsetResponse)(nil),              // 18: ocean.v1.BlindPsetResponse
-	(*SignPsetRequest)(nil),                // 19: ocean.v1.SignPsetRequest
-	(*SignPsetResponse)(nil),               // 20: ocean.v1.SignPsetResponse
-	(*MintRequest)(nil),                    // 21: ocean.v1.MintRequest
-	(*MintResponse)(nil),                   // 22: ocean.v1.MintResponse
-	(*RemintRequest)(nil),                  // 23: ocean.v1.RemintRequest
-	(*RemintResponse)(nil),                 // 24: ocean.v1.RemintResponse
-	(*BurnRequest)(nil),                    // 25: ocean.v1.BurnRequest
-	(*BurnResponse)(nil),                   // 26: ocean.v1.BurnResponse
-	(*TransferRequest)(nil),                // 27: ocean.v1.TransferRequest
-	(*TransferResponse)(nil),               // 28: ocean.v1.TransferResponse
-	(*PegInAddressRequest)(nil),            // 29: ocean.v1.PegInAddressRequest
-	(*PegInAddressResponse)(nil),           // 30: ocean.v1.PegInAddressResponse
-	(*ClaimPegInRequest)(nil),              // 31: ocean.v1.ClaimPegInRequest
-	(*ClaimPegInResponse)(nil),             // 32: ocean.v1.ClaimPegInResponse
-	(*SignPsetWithSchnorrKeyRequest)(nil),  // 33: ocean.v1.SignPsetWithSchnorrKeyRequest
-	(*SignPsetWithSchnorrKeyResponse)(nil), // 34: ocean.v1.SignPsetWithSchnorrKeyResponse
-	(*BlockDetails)(nil),                   // 35: ocean.v1.BlockDetails
-	(*Utxo)(nil),                           // 36: ocean.v1.Utxo
-	(*Input)(nil),                          // 37: ocean.v1.Input
-	(*Output)(nil),                         // 38: ocean.v1.Output
-	(*UnblindedInput)(nil),                 // 39: ocean.v1.UnblindedInput
-}
-var file_ocean_v1_transaction_proto_depIdxs = []int32{
-	35, // 0: ocean.v1.GetTransactionResponse.block_details:type_name -> ocean.v1.BlockDetails
-	0,  // 1: ocean.v1.SelectUtxosRequest.strategy:type_name -> ocean.v1.SelectUtxosRequest.Strategy
-	36, // 2: ocean.v1.SelectUtxosResponse.utxos:type_name -> ocean.v1.Utxo
-	37, // 3: ocean.v1.LockUtxosRequest.utxos:type_name -> ocean.v1.Input
-	37, // 4: ocean.v1.EstimateFeesRequest.inputs:type_name -> ocean.v1.Input
-	38, // 5: ocean.v1.EstimateFeesRequest.outputs:type_name -> ocean.v1.Output
-	37, // 6: ocean.v1.CreatePsetRequest.inputs:type_name -> ocean.v1.Input
-	38, // 7: ocean.v1.CreatePsetRequest.outputs:type_name -> ocean.v1.Output
-	37, // 8: ocean.v1.UpdatePsetRequest.inputs:type_name -> ocean.v1.Input
-	38, // 9: ocean.v1.UpdatePsetRequest.outputs:type_name -> ocean.v1.Output
-	39, // 10: ocean.v1.BlindPsetRequest.extra_unblinded_inputs:type_name -> ocean.v1.UnblindedInput
-	38, // 11: ocean.v1.BurnRequest.receivers:type_name -> ocean.v1.Output
-	38, // 12: ocean.v1.TransferRequest.receivers:type_name -> ocean.v1.Output
-	1,  // 13: ocean.v1.TransactionService.GetTransaction:input_type -> ocean.v1.GetTransactionRequest
-	3,  // 14: ocean.v1.TransactionService.SelectUtxos:input_type -> ocean.v1.SelectUtxosRequest
-	5,  // 15: ocean.v1.TransactionService.LockUtxos:input_type -> ocean.v1.LockUtxosRequest
-	7,  // 16: ocean.v1.TransactionService.EstimateFees:input_type -> ocean.v1.EstimateFeesRequest
-	9,  // 17: ocean.v1.TransactionService.SignTransaction:input_type -> ocean.v1.SignTransactionRequest
-	11, // 18: ocean.v1.TransactionService.BroadcastTransaction:input_type -> ocean.v1.BroadcastTransactionRequest
-	13, // 19: ocean.v1.TransactionService.CreatePset:input_type -> ocean.v1.CreatePsetRequest
-	15, // 20: ocean.v1.TransactionService.UpdatePset:input_type -> ocean.v1.UpdatePsetRequest
-	17, // 21: ocean.v1.TransactionService.BlindPset:input_type -> ocean.v1.BlindPsetRequest
-	19, // 22: ocean.v1.TransactionService.SignPset:input_type -> ocean.v1.SignPsetRequest
-	21, // 23: ocean.v1.TransactionService.Mint:input_type -> ocean.v1.MintRequest
-	23, // 24: ocean.v1.TransactionService.Remint:input_type -> ocean.v1.RemintRequest
-	25, // 25: ocean.v1.TransactionService.Burn:input_type -> ocean.v1.BurnRequest
-	27, // 26: ocean.v1.TransactionService.Transfer:input_type -> ocean.v1.TransferRequest
-	29, // 27: ocean.v1.TransactionService.PegInAddress:input_type -> ocean.v1.PegInAddressRequest
-	31, // 28: ocean.v1.TransactionService.ClaimPegIn:input_type -> ocean.v1.ClaimPegInRequest
-	33, // 29: ocean.v1.TransactionService.SignPsetWithSchnorrKey:input_type -> ocean.v1.SignPsetWithSchnorrKeyRequest
-	2,  // 30: ocean.v1.TransactionService.GetTransaction:output_type -> ocean.v1.GetTransactionResponse
-	4,  // 31: ocean.v1.TransactionService.SelectUtxos:output_type -> ocean.v1.SelectUtxosResponse
-	6,  // 32: ocean.v1.TransactionService.LockUtxos:output_type -> ocean.v1.LockUtxosResponse
-	8,  // 33: ocean.v1.TransactionService.EstimateFees:output_type -> ocean.v1.EstimateFeesResponse
-	10, // 34: ocean.v1.TransactionService.SignTransaction:output_type -> ocean.v1.SignTransactionResponse
-	12, // 35: ocean.v1.TransactionService.BroadcastTransaction:output_type -> ocean.v1.BroadcastTransactionResponse
-	14, // 36: ocean.v1.TransactionService.CreatePset:output_type -> ocean.v1.CreatePsetResponse
-	16, // 37: ocean.v1.TransactionService.UpdatePset:output_type -> ocean.v1.UpdatePsetResponse
-	18, // 38: ocean.v1.TransactionService.BlindPset:output_type -> ocean.v1.BlindPsetResponse
-	20, // 39: ocean.v1.TransactionService.SignPset:output_type -> ocean.v1.SignPsetResponse
-	22, // 40: ocean.v1.TransactionService.Mint:output_type -> ocean.v1.MintResponse
-	24, // 41: ocean.v1.TransactionService.Remint:output_type -> ocean.v1.RemintResponse
-	26, // 42: ocean.v1.TransactionService.Burn:output_type -> ocean.v1.BurnResponse
-	28, // 43: ocean.v1.TransactionService.Transfer:output_type -> ocean.v1.TransferResponse
-	30, // 44: ocean.v1.TransactionService.PegInAddress:output_type -> ocean.v1.PegInAddressResponse
-	32, // 45: ocean.v1.TransactionService.ClaimPegIn:output_type -> ocean.v1.ClaimPegInResponse
-	34, // 46: ocean.v1.TransactionService.SignPsetWithSchnorrKey:output_type -> ocean.v1.SignPsetWithSchnorrKeyResponse
-	30, // [30:47] is the sub-list for method output_type
-	13, // [13:30] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_ocean_v1_transaction_proto_init() }
-func file_ocean_v1_transaction_proto_init() {
-	if File_ocean_v1_transaction_proto != nil {
-		return
-	}
-	file_ocean_v1_types_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_ocean_v1_transaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2421,8 +7970,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueuedPaymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2433,8 +7982,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectUtxosRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueuedPaymentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2445,8 +7994,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectUtxosResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedPayment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2457,8 +8006,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockUtxosRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSwapOfferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2469,8 +8018,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockUtxosResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSwapOfferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2481,8 +8030,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateFeesRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptSwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2493,8 +8042,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateFeesResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptSwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2505,8 +8054,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignTransactionRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteSwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2517,8 +8066,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignTransactionResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteSwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2529,8 +8078,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTransactionRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapOffer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2541,8 +8090,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTransactionResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFragmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2553,8 +8102,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFragmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2565,8 +8114,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillFragmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2577,8 +8126,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FillFragmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2589,8 +8138,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fragment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2601,8 +8150,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlindPsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2613,8 +8162,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlindPsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2625,8 +8174,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignPsetRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2637,8 +8186,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignPsetResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2649,8 +8198,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSpendingPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2661,8 +8210,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSpendingPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2673,8 +8222,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemintRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSpendingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2685,8 +8234,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemintResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSpendingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2697,8 +8246,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurnRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSpendingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2709,8 +8258,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurnResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSpendingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2721,8 +8270,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferRequest); i {
+		file_ocean_v1_transaction_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPolicyViolationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2733,8 +8282,8 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferResponse); i {
+		file_ocean_v1_transaction_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPolicyViolationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2745,7 +8294,43 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetSpendingLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Spending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocean_v1_transaction_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PegInAddressRequest); i {
 			case 0:
 				return &v.state
@@ -2757,7 +8342,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PegInAddressResponse); i {
 			case 0:
 				return &v.state
@@ -2769,7 +8354,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimPegInRequest); i {
 			case 0:
 				return &v.state
@@ -2781,7 +8366,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimPegInResponse); i {
 			case 0:
 				return &v.state
@@ -2793,7 +8378,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignPsetWithSchnorrKeyRequest); i {
 			case 0:
 				return &v.state
@@ -2805,7 +8390,7 @@ func file_ocean_v1_transaction_proto_init() {
 				return nil
 			}
 		}
-		file_ocean_v1_transaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_ocean_v1_transaction_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignPsetWithSchnorrKeyResponse); i {
 			case 0:
 				return &v.state
@@ -2818,13 +8403,16 @@ func file_ocean_v1_transaction_proto_init() {
 			}
 		}
 	}
+	file_ocean_v1_transaction_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_ocean_v1_transaction_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_ocean_v1_transaction_proto_msgTypes[52].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ocean_v1_transaction_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   34,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,10 +27,22 @@ type TransactionServiceClient interface {
 	SelectUtxos(ctx context.Context, in *SelectUtxosRequest, opts ...grpc.CallOption) (*SelectUtxosResponse, error)
 	// LockUtxos allows to manually select utxos to spend by a subsequent tx.
 	LockUtxos(ctx context.Context, in *LockUtxosRequest, opts ...grpc.CallOption) (*LockUtxosResponse, error)
+	// UnlockUtxos releases the utxos locked by a lease before it expires.
+	// Only who knows the lease id is allowed to release them.
+	UnlockUtxos(ctx context.Context, in *UnlockUtxosRequest, opts ...grpc.CallOption) (*UnlockUtxosResponse, error)
+	// ExtendLease postpones the expiration of the utxos locked by a lease.
+	ExtendLease(ctx context.Context, in *ExtendLeaseRequest, opts ...grpc.CallOption) (*ExtendLeaseResponse, error)
+	// ListLeases returns the list of active leases.
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error)
 	// EstimateFees returns the fee amount to pay for a tx containing the given
 	// inputs and outputs.
 	EstimateFees(ctx context.Context, in *EstimateFeesRequest, opts ...grpc.CallOption) (*EstimateFeesResponse, error)
+	// EstimateFeeRate returns the fee rate required for a tx to be confirmed
+	// within the given number of blocks, as estimated by the blockchain backend.
+	EstimateFeeRate(ctx context.Context, in *EstimateFeeRateRequest, opts ...grpc.CallOption) (*EstimateFeeRateResponse, error)
 	// SignTransaction signs a raw transaction in hex format.
+	// It's refused if the fee rate exceeds the sanity ceiling the daemon is
+	// configured with, unless the fee guard is explicitly overridden.
 	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
 	// BroadcastTransaction broadacats a raw transaction in hex format.
 	BroadcastTransaction(ctx context.Context, in *BroadcastTransactionRequest, opts ...grpc.CallOption) (*BroadcastTransactionResponse, error)
@@ -41,7 +53,21 @@ type TransactionServiceClient interface {
 	// BlindPset updates the given pset with required ins and outs blinded.
 	BlindPset(ctx context.Context, in *BlindPsetRequest, opts ...grpc.CallOption) (*BlindPsetResponse, error)
 	// SignPset updates the given pset adding the required signatures.
+	// It's refused if the fee rate exceeds the sanity ceiling the daemon is
+	// configured with, unless the fee guard is explicitly overridden.
 	SignPset(ctx context.Context, in *SignPsetRequest, opts ...grpc.CallOption) (*SignPsetResponse, error)
+	// DecodePset returns the inputs and outputs of the given pset, revealing and
+	// attributing to the accounts those owned by the wallet, along with the net
+	// change of the balance of each account, the fee amount and the status of
+	// signatures and blinding.
+	DecodePset(ctx context.Context, in *DecodePsetRequest, opts ...grpc.CallOption) (*DecodePsetResponse, error)
+	// CombinePsets merges signatures, blinding data and derivation info of
+	// copies of the same pset updated by different parties. It fails if the
+	// copies refer to different transactions or contain conflicting data.
+	CombinePsets(ctx context.Context, in *CombinePsetsRequest, opts ...grpc.CallOption) (*CombinePsetsResponse, error)
+	// FinalizePset finalizes the inputs of the given pset, including those
+	// locked by multisig and template scripts, and returns the extracted tx.
+	FinalizePset(ctx context.Context, in *FinalizePsetRequest, opts ...grpc.CallOption) (*FinalizePsetResponse, error)
 	// Mint returns a transaction that issues a new asset.
 	Mint(ctx context.Context, in *MintRequest, opts ...grpc.CallOption) (*MintResponse, error)
 	// Remint returns a transaction that re-issues an existing asset.
@@ -50,6 +76,77 @@ type TransactionServiceClient interface {
 	Burn(ctx context.Context, in *BurnRequest, opts ...grpc.CallOption) (*BurnResponse, error)
 	// Transfer returns a transaction to send funds to some receiver.
 	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	// BumpFee replaces an unconfirmed transaction returned by Transfer with one
+	// paying a higher fee rate. The replacement is signed and broadcasted.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	// Accelerate spends the unconfirmed outputs of a transaction owned by the
+	// wallet with a child transaction paying for both (CPFP). The child is
+	// signed and broadcasted.
+	Accelerate(ctx context.Context, in *AccelerateRequest, opts ...grpc.CallOption) (*AccelerateResponse, error)
+	// MoveFunds sends some funds from an account to another one of the wallet,
+	// to an address derived for the target account. The output is confidential
+	// unless the target is an unconfidential account. The tx is broadcasted and
+	// shows as internal in the history of both accounts.
+	MoveFunds(ctx context.Context, in *MoveFundsRequest, opts ...grpc.CallOption) (*MoveFundsResponse, error)
+	// FragmentUtxos returns a transaction that splits the balance of an asset
+	// owned by an account into many outputs sent to fresh addresses of the same
+	// account.
+	FragmentUtxos(ctx context.Context, in *FragmentUtxosRequest, opts ...grpc.CallOption) (*FragmentUtxosResponse, error)
+	// Consolidate returns a transaction that sweeps the smallest utxos of an
+	// asset owned by an account into one output sent to a fresh address of the
+	// same account.
+	Consolidate(ctx context.Context, in *ConsolidateRequest, opts ...grpc.CallOption) (*ConsolidateResponse, error)
+	// EnqueuePayment adds a payment to the queue of an account. The queued
+	// payments are periodically sent with a single batched transaction, see
+	// NotificationService.PaymentNotifications to get the txid of a payment.
+	EnqueuePayment(ctx context.Context, in *EnqueuePaymentRequest, opts ...grpc.CallOption) (*EnqueuePaymentResponse, error)
+	// CancelPayment removes a payment from the queue before it's sent, or a
+	// failed one.
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error)
+	// ListQueuedPayments returns the payments not sent yet.
+	ListQueuedPayments(ctx context.Context, in *ListQueuedPaymentsRequest, opts ...grpc.CallOption) (*ListQueuedPaymentsResponse, error)
+	// CreateSwapOffer returns a partial transaction where an account gives an
+	// amount of an asset in exchange for an amount of another one. The spent
+	// utxos are locked for the lifetime of the swap offer.
+	CreateSwapOffer(ctx context.Context, in *CreateSwapOfferRequest, opts ...grpc.CallOption) (*CreateSwapOfferResponse, error)
+	// AcceptSwap adds the inputs and outputs of an account to the partial
+	// transaction of a swap offer, paying for the wanted amount and the network
+	// fees. The spent utxos are locked for the lifetime of the swap.
+	AcceptSwap(ctx context.Context, in *AcceptSwapRequest, opts ...grpc.CallOption) (*AcceptSwapResponse, error)
+	// CompleteSwap validates the partial transaction of a swap against its
+	// terms, blinds it if necessary and signs the inputs of the account.
+	// The final tx is returned once all inputs are signed.
+	CompleteSwap(ctx context.Context, in *CompleteSwapRequest, opts ...grpc.CallOption) (*CompleteSwapResponse, error)
+	// CreateFragment returns a partial transaction where an account spends a
+	// utxo in exchange for an output at the same index, signed with
+	// SIGHASH_SINGLE|ANYONECANPAY so that anyone can fill the order.
+	// The spent utxo is locked for the lifetime of the fragment.
+	CreateFragment(ctx context.Context, in *CreateFragmentRequest, opts ...grpc.CallOption) (*CreateFragmentResponse, error)
+	// FillFragments verifies and merges the given fragments into a transaction
+	// funded by an account that pays the wanted amounts and the network fees.
+	FillFragments(ctx context.Context, in *FillFragmentsRequest, opts ...grpc.CallOption) (*FillFragmentsResponse, error)
+	// SetSpendingPolicy sets or replaces the spending policy of an account.
+	// Any transaction or partial transaction spending funds of the account is
+	// checked against its policy before being signed, and is refused if any
+	// rule is violated. Requires the wallet password.
+	SetSpendingPolicy(ctx context.Context, in *SetSpendingPolicyRequest, opts ...grpc.CallOption) (*SetSpendingPolicyResponse, error)
+	// GetSpendingPolicy returns the spending policy of an account.
+	GetSpendingPolicy(ctx context.Context, in *GetSpendingPolicyRequest, opts ...grpc.CallOption) (*GetSpendingPolicyResponse, error)
+	// DeleteSpendingPolicy removes the spending policy of an account.
+	// Requires the wallet password.
+	DeleteSpendingPolicy(ctx context.Context, in *DeleteSpendingPolicyRequest, opts ...grpc.CallOption) (*DeleteSpendingPolicyResponse, error)
+	// ApproveSpending adds an approval to a spending pending for approval.
+	// The approver must be registered in the spending policy of the account and
+	// must sign the digest of the spending.
+	// Once approved by the number of approvers required by the policy, the
+	// same transaction can be signed within the spending window of 24 hours.
+	ApproveSpending(ctx context.Context, in *ApproveSpendingRequest, opts ...grpc.CallOption) (*ApproveSpendingResponse, error)
+	// ListSpendings returns the spendings of an account of the last 24 hours,
+	// either signed or pending for approval.
+	ListSpendings(ctx context.Context, in *ListSpendingsRequest, opts ...grpc.CallOption) (*ListSpendingsResponse, error)
+	// ListPolicyViolations returns the audit log of the attempts to spend funds
+	// of an account refused because violating its spending policy.
+	ListPolicyViolations(ctx context.Context, in *ListPolicyViolationsRequest, opts ...grpc.CallOption) (*ListPolicyViolationsResponse, error)
 	// PegInAddress returns what's necessary to peg funds of the Bitcoin
 	// main-chain and have them available on the Liquid side-chain.
 	// Bitcoin funds must be sent to the main-chain address while the claim
@@ -98,6 +195,33 @@ func (c *transactionServiceClient) LockUtxos(ctx context.Context, in *LockUtxosR
 	return out, nil
 }
 
+func (c *transactionServiceClient) UnlockUtxos(ctx context.Context, in *UnlockUtxosRequest, opts ...grpc.CallOption) (*UnlockUtxosResponse, error) {
+	out := new(UnlockUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/UnlockUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ExtendLease(ctx context.Context, in *ExtendLeaseRequest, opts ...grpc.CallOption) (*ExtendLeaseResponse, error) {
+	out := new(ExtendLeaseResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ExtendLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error) {
+	out := new(ListLeasesResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ListLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) EstimateFees(ctx context.Context, in *EstimateFeesRequest, opts ...grpc.CallOption) (*EstimateFeesResponse, error) {
 	out := new(EstimateFeesResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/EstimateFees", in, out, opts...)
@@ -107,6 +231,15 @@ func (c *transactionServiceClient) EstimateFees(ctx context.Context, in *Estimat
 	return out, nil
 }
 
+func (c *transactionServiceClient) EstimateFeeRate(ctx context.Context, in *EstimateFeeRateRequest, opts ...grpc.CallOption) (*EstimateFeeRateResponse, error) {
+	out := new(EstimateFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/EstimateFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
 	out := new(SignTransactionResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/SignTransaction", in, out, opts...)
@@ -161,6 +294,33 @@ func (c *transactionServiceClient) SignPset(ctx context.Context, in *SignPsetReq
 	return out, nil
 }
 
+func (c *transactionServiceClient) DecodePset(ctx context.Context, in *DecodePsetRequest, opts ...grpc.CallOption) (*DecodePsetResponse, error) {
+	out := new(DecodePsetResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/DecodePset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CombinePsets(ctx context.Context, in *CombinePsetsRequest, opts ...grpc.CallOption) (*CombinePsetsResponse, error) {
+	out := new(CombinePsetsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/CombinePsets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FinalizePset(ctx context.Context, in *FinalizePsetRequest, opts ...grpc.CallOption) (*FinalizePsetResponse, error) {
+	out := new(FinalizePsetResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/FinalizePset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) Mint(ctx context.Context, in *MintRequest, opts ...grpc.CallOption) (*MintResponse, error) {
 	out := new(MintResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/Mint", in, out, opts...)
@@ -197,6 +357,177 @@ func (c *transactionServiceClient) Transfer(ctx context.Context, in *TransferReq
 	return out, nil
 }
 
+func (c *transactionServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) Accelerate(ctx context.Context, in *AccelerateRequest, opts ...grpc.CallOption) (*AccelerateResponse, error) {
+	out := new(AccelerateResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/Accelerate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) MoveFunds(ctx context.Context, in *MoveFundsRequest, opts ...grpc.CallOption) (*MoveFundsResponse, error) {
+	out := new(MoveFundsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/MoveFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FragmentUtxos(ctx context.Context, in *FragmentUtxosRequest, opts ...grpc.CallOption) (*FragmentUtxosResponse, error) {
+	out := new(FragmentUtxosResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/FragmentUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) Consolidate(ctx context.Context, in *ConsolidateRequest, opts ...grpc.CallOption) (*ConsolidateResponse, error) {
+	out := new(ConsolidateResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/Consolidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) EnqueuePayment(ctx context.Context, in *EnqueuePaymentRequest, opts ...grpc.CallOption) (*EnqueuePaymentResponse, error) {
+	out := new(EnqueuePaymentResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/EnqueuePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*CancelPaymentResponse, error) {
+	out := new(CancelPaymentResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/CancelPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListQueuedPayments(ctx context.Context, in *ListQueuedPaymentsRequest, opts ...grpc.CallOption) (*ListQueuedPaymentsResponse, error) {
+	out := new(ListQueuedPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ListQueuedPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateSwapOffer(ctx context.Context, in *CreateSwapOfferRequest, opts ...grpc.CallOption) (*CreateSwapOfferResponse, error) {
+	out := new(CreateSwapOfferResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/CreateSwapOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) AcceptSwap(ctx context.Context, in *AcceptSwapRequest, opts ...grpc.CallOption) (*AcceptSwapResponse, error) {
+	out := new(AcceptSwapResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/AcceptSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CompleteSwap(ctx context.Context, in *CompleteSwapRequest, opts ...grpc.CallOption) (*CompleteSwapResponse, error) {
+	out := new(CompleteSwapResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/CompleteSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateFragment(ctx context.Context, in *CreateFragmentRequest, opts ...grpc.CallOption) (*CreateFragmentResponse, error) {
+	out := new(CreateFragmentResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/CreateFragment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FillFragments(ctx context.Context, in *FillFragmentsRequest, opts ...grpc.CallOption) (*FillFragmentsResponse, error) {
+	out := new(FillFragmentsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/FillFragments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SetSpendingPolicy(ctx context.Context, in *SetSpendingPolicyRequest, opts ...grpc.CallOption) (*SetSpendingPolicyResponse, error) {
+	out := new(SetSpendingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/SetSpendingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetSpendingPolicy(ctx context.Context, in *GetSpendingPolicyRequest, opts ...grpc.CallOption) (*GetSpendingPolicyResponse, error) {
+	out := new(GetSpendingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/GetSpendingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteSpendingPolicy(ctx context.Context, in *DeleteSpendingPolicyRequest, opts ...grpc.CallOption) (*DeleteSpendingPolicyResponse, error) {
+	out := new(DeleteSpendingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/DeleteSpendingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ApproveSpending(ctx context.Context, in *ApproveSpendingRequest, opts ...grpc.CallOption) (*ApproveSpendingResponse, error) {
+	out := new(ApproveSpendingResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ApproveSpending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListSpendings(ctx context.Context, in *ListSpendingsRequest, opts ...grpc.CallOption) (*ListSpendingsResponse, error) {
+	out := new(ListSpendingsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ListSpendings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListPolicyViolations(ctx context.Context, in *ListPolicyViolationsRequest, opts ...grpc.CallOption) (*ListPolicyViolationsResponse, error) {
+	out := new(ListPolicyViolationsResponse)
+	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/ListPolicyViolations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) PegInAddress(ctx context.Context, in *PegInAddressRequest, opts ...grpc.CallOption) (*PegInAddressResponse, error) {
 	out := new(PegInAddressResponse)
 	err := c.cc.Invoke(ctx, "/ocean.v1.TransactionService/PegInAddress", in, out, opts...)
@@ -237,10 +568,22 @@ type TransactionServiceServer interface {
 	SelectUtxos(context.Context, *SelectUtxosRequest) (*SelectUtxosResponse, error)
 	// LockUtxos allows to manually select utxos to spend by a subsequent tx.
 	LockUtxos(context.Context, *LockUtxosRequest) (*LockUtxosResponse, error)
+	// UnlockUtxos releases the utxos locked by a lease before it expires.
+	// Only who knows the lease id is allowed to release them.
+	UnlockUtxos(context.Context, *UnlockUtxosRequest) (*UnlockUtxosResponse, error)
+	// ExtendLease postpones the expiration of the utxos locked by a lease.
+	ExtendLease(context.Context, *ExtendLeaseRequest) (*ExtendLeaseResponse, error)
+	// ListLeases returns the list of active leases.
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error)
 	// EstimateFees returns the fee amount to pay for a tx containing the given
 	// inputs and outputs.
 	EstimateFees(context.Context, *EstimateFeesRequest) (*EstimateFeesResponse, error)
+	// EstimateFeeRate returns the fee rate required for a tx to be confirmed
+	// within the given number of blocks, as estimated by the blockchain backend.
+	EstimateFeeRate(context.Context, *EstimateFeeRateRequest) (*EstimateFeeRateResponse, error)
 	// SignTransaction signs a raw transaction in hex format.
+	// It's refused if the fee rate exceeds the sanity ceiling the daemon is
+	// configured with, unless the fee guard is explicitly overridden.
 	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
 	// BroadcastTransaction broadacats a raw transaction in hex format.
 	BroadcastTransaction(context.Context, *BroadcastTransactionRequest) (*BroadcastTransactionResponse, error)
@@ -251,7 +594,21 @@ type TransactionServiceServer interface {
 	// BlindPset updates the given pset with required ins and outs blinded.
 	BlindPset(context.Context, *BlindPsetRequest) (*BlindPsetResponse, error)
 	// SignPset updates the given pset adding the required signatures.
+	// It's refused if the fee rate exceeds the sanity ceiling the daemon is
+	// configured with, unless the fee guard is explicitly overridden.
 	SignPset(context.Context, *SignPsetRequest) (*SignPsetResponse, error)
+	// DecodePset returns the inputs and outputs of the given pset, revealing and
+	// attributing to the accounts those owned by the wallet, along with the net
+	// change of the balance of each account, the fee amount and the status of
+	// signatures and blinding.
+	DecodePset(context.Context, *DecodePsetRequest) (*DecodePsetResponse, error)
+	// CombinePsets merges signatures, blinding data and derivation info of
+	// copies of the same pset updated by different parties. It fails if the
+	// copies refer to different transactions or contain conflicting data.
+	CombinePsets(context.Context, *CombinePsetsRequest) (*CombinePsetsResponse, error)
+	// FinalizePset finalizes the inputs of the given pset, including those
+	// locked by multisig and template scripts, and returns the extracted tx.
+	FinalizePset(context.Context, *FinalizePsetRequest) (*FinalizePsetResponse, error)
 	// Mint returns a transaction that issues a new asset.
 	Mint(context.Context, *MintRequest) (*MintResponse, error)
 	// Remint returns a transaction that re-issues an existing asset.
@@ -260,6 +617,77 @@ type TransactionServiceServer interface {
 	Burn(context.Context, *BurnRequest) (*BurnResponse, error)
 	// Transfer returns a transaction to send funds to some receiver.
 	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// BumpFee replaces an unconfirmed transaction returned by Transfer with one
+	// paying a higher fee rate. The replacement is signed and broadcasted.
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	// Accelerate spends the unconfirmed outputs of a transaction owned by the
+	// wallet with a child transaction paying for both (CPFP). The child is
+	// signed and broadcasted.
+	Accelerate(context.Context, *AccelerateRequest) (*AccelerateResponse, error)
+	// MoveFunds sends some funds from an account to another one of the wallet,
+	// to an address derived for the target account. The output is confidential
+	// unless the target is an unconfidential account. The tx is broadcasted and
+	// shows as internal in the history of both accounts.
+	MoveFunds(context.Context, *MoveFundsRequest) (*MoveFundsResponse, error)
+	// FragmentUtxos returns a transaction that splits the balance of an asset
+	// owned by an account into many outputs sent to fresh addresses of the same
+	// account.
+	FragmentUtxos(context.Context, *FragmentUtxosRequest) (*FragmentUtxosResponse, error)
+	// Consolidate returns a transaction that sweeps the smallest utxos of an
+	// asset owned by an account into one output sent to a fresh address of the
+	// same account.
+	Consolidate(context.Context, *ConsolidateRequest) (*ConsolidateResponse, error)
+	// EnqueuePayment adds a payment to the queue of an account. The queued
+	// payments are periodically sent with a single batched transaction, see
+	// NotificationService.PaymentNotifications to get the txid of a payment.
+	EnqueuePayment(context.Context, *EnqueuePaymentRequest) (*EnqueuePaymentResponse, error)
+	// CancelPayment removes a payment from the queue before it's sent, or a
+	// failed one.
+	CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error)
+	// ListQueuedPayments returns the payments not sent yet.
+	ListQueuedPayments(context.Context, *ListQueuedPaymentsRequest) (*ListQueuedPaymentsResponse, error)
+	// CreateSwapOffer returns a partial transaction where an account gives an
+	// amount of an asset in exchange for an amount of another one. The spent
+	// utxos are locked for the lifetime of the swap offer.
+	CreateSwapOffer(context.Context, *CreateSwapOfferRequest) (*CreateSwapOfferResponse, error)
+	// AcceptSwap adds the inputs and outputs of an account to the partial
+	// transaction of a swap offer, paying for the wanted amount and the network
+	// fees. The spent utxos are locked for the lifetime of the swap.
+	AcceptSwap(context.Context, *AcceptSwapRequest) (*AcceptSwapResponse, error)
+	// CompleteSwap validates the partial transaction of a swap against its
+	// terms, blinds it if necessary and signs the inputs of the account.
+	// The final tx is returned once all inputs are signed.
+	CompleteSwap(context.Context, *CompleteSwapRequest) (*CompleteSwapResponse, error)
+	// CreateFragment returns a partial transaction where an account spends a
+	// utxo in exchange for an output at the same index, signed with
+	// SIGHASH_SINGLE|ANYONECANPAY so that anyone can fill the order.
+	// The spent utxo is locked for the lifetime of the fragment.
+	CreateFragment(context.Context, *CreateFragmentRequest) (*CreateFragmentResponse, error)
+	// FillFragments verifies and merges the given fragments into a transaction
+	// funded by an account that pays the wanted amounts and the network fees.
+	FillFragments(context.Context, *FillFragmentsRequest) (*FillFragmentsResponse, error)
+	// SetSpendingPolicy sets or replaces the spending policy of an account.
+	// Any transaction or partial transaction spending funds of the account is
+	// checked against its policy before being signed, and is refused if any
+	// rule is violated. Requires the wallet password.
+	SetSpendingPolicy(context.Context, *SetSpendingPolicyRequest) (*SetSpendingPolicyResponse, error)
+	// GetSpendingPolicy returns the spending policy of an account.
+	GetSpendingPolicy(context.Context, *GetSpendingPolicyRequest) (*GetSpendingPolicyResponse, error)
+	// DeleteSpendingPolicy removes the spending policy of an account.
+	// Requires the wallet password.
+	DeleteSpendingPolicy(context.Context, *DeleteSpendingPolicyRequest) (*DeleteSpendingPolicyResponse, error)
+	// ApproveSpending adds an approval to a spending pending for approval.
+	// The approver must be registered in the spending policy of the account and
+	// must sign the digest of the spending.
+	// Once approved by the number of approvers required by the policy, the
+	// same transaction can be signed within the spending window of 24 hours.
+	ApproveSpending(context.Context, *ApproveSpendingRequest) (*ApproveSpendingResponse, error)
+	// ListSpendings returns the spendings of an account of the last 24 hours,
+	// either signed or pending for approval.
+	ListSpendings(context.Context, *ListSpendingsRequest) (*ListSpendingsResponse, error)
+	// ListPolicyViolations returns the audit log of the attempts to spend funds
+	// of an account refused because violating its spending policy.
+	ListPolicyViolations(context.Context, *ListPolicyViolationsRequest) (*ListPolicyViolationsResponse, error)
 	// PegInAddress returns what's necessary to peg funds of the Bitcoin
 	// main-chain and have them available on the Liquid side-chain.
 	// Bitcoin funds must be sent to the main-chain address while the claim
@@ -286,9 +714,21 @@ func (UnimplementedTransactionServiceServer) SelectUtxos(context.Context, *Selec
 func (UnimplementedTransactionServiceServer) LockUtxos(context.Context, *LockUtxosRequest) (*LockUtxosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockUtxos not implemented")
 }
+func (UnimplementedTransactionServiceServer) UnlockUtxos(context.Context, *UnlockUtxosRequest) (*UnlockUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUtxos not implemented")
+}
+func (UnimplementedTransactionServiceServer) ExtendLease(context.Context, *ExtendLeaseRequest) (*ExtendLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLease not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
+}
 func (UnimplementedTransactionServiceServer) EstimateFees(context.Context, *EstimateFeesRequest) (*EstimateFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateFees not implemented")
 }
+func (UnimplementedTransactionServiceServer) EstimateFeeRate(context.Context, *EstimateFeeRateRequest) (*EstimateFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFeeRate not implemented")
+}
 func (UnimplementedTransactionServiceServer) SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
 }
@@ -307,6 +747,15 @@ func (UnimplementedTransactionServiceServer) BlindPset(context.Context, *BlindPs
 func (UnimplementedTransactionServiceServer) SignPset(context.Context, *SignPsetRequest) (*SignPsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignPset not implemented")
 }
+func (UnimplementedTransactionServiceServer) DecodePset(context.Context, *DecodePsetRequest) (*DecodePsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodePset not implemented")
+}
+func (UnimplementedTransactionServiceServer) CombinePsets(context.Context, *CombinePsetsRequest) (*CombinePsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CombinePsets not implemented")
+}
+func (UnimplementedTransactionServiceServer) FinalizePset(context.Context, *FinalizePsetRequest) (*FinalizePsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePset not implemented")
+}
 func (UnimplementedTransactionServiceServer) Mint(context.Context, *MintRequest) (*MintResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
 }
@@ -319,6 +768,63 @@ func (UnimplementedTransactionServiceServer) Burn(context.Context, *BurnRequest)
 func (UnimplementedTransactionServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
 }
+func (UnimplementedTransactionServiceServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (UnimplementedTransactionServiceServer) Accelerate(context.Context, *AccelerateRequest) (*AccelerateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accelerate not implemented")
+}
+func (UnimplementedTransactionServiceServer) MoveFunds(context.Context, *MoveFundsRequest) (*MoveFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFunds not implemented")
+}
+func (UnimplementedTransactionServiceServer) FragmentUtxos(context.Context, *FragmentUtxosRequest) (*FragmentUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FragmentUtxos not implemented")
+}
+func (UnimplementedTransactionServiceServer) Consolidate(context.Context, *ConsolidateRequest) (*ConsolidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consolidate not implemented")
+}
+func (UnimplementedTransactionServiceServer) EnqueuePayment(context.Context, *EnqueuePaymentRequest) (*EnqueuePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueuePayment not implemented")
+}
+func (UnimplementedTransactionServiceServer) CancelPayment(context.Context, *CancelPaymentRequest) (*CancelPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayment not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListQueuedPayments(context.Context, *ListQueuedPaymentsRequest) (*ListQueuedPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueuedPayments not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateSwapOffer(context.Context, *CreateSwapOfferRequest) (*CreateSwapOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwapOffer not implemented")
+}
+func (UnimplementedTransactionServiceServer) AcceptSwap(context.Context, *AcceptSwapRequest) (*AcceptSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwap not implemented")
+}
+func (UnimplementedTransactionServiceServer) CompleteSwap(context.Context, *CompleteSwapRequest) (*CompleteSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSwap not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateFragment(context.Context, *CreateFragmentRequest) (*CreateFragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFragment not implemented")
+}
+func (UnimplementedTransactionServiceServer) FillFragments(context.Context, *FillFragmentsRequest) (*FillFragmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillFragments not implemented")
+}
+func (UnimplementedTransactionServiceServer) SetSpendingPolicy(context.Context, *SetSpendingPolicyRequest) (*SetSpendingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingPolicy not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetSpendingPolicy(context.Context, *GetSpendingPolicyRequest) (*GetSpendingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingPolicy not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteSpendingPolicy(context.Context, *DeleteSpendingPolicyRequest) (*DeleteSpendingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpendingPolicy not implemented")
+}
+func (UnimplementedTransactionServiceServer) ApproveSpending(context.Context, *ApproveSpendingRequest) (*ApproveSpendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSpending not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListSpendings(context.Context, *ListSpendingsRequest) (*ListSpendingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpendings not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListPolicyViolations(context.Context, *ListPolicyViolationsRequest) (*ListPolicyViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyViolations not implemented")
+}
 func (UnimplementedTransactionServiceServer) PegInAddress(context.Context, *PegInAddressRequest) (*PegInAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PegInAddress not implemented")
 }
@@ -394,6 +900,60 @@ func _TransactionService_LockUtxos_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_UnlockUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UnlockUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/UnlockUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UnlockUtxos(ctx, req.(*UnlockUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ExtendLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ExtendLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ExtendLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ExtendLease(ctx, req.(*ExtendLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ListLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListLeases(ctx, req.(*ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_EstimateFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EstimateFeesRequest)
 	if err := dec(in); err != nil {
@@ -412,6 +972,24 @@ func _TransactionService_EstimateFees_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_EstimateFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).EstimateFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/EstimateFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).EstimateFeeRate(ctx, req.(*EstimateFeeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignTransactionRequest)
 	if err := dec(in); err != nil {
@@ -520,71 +1098,125 @@ func _TransactionService_SignPset_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransactionService_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MintRequest)
+func _TransactionService_DecodePset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodePsetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransactionServiceServer).Mint(ctx, in)
+		return srv.(TransactionServiceServer).DecodePset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ocean.v1.TransactionService/Mint",
+		FullMethod: "/ocean.v1.TransactionService/DecodePset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionServiceServer).Mint(ctx, req.(*MintRequest))
+		return srv.(TransactionServiceServer).DecodePset(ctx, req.(*DecodePsetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransactionService_Remint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemintRequest)
+func _TransactionService_CombinePsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinePsetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransactionServiceServer).Remint(ctx, in)
+		return srv.(TransactionServiceServer).CombinePsets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ocean.v1.TransactionService/Remint",
+		FullMethod: "/ocean.v1.TransactionService/CombinePsets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionServiceServer).Remint(ctx, req.(*RemintRequest))
+		return srv.(TransactionServiceServer).CombinePsets(ctx, req.(*CombinePsetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransactionService_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BurnRequest)
+func _TransactionService_FinalizePset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransactionServiceServer).Burn(ctx, in)
+		return srv.(TransactionServiceServer).FinalizePset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ocean.v1.TransactionService/Burn",
+		FullMethod: "/ocean.v1.TransactionService/FinalizePset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionServiceServer).Burn(ctx, req.(*BurnRequest))
+		return srv.(TransactionServiceServer).FinalizePset(ctx, req.(*FinalizePsetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransactionService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferRequest)
+func _TransactionService_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransactionServiceServer).Transfer(ctx, in)
+		return srv.(TransactionServiceServer).Mint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ocean.v1.TransactionService/Transfer",
+		FullMethod: "/ocean.v1.TransactionService/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Mint(ctx, req.(*MintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Remint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Remint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/Remint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Remint(ctx, req.(*RemintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Burn(ctx, req.(*BurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/Transfer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(TransactionServiceServer).Transfer(ctx, req.(*TransferRequest))
@@ -592,6 +1224,348 @@ func _TransactionService_Transfer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Accelerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccelerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Accelerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/Accelerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Accelerate(ctx, req.(*AccelerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_MoveFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).MoveFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/MoveFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).MoveFunds(ctx, req.(*MoveFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_FragmentUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FragmentUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FragmentUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/FragmentUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FragmentUtxos(ctx, req.(*FragmentUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_Consolidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).Consolidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/Consolidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).Consolidate(ctx, req.(*ConsolidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_EnqueuePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueuePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).EnqueuePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/EnqueuePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).EnqueuePayment(ctx, req.(*EnqueuePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CancelPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/CancelPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListQueuedPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuedPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListQueuedPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ListQueuedPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListQueuedPayments(ctx, req.(*ListQueuedPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateSwapOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSwapOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateSwapOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/CreateSwapOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateSwapOffer(ctx, req.(*CreateSwapOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_AcceptSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).AcceptSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/AcceptSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).AcceptSwap(ctx, req.(*AcceptSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CompleteSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CompleteSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/CompleteSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CompleteSwap(ctx, req.(*CompleteSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateFragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFragmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateFragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/CreateFragment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateFragment(ctx, req.(*CreateFragmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_FillFragments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillFragmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FillFragments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/FillFragments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FillFragments(ctx, req.(*FillFragmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SetSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/SetSpendingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetSpendingPolicy(ctx, req.(*SetSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/GetSpendingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetSpendingPolicy(ctx, req.(*GetSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/DeleteSpendingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteSpendingPolicy(ctx, req.(*DeleteSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ApproveSpending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSpendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ApproveSpending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ApproveSpending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ApproveSpending(ctx, req.(*ApproveSpendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListSpendings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpendingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListSpendings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ListSpendings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListSpendings(ctx, req.(*ListSpendingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListPolicyViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListPolicyViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocean.v1.TransactionService/ListPolicyViolations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListPolicyViolations(ctx, req.(*ListPolicyViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_PegInAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PegInAddressRequest)
 	if err := dec(in); err != nil {
@@ -665,10 +1639,26 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LockUtxos",
 			Handler:    _TransactionService_LockUtxos_Handler,
 		},
+		{
+			MethodName: "UnlockUtxos",
+			Handler:    _TransactionService_UnlockUtxos_Handler,
+		},
+		{
+			MethodName: "ExtendLease",
+			Handler:    _TransactionService_ExtendLease_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _TransactionService_ListLeases_Handler,
+		},
 		{
 			MethodName: "EstimateFees",
 			Handler:    _TransactionService_EstimateFees_Handler,
 		},
+		{
+			MethodName: "EstimateFeeRate",
+			Handler:    _TransactionService_EstimateFeeRate_Handler,
+		},
 		{
 			MethodName: "SignTransaction",
 			Handler:    _TransactionService_SignTransaction_Handler,
@@ -693,6 +1683,18 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignPset",
 			Handler:    _TransactionService_SignPset_Handler,
 		},
+		{
+			MethodName: "DecodePset",
+			Handler:    _TransactionService_DecodePset_Handler,
+		},
+		{
+			MethodName: "CombinePsets",
+			Handler:    _TransactionService_CombinePsets_Handler,
+		},
+		{
+			MethodName: "FinalizePset",
+			Handler:    _TransactionService_FinalizePset_Handler,
+		},
 		{
 			MethodName: "Mint",
 			Handler:    _TransactionService_Mint_Handler,
@@ -709,6 +1711,82 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _TransactionService_Transfer_Handler,
 		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _TransactionService_BumpFee_Handler,
+		},
+		{
+			MethodName: "Accelerate",
+			Handler:    _TransactionService_Accelerate_Handler,
+		},
+		{
+			MethodName: "MoveFunds",
+			Handler:    _TransactionService_MoveFunds_Handler,
+		},
+		{
+			MethodName: "FragmentUtxos",
+			Handler:    _TransactionService_FragmentUtxos_Handler,
+		},
+		{
+			MethodName: "Consolidate",
+			Handler:    _TransactionService_Consolidate_Handler,
+		},
+		{
+			MethodName: "EnqueuePayment",
+			Handler:    _TransactionService_EnqueuePayment_Handler,
+		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _TransactionService_CancelPayment_Handler,
+		},
+		{
+			MethodName: "ListQueuedPayments",
+			Handler:    _TransactionService_ListQueuedPayments_Handler,
+		},
+		{
+			MethodName: "CreateSwapOffer",
+			Handler:    _TransactionService_CreateSwapOffer_Handler,
+		},
+		{
+			MethodName: "AcceptSwap",
+			Handler:    _TransactionService_AcceptSwap_Handler,
+		},
+		{
+			MethodName: "CompleteSwap",
+			Handler:    _TransactionService_CompleteSwap_Handler,
+		},
+		{
+			MethodName: "CreateFragment",
+			Handler:    _TransactionService_CreateFragment_Handler,
+		},
+		{
+			MethodName: "FillFragments",
+			Handler:    _TransactionService_FillFragments_Handler,
+		},
+		{
+			MethodName: "SetSpendingPolicy",
+			Handler:    _TransactionService_SetSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "GetSpendingPolicy",
+			Handler:    _TransactionService_GetSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteSpendingPolicy",
+			Handler:    _TransactionService_DeleteSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "ApproveSpending",
+			Handler:    _TransactionService_ApproveSpending_Handler,
+		},
+		{
+			MethodName: "ListSpendings",
+			Handler:    _TransactionService_ListSpendings_Handler,
+		},
+		{
+			MethodName: "ListPolicyViolations",
+			Handler:    _TransactionService_ListPolicyViolations_Handler,
+		},
 		{
 			MethodName: "PegInAddress",
 			Handler:    _TransactionService_PegInAddress_Handler,
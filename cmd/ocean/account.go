@@ -2,7 +2,12 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
@@ -13,6 +18,10 @@ var (
 	accountName, accountLabel      string
 	numOfAddresses                 uint64
 	accountUnconf, changeAddresses bool
+	exportFormat                   string
+	minConfirmations               uint32
+	accountUtxos                   []string
+	freezeReason                   string
 
 	accountCreateCmd = &cobra.Command{
 		Use:   "create",
@@ -38,7 +47,7 @@ var (
 		Use:   "balance",
 		Short: "get account balance",
 		Long: "this command returns info about the balance of the given account " +
-			"(confirmed unconfirmed and locked)",
+			"(confirmed unconfirmed, locked and frozen)",
 		RunE: accountBalance,
 	}
 	accountListAddressesCmd = &cobra.Command{
@@ -55,6 +64,28 @@ var (
 			"addresses of the given account",
 		RunE: accountListUtxos,
 	}
+	accountFreezeCmd = &cobra.Command{
+		Use:   "freeze",
+		Short: "freeze account utxos",
+		Long: "this command lets you exclude some utxos of the given account " +
+			"from any coin selection until they're unfrozen",
+		RunE: accountFreeze,
+	}
+	accountUnfreezeCmd = &cobra.Command{
+		Use:   "unfreeze",
+		Short: "unfreeze account utxos",
+		Long: "this command lets you make some frozen utxos of the given " +
+			"account spendable again",
+		RunE: accountUnfreeze,
+	}
+	accountExportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "export account history",
+		Long: "this command exports the history of the given account, one row " +
+			"for every asset moved by every account transaction, in csv or json " +
+			"lines format",
+		RunE: accountExport,
+	}
 	accountDeleteCmd = &cobra.Command{
 		Use:   "delete",
 		Short: "delete account",
@@ -87,6 +118,25 @@ func init() {
 		"whether derive change (internal) addresses",
 	)
 
+	accountBalanceCmd.Flags().Uint32Var(
+		&minConfirmations, "min-confirmations", 0,
+		"minimum number of confirmations for funds to be considered confirmed",
+	)
+
+	accountFreezeCmd.Flags().StringArrayVar(
+		&accountUtxos, "utxo", nil, "utxo to freeze as <txid>:<vout>",
+	)
+	accountFreezeCmd.Flags().StringVar(
+		&freezeReason, "reason", "", "why the utxos are frozen",
+	)
+	accountUnfreezeCmd.Flags().StringArrayVar(
+		&accountUtxos, "utxo", nil, "utxo to unfreeze as <txid>:<vout>",
+	)
+
+	accountExportCmd.Flags().StringVarP(
+		&exportFormat, "format", "f", "csv", "output format, one of csv or json",
+	)
+
 	accountCmd.PersistentFlags().StringVar(
 		&accountName, "account-name", "", "account namespace or label",
 	)
@@ -95,13 +145,16 @@ func init() {
 	accountBalanceCmd.MarkPersistentFlagRequired("account-name")
 	accountListAddressesCmd.MarkPersistentFlagRequired("account-name")
 	accountListUtxosCmd.MarkPersistentFlagRequired("account-name")
+	accountExportCmd.MarkPersistentFlagRequired("account-name")
 	accountDeleteCmd.MarkPersistentFlagRequired("account-name")
 	accountLabelCmd.MarkPersistentFlagRequired("account-name")
+	accountFreezeCmd.MarkPersistentFlagRequired("account-name")
+	accountUnfreezeCmd.MarkPersistentFlagRequired("account-name")
 
 	accountCmd.AddCommand(
 		accountCreateCmd, accountDeriveAddressesCmd, accountBalanceCmd,
 		accountListAddressesCmd, accountListUtxosCmd, accountDeleteCmd,
-		accountLabelCmd,
+		accountLabelCmd, accountExportCmd, accountFreezeCmd, accountUnfreezeCmd,
 	)
 }
 
@@ -211,7 +264,8 @@ func accountBalance(cmd *cobra.Command, _ []string) error {
 
 	reply, err := client.Balance(
 		context.Background(), &pb.BalanceRequest{
-			AccountName: accountName,
+			AccountName:      accountName,
+			MinConfirmations: parseMinConfirmations(cmd),
 		},
 	)
 	if err != nil {
@@ -283,6 +337,78 @@ func accountListUtxos(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+func accountExport(cmd *cobra.Command, _ []string) error {
+	if exportFormat != "csv" && exportFormat != "json" {
+		return fmt.Errorf("invalid format, must be one of csv or json")
+	}
+
+	client, cleanup, err := getAccountClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.ExportHistory(
+		context.Background(), &pb.ExportHistoryRequest{
+			AccountName: accountName,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	var csvWriter *csv.Writer
+	jsonEncoder := json.NewEncoder(os.Stdout)
+	if exportFormat == "csv" {
+		csvWriter = csv.NewWriter(os.Stdout)
+		defer csvWriter.Flush()
+
+		csvWriter.Write([]string{
+			"timestamp", "txid", "block_height", "asset", "amount", "fee",
+			"label", "counterparty_address", "internal",
+		})
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			printErr(err)
+			return nil
+		}
+
+		if csvWriter != nil {
+			csvWriter.Write([]string{
+				strconv.FormatInt(entry.GetTimestamp(), 10),
+				entry.GetTxid(),
+				strconv.FormatUint(entry.GetBlockHeight(), 10),
+				entry.GetAsset(),
+				strconv.FormatInt(entry.GetAmount(), 10),
+				strconv.FormatUint(entry.GetFee(), 10),
+				entry.GetLabel(),
+				entry.GetCounterpartyAddress(),
+				strconv.FormatBool(entry.GetInternal()),
+			})
+			continue
+		}
+
+		jsonEncoder.Encode(historyEntry{
+			Timestamp:           entry.GetTimestamp(),
+			Txid:                entry.GetTxid(),
+			BlockHeight:         entry.GetBlockHeight(),
+			Asset:               entry.GetAsset(),
+			Amount:              entry.GetAmount(),
+			Fee:                 entry.GetFee(),
+			Label:               entry.GetLabel(),
+			CounterpartyAddress: entry.GetCounterpartyAddress(),
+			Internal:            entry.GetInternal(),
+		})
+	}
+}
+
 func accountDelete(cmd *cobra.Command, _ []string) error {
 	client, cleanup, err := getAccountClient()
 	if err != nil {
@@ -302,3 +428,70 @@ func accountDelete(cmd *cobra.Command, _ []string) error {
 	fmt.Println("account deleted")
 	return nil
 }
+
+func accountFreeze(cmd *cobra.Command, _ []string) error {
+	utxos, err := parseUtxos(accountUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	client, cleanup, err := getAccountClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.FreezeUtxos(
+		context.Background(), &pb.FreezeUtxosRequest{
+			AccountName: accountName,
+			Utxos:       utxos,
+			Reason:      freezeReason,
+		},
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("utxos frozen")
+	return nil
+}
+
+func accountUnfreeze(cmd *cobra.Command, _ []string) error {
+	utxos, err := parseUtxos(accountUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	client, cleanup, err := getAccountClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.UnfreezeUtxos(
+		context.Background(), &pb.UnfreezeUtxosRequest{
+			AccountName: accountName,
+			Utxos:       utxos,
+		},
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("utxos unfrozen")
+	return nil
+}
+
+type historyEntry struct {
+	Timestamp           int64  `json:"timestamp"`
+	Txid                string `json:"txid"`
+	BlockHeight         uint64 `json:"block_height"`
+	Asset               string `json:"asset"`
+	Amount              int64  `json:"amount"`
+	Fee                 uint64 `json:"fee"`
+	Label               string `json:"label"`
+	CounterpartyAddress string `json:"counterparty_address"`
+	Internal            bool   `json:"internal"`
+}
@@ -9,12 +9,49 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/spf13/cobra"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var (
-	satsPerByte     float32
-	txReceiversJSON []string
-	txNoBroadcast   bool
+	satsPerByte      float32
+	txReceiversJSON  []string
+	txNoBroadcast    bool
+	txUtxos          []string
+	txAllowExtraIns  bool
+	txSendAll        bool
+	txSubtractFee    bool
+	txDryRun         bool
+	txReserve        bool
+	txLeaseID        string
+	txLeaseDuration  uint64
+	txAsset          string
+	txNumFragments   uint32
+	txFragmentAmount float64
+	txMaxInputs      uint32
+	txMaxUtxoValue   float64
+	txMaxSatsPerByte float32
+	txConfTarget     uint32
+	txID             string
+	txReceiverJSON   string
+	txPaymentID      string
+	txToAccount      string
+	txAmount         float64
+	txLockTime       uint32
+	txGiveAsset      string
+	txGiveAmount     float64
+	txWantAsset      string
+	txWantAmount     float64
+	txPset           string
+	txPsets          []string
+	txUnblindedIns   []string
+	txSwapID         string
+	txUtxo           string
+	txBlindOutput    bool
+	txFragmentsJSON  []string
+	txPolicyJSON     string
+	txSpendingID     string
+	txApprover       string
+	txApprovalSig    string
 
 	txTransferCmd = &cobra.Command{
 		Use:   "transfer",
@@ -30,6 +67,204 @@ var (
 			"(in hex format) through the network to be included in a future block",
 		RunE: txBroadcast,
 	}
+	txUnlockCmd = &cobra.Command{
+		Use:   "unlock",
+		Short: "unlock the utxos locked by a lease",
+		Long: "this command lets you release the utxos locked by a previous " +
+			"coin selection before the lock expires",
+		RunE: txUnlock,
+	}
+	txExtendLeaseCmd = &cobra.Command{
+		Use:   "extend-lease",
+		Short: "extend the lock of the utxos of a lease",
+		Long: "this command lets you postpone the expiration of the lock of " +
+			"the utxos of a lease",
+		RunE: txExtendLease,
+	}
+	txLeasesCmd = &cobra.Command{
+		Use:   "leases",
+		Short: "list the active leases",
+		Long: "this command lets you list the active leases, ie. the groups of " +
+			"currently locked utxos, optionally filtered by account",
+		RunE: txLeases,
+	}
+	txFragmentCmd = &cobra.Command{
+		Use:   "fragment",
+		Short: "split the balance of an asset into many utxos",
+		Long: "this command lets you split your balance of an asset into many " +
+			"utxos, either of the given amount or in geometric buckets, so that " +
+			"they can be spent in parallel",
+		RunE: txFragment,
+	}
+	txConsolidateCmd = &cobra.Command{
+		Use:   "consolidate",
+		Short: "sweep the smallest utxos of an asset into one",
+		Long: "this command lets you sweep the smallest utxos of an asset into " +
+			"one sent to a new address of the same account, to reduce the inputs " +
+			"(and fees) of future transactions",
+		RunE: txConsolidate,
+	}
+	txEstimateFeeRateCmd = &cobra.Command{
+		Use:   "estimate-fee-rate",
+		Short: "get the fee rate to use for a tx to be confirmed in time",
+		Long: "this command lets you know the sats/byte ratio required for a " +
+			"transaction to be confirmed within the given number of blocks",
+		RunE: txEstimateFeeRate,
+	}
+	txBumpFeeCmd = &cobra.Command{
+		Use:   "bump-fee",
+		Short: "replace an unconfirmed transfer with one paying higher fees",
+		Long: "this command lets you replace an unconfirmed transaction crafted " +
+			"by a previous transfer with one paying the same receivers at a " +
+			"higher fee rate, possibly spending other utxos of the account",
+		RunE: txBumpFee,
+	}
+	txAccelerateCmd = &cobra.Command{
+		Use:   "accelerate",
+		Short: "speed up the confirmation of a transaction with a child paying for it",
+		Long: "this command lets you spend the unconfirmed outputs of a " +
+			"transaction owned by the wallet with a child transaction paying the " +
+			"fees for both (CPFP), possibly spending other utxos of the account",
+		RunE: txAccelerate,
+	}
+	txMoveFundsCmd = &cobra.Command{
+		Use:   "move-funds",
+		Short: "move funds between accounts of the wallet",
+		Long: "this command lets you send some funds of an account to a fresh " +
+			"address of another account of the wallet with a transaction that " +
+			"shows as internal in the history of both",
+		RunE: txMoveFunds,
+	}
+	txEnqueuePaymentCmd = &cobra.Command{
+		Use:   "enqueue-payment",
+		Short: "add a payment to the queue of batched payments",
+		Long: "this command lets you queue a payment to the given receiver " +
+			"({asset, amount, address}), sent along with the others of the same " +
+			"account with a single batched transaction",
+		RunE: txEnqueuePayment,
+	}
+	txCancelPaymentCmd = &cobra.Command{
+		Use:   "cancel-payment",
+		Short: "remove a payment from the queue of batched payments",
+		Long: "this command lets you cancel a queued payment before it's sent " +
+			"with a batched transaction",
+		RunE: txCancelPayment,
+	}
+	txQueuedPaymentsCmd = &cobra.Command{
+		Use:   "queued-payments",
+		Short: "list the queued payments",
+		Long: "this command lets you list the payments not yet sent with a " +
+			"batched transaction, optionally filtered by account",
+		RunE: txQueuedPayments,
+	}
+	txCreateSwapOfferCmd = &cobra.Command{
+		Use:   "create-swap-offer",
+		Short: "create an offer to swap some funds for another asset",
+		Long: "this command lets you create a partial transaction spending " +
+			"some funds of the account and asking for some amount of another " +
+			"asset in exchange, to be accepted by a counter-party",
+		RunE: txCreateSwapOffer,
+	}
+	txAcceptSwapCmd = &cobra.Command{
+		Use:   "accept-swap",
+		Short: "accept a swap offer",
+		Long: "this command lets you accept a swap offer by adding to its " +
+			"partial transaction the funds of the account requested by the " +
+			"counter-party, paying for the network fees",
+		RunE: txAcceptSwap,
+	}
+	txCompleteSwapCmd = &cobra.Command{
+		Use:   "complete-swap",
+		Short: "complete a swap",
+		Long: "this command lets you blind and sign the account's inputs of a " +
+			"swap partial transaction, given the unblinded inputs revealed by " +
+			"the counter-party. The tx hex is returned once fully signed",
+		RunE: txCompleteSwap,
+	}
+	txCreateFragmentCmd = &cobra.Command{
+		Use:   "create-fragment",
+		Short: "create a fragment exchanging a utxo for another asset",
+		Long: "this command lets you create a partial transaction spending a " +
+			"utxo of the account in exchange for an output at the same index, " +
+			"signed with SIGHASH_SINGLE|ANYONECANPAY so that anyone can fill it",
+		RunE: txCreateFragment,
+	}
+	txFillFragmentsCmd = &cobra.Command{
+		Use:   "fill-fragments",
+		Short: "fill some fragments with the funds of the account",
+		Long: "this command lets you verify and merge some fragments into a " +
+			"transaction paying the amounts they want with the funds of the " +
+			"account, and receiving the amounts they give",
+		RunE: txFillFragments,
+	}
+	txDecodePsetCmd = &cobra.Command{
+		Use:   "decode-pset",
+		Short: "decode a partial transaction",
+		Long: "this command lets you inspect the inputs and outputs of a " +
+			"partial transaction, which ones are owned by which account, how it " +
+			"changes their balances and whether it's fully signed and blinded",
+		RunE: txDecodePset,
+	}
+	txCombinePsetsCmd = &cobra.Command{
+		Use:   "combine-psets",
+		Short: "combine copies of the same partial transaction",
+		Long: "this command lets you merge the signatures and blinding data " +
+			"of the copies of a partial transaction updated by different parties",
+		RunE: txCombinePsets,
+	}
+	txFinalizePsetCmd = &cobra.Command{
+		Use:   "finalize-pset",
+		Short: "finalize a partial transaction",
+		Long: "this command lets you finalize all the inputs of a partial " +
+			"transaction, including multisig and template scripts, and get the " +
+			"raw transaction ready to be broadcasted",
+		RunE: txFinalizePset,
+	}
+	txSetPolicyCmd = &cobra.Command{
+		Use:   "set-policy",
+		Short: "set the spending policy of an account",
+		Long: "this command lets you set or replace the limits, allowed and " +
+			"denied destinations, fee caps and approval rules checked before " +
+			"signing any transaction spending the funds of the account",
+		RunE: txSetPolicy,
+	}
+	txGetPolicyCmd = &cobra.Command{
+		Use:   "get-policy",
+		Short: "get the spending policy of an account",
+		Long:  "this command lets you show the spending policy of the account",
+		RunE:  txGetPolicy,
+	}
+	txDeletePolicyCmd = &cobra.Command{
+		Use:   "delete-policy",
+		Short: "remove the spending policy of an account",
+		Long: "this command lets you remove the spending policy of the " +
+			"account, so that its funds are spent without restrictions",
+		RunE: txDeletePolicy,
+	}
+	txApproveSpendingCmd = &cobra.Command{
+		Use:   "approve-spending",
+		Short: "approve a spending pending for approval",
+		Long: "this command lets you add an approval to a spending above the " +
+			"threshold of the policy, so that the same transaction can be " +
+			"signed once approved by enough approvers. The approver must be " +
+			"one of the public keys of the policy and must sign the digest of " +
+			"the spending",
+		RunE: txApproveSpending,
+	}
+	txSpendingsCmd = &cobra.Command{
+		Use:   "spendings",
+		Short: "list the spendings of an account of the last 24 hours",
+		Long: "this command lets you list the spendings of the account of the " +
+			"last 24 hours, either signed or pending for approval",
+		RunE: txSpendings,
+	}
+	txPolicyViolationsCmd = &cobra.Command{
+		Use:   "policy-violations",
+		Short: "list the violations of the spending policy of an account",
+		Long: "this command lets you list the attempts to spend the funds of " +
+			"the account refused because violating its spending policy",
+		RunE: txPolicyViolations,
+	}
 	txCmd = &cobra.Command{
 		Use:   "transaction",
 		Short: "interact with ocean transaction interface",
@@ -45,19 +280,164 @@ func init() {
 		"JSON string list of receivers as "+
 			"{\"address\": \"<address>\", \"amount\": <amount in BTC>, \"asset\": \"<asset>\"}",
 	)
+	txTransferCmd.Flags().Uint32Var(
+		&minConfirmations, "min-confirmations", 0,
+		"minimum number of confirmations for funds to be spent",
+	)
 	txTransferCmd.Flags().BoolVar(&txNoBroadcast, "no-broadcast", false, "use this flag to not broadcast the transaction and get the tx hex instead of its hash")
+	txTransferCmd.Flags().StringArrayVar(
+		&txUtxos, "utxo", nil, "utxo to spend as <txid>:<vout>",
+	)
+	txTransferCmd.Flags().BoolVar(
+		&txAllowExtraIns, "allow-extra-inputs", false,
+		"use this flag to let other utxos be spent if the given ones are not enough",
+	)
+	txTransferCmd.Flags().BoolVar(
+		&txSendAll, "send-all", false,
+		"use this flag to send the whole balance of the receivers' assets, "+
+			"their amounts are ignored",
+	)
+	txTransferCmd.Flags().BoolVar(
+		&txSubtractFee, "subtract-fee", false,
+		"use this flag to subtract the fees from the LBTC receivers' amounts",
+	)
+	txTransferCmd.Flags().BoolVar(
+		&txDryRun, "dry-run", false,
+		"use this flag to preview the inputs, change and fees of the "+
+			"transaction without crafting it",
+	)
+	txTransferCmd.Flags().BoolVar(
+		&txReserve, "reserve", false,
+		"use this flag along with --dry-run to lock the selected utxos",
+	)
+	txTransferCmd.Flags().StringVar(
+		&txLeaseID, "lease-id", "",
+		"id of the lease returned by a dry-run with reservation",
+	)
+	txTransferCmd.Flags().Uint32Var(
+		&txLockTime, "locktime", 0,
+		"locktime of the transaction, either a block height or a timestamp",
+	)
+
+	txUnlockCmd.Flags().StringVar(&txLeaseID, "lease-id", "", "id of the lease")
+	txExtendLeaseCmd.Flags().StringVar(&txLeaseID, "lease-id", "", "id of the lease")
+	txExtendLeaseCmd.Flags().Uint64Var(
+		&txLeaseDuration, "duration", 0,
+		"number of seconds from now after which the lease expires",
+	)
+
+	txFragmentCmd.Flags().StringVar(&txAsset, "asset", "", "asset of the balance to split")
+	txFragmentCmd.Flags().Uint32Var(
+		&txNumFragments, "num-fragments", 0,
+		"number of fragments, as many as possible if not specified",
+	)
+	txFragmentCmd.Flags().Float64Var(
+		&txFragmentAmount, "fragment-amount", 0,
+		"amount in BTC of every fragment, geometric buckets if not specified",
+	)
+	txFragmentCmd.Flags().Uint32Var(
+		&minConfirmations, "min-confirmations", 0,
+		"minimum number of confirmations for funds to be spent",
+	)
+	txFragmentCmd.Flags().BoolVar(&txNoBroadcast, "no-broadcast", false, "use this flag to not broadcast the transaction and get the tx hex instead of its hash")
+
+	txConsolidateCmd.Flags().StringVar(&txAsset, "asset", "", "asset of the utxos to consolidate")
+	txConsolidateCmd.Flags().Uint32Var(
+		&txMaxInputs, "max-inputs", 20, "max number of utxos to consolidate",
+	)
+	txConsolidateCmd.Flags().Float64Var(
+		&txMaxUtxoValue, "max-utxo-value", 0,
+		"max value in BTC of a utxo to be consolidated",
+	)
+	txConsolidateCmd.Flags().Float32Var(
+		&txMaxSatsPerByte, "max-sats-per-byte", 0,
+		"sats/byte ratio ceiling above which the consolidation is refused",
+	)
+	txConsolidateCmd.Flags().BoolVar(&txNoBroadcast, "no-broadcast", false, "use this flag to not broadcast the transaction and get the tx hex instead of its hash")
+
+	txEstimateFeeRateCmd.Flags().Uint32Var(
+		&txConfTarget, "conf-target", 0,
+		"number of blocks within which the tx should be confirmed",
+	)
+
+	txBumpFeeCmd.Flags().StringVar(&txID, "txid", "", "hash of the transaction to replace")
+	txAccelerateCmd.Flags().StringVar(&txID, "txid", "", "hash of the transaction to accelerate")
+
+	txMoveFundsCmd.Flags().StringVar(&txToAccount, "to-account", "", "name of the account receiving the funds")
+	txMoveFundsCmd.Flags().StringVar(&txAsset, "asset", "", "asset to move")
+	txMoveFundsCmd.Flags().Float64Var(&txAmount, "amount", 0, "amount in BTC to move")
+
+	txEnqueuePaymentCmd.Flags().StringVar(
+		&txReceiverJSON, "receiver", "",
+		"JSON string of the receiver as "+
+			"{\"address\": \"<address>\", \"amount\": <amount in BTC>, \"asset\": \"<asset>\"}",
+	)
+	txCancelPaymentCmd.Flags().StringVar(&txPaymentID, "id", "", "id of the payment")
+
+	txCreateSwapOfferCmd.Flags().StringVar(&txGiveAsset, "give-asset", "", "asset to give")
+	txCreateSwapOfferCmd.Flags().Float64Var(&txGiveAmount, "give-amount", 0, "amount in BTC to give")
+	txCreateSwapOfferCmd.Flags().StringVar(&txWantAsset, "want-asset", "", "asset to receive")
+	txCreateSwapOfferCmd.Flags().Float64Var(&txWantAmount, "want-amount", 0, "amount in BTC to receive")
+	txAcceptSwapCmd.Flags().StringVar(&txPset, "pset", "", "base64 pset of the swap offer")
+	txCompleteSwapCmd.Flags().StringVar(&txSwapID, "id", "", "id of the swap")
+	txCompleteSwapCmd.Flags().StringVar(&txPset, "pset", "", "base64 pset of the accepted swap")
+	txCompleteSwapCmd.Flags().StringArrayVar(
+		&txUnblindedIns, "unblinded-input", nil,
+		"JSON string of an input revealed by the counter-party as "+
+			"{\"index\": <index>, \"asset\": \"<asset>\", \"amount\": <amount in sats>, "+
+			"\"assetBlinder\": \"<asset blinder>\", \"amountBlinder\": \"<amount blinder>\"}",
+	)
+
+	txCreateFragmentCmd.Flags().StringVar(&txUtxo, "utxo", "", "utxo to spend as <txid>:<vout>")
+	txCreateFragmentCmd.Flags().StringVar(&txWantAsset, "want-asset", "", "asset to receive")
+	txCreateFragmentCmd.Flags().Float64Var(&txWantAmount, "want-amount", 0, "amount in BTC to receive")
+	txCreateFragmentCmd.Flags().BoolVar(&txBlindOutput, "blind-output", false, "use this flag to blind the output receiving the wanted amount")
+	txFillFragmentsCmd.Flags().StringArrayVar(
+		&txFragmentsJSON, "fragment", nil,
+		"JSON string of a fragment as returned by create-fragment",
+	)
+	txDecodePsetCmd.Flags().StringVar(&txPset, "pset", "", "base64 pset to decode")
+	txCombinePsetsCmd.Flags().StringArrayVar(
+		&txPsets, "pset", nil, "base64 copy of the pset to combine",
+	)
+	txFinalizePsetCmd.Flags().StringVar(&txPset, "pset", "", "base64 pset to finalize")
+
+	txSetPolicyCmd.Flags().StringVar(
+		&txPolicyJSON, "policy", "",
+		"JSON string of the policy as "+
+			"{\"assetLimits\": [{\"asset\": \"<asset>\", \"maxAmountPerTx\": <amount in sats>, "+
+			"\"maxAmountPerDay\": <amount in sats>, \"approvalThreshold\": <amount in sats>}], "+
+			"\"allowedScripts\": [\"<script>\"], \"deniedScripts\": [\"<script>\"], "+
+			"\"maxFeeRate\": <mSats/byte>, \"maxFeeAmount\": <amount in sats>, "+
+			"\"requiredApprovals\": <number>, \"approvers\": [\"<pubkey>\"]}",
+	)
+	txSetPolicyCmd.Flags().StringVar(&password, "password", "", "wallet password")
+	txDeletePolicyCmd.Flags().StringVar(&password, "password", "", "wallet password")
+	txApproveSpendingCmd.Flags().StringVar(&txSpendingID, "id", "", "id of the spending")
+	txApproveSpendingCmd.Flags().StringVar(&txApprover, "approver", "", "public key of who approves the spending")
+	txApproveSpendingCmd.Flags().StringVar(&txApprovalSig, "signature", "", "DER encoded ECDSA signature of the spending digest")
 
 	txCmd.PersistentFlags().StringVar(
 		&accountName, "account-name", "", "name of the account's funds to use",
 	)
 	txCmd.PersistentFlags().Float32Var(
-		&satsPerByte, "sats-per-byte", 0.1, "sats/byte ratio to use for network fees",
+		&satsPerByte, "sats-per-byte", 0,
+		"sats/byte ratio to use for network fees, estimated if not specified",
 	)
 
-	txCmd.AddCommand(txTransferCmd, txBroadcastCmd)
+	txCmd.AddCommand(
+		txTransferCmd, txBroadcastCmd, txUnlockCmd, txExtendLeaseCmd, txLeasesCmd,
+		txFragmentCmd, txConsolidateCmd, txEstimateFeeRateCmd, txBumpFeeCmd,
+		txAccelerateCmd, txMoveFundsCmd, txEnqueuePaymentCmd, txCancelPaymentCmd,
+		txQueuedPaymentsCmd, txCreateSwapOfferCmd, txAcceptSwapCmd,
+		txCompleteSwapCmd, txCreateFragmentCmd, txFillFragmentsCmd,
+		txDecodePsetCmd, txCombinePsetsCmd, txFinalizePsetCmd, txSetPolicyCmd,
+		txGetPolicyCmd, txDeletePolicyCmd, txApproveSpendingCmd, txSpendingsCmd,
+		txPolicyViolationsCmd,
+	)
 }
 
-func txTransfer(_ *cobra.Command, _ []string) error {
+func txTransfer(cmd *cobra.Command, _ []string) error {
 	client, cleanup, err := getTransactionClient()
 	if err != nil {
 		return err
@@ -75,10 +455,131 @@ func txTransfer(_ *cobra.Command, _ []string) error {
 		receivers = append(receivers, receiver)
 	}
 
+	inputs, err := parseUtxos(txUtxos)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
 	reply, err := client.Transfer(ctx, &pb.TransferRequest{
 		AccountName:      accountName,
 		MillisatsPerByte: uint64(satsPerByte * 1000),
 		Receivers:        receivers.proto(),
+		MinConfirmations: parseMinConfirmations(cmd),
+		Inputs:           inputs,
+		AllowExtraInputs: txAllowExtraIns,
+		SendAll:          txSendAll,
+		SubtractFee:      txSubtractFee,
+		DryRun:           txDryRun,
+		Reserve:          txReserve,
+		LeaseId:          txLeaseID,
+		Locktime:         txLockTime,
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	if txNoBroadcast || txDryRun {
+		jsonReply, err := jsonResponse(reply)
+		if err != nil {
+			printErr(err)
+			return nil
+		}
+
+		fmt.Println(jsonReply)
+		return nil
+	}
+
+	bReply, err := client.BroadcastTransaction(
+		ctx, &pb.BroadcastTransactionRequest{
+			TxHex: reply.GetTxHex(),
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(bReply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txFragment(cmd *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	fragmentAmount := output{Amount: txFragmentAmount}.proto().GetAmount()
+	reply, err := client.FragmentUtxos(ctx, &pb.FragmentUtxosRequest{
+		AccountName:      accountName,
+		Asset:            txAsset,
+		NumFragments:     txNumFragments,
+		FragmentAmount:   fragmentAmount,
+		MillisatsPerByte: uint64(satsPerByte * 1000),
+		MinConfirmations: parseMinConfirmations(cmd),
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	if txNoBroadcast {
+		jsonReply, err := jsonResponse(reply)
+		if err != nil {
+			printErr(err)
+			return nil
+		}
+
+		fmt.Println(jsonReply)
+		return nil
+	}
+
+	bReply, err := client.BroadcastTransaction(
+		ctx, &pb.BroadcastTransactionRequest{
+			TxHex: reply.GetTxHex(),
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(bReply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txConsolidate(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	maxUtxoValue := output{Amount: txMaxUtxoValue}.proto().GetAmount()
+	reply, err := client.Consolidate(ctx, &pb.ConsolidateRequest{
+		AccountName:         accountName,
+		Asset:               txAsset,
+		MaxInputs:           txMaxInputs,
+		MaxUtxoValue:        maxUtxoValue,
+		MillisatsPerByte:    uint64(satsPerByte * 1000),
+		MaxMillisatsPerByte: uint64(txMaxSatsPerByte * 1000),
 	})
 	if err != nil {
 		printErr(err)
@@ -147,28 +648,703 @@ func txBroadcast(_ *cobra.Command, args []string) error {
 	return nil
 }
 
-type output struct {
-	Address string  `json:"address"`
-	Amount  float64 `json:"amount"`
-	Asset   string  `json:"asset"`
+func txUnlock(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.UnlockUtxos(
+		context.Background(), &pb.UnlockUtxosRequest{
+			LeaseId: txLeaseID,
+		})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
 }
 
-func (o output) proto() *pb.Output {
-	btc := decimal.NewFromFloat(math.Pow10(8))
-	amount := decimal.NewFromFloat(o.Amount).Mul(btc).BigInt().Uint64()
-	return &pb.Output{
-		Address: o.Address,
-		Amount:  amount,
-		Asset:   o.Asset,
+func txExtendLease(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ExtendLease(
+		context.Background(), &pb.ExtendLeaseRequest{
+			LeaseId:  txLeaseID,
+			Duration: txLeaseDuration,
+		})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
 	}
+
+	fmt.Println(jsonReply)
+	return nil
 }
 
-type outputs []output
+func txLeases(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
 
-func (o outputs) proto() []*pb.Output {
-	outs := make([]*pb.Output, 0, len(o))
-	for _, out := range o {
-		outs = append(outs, out.proto())
+	reply, err := client.ListLeases(
+		context.Background(), &pb.ListLeasesRequest{
+			AccountName: accountName,
+		})
+	if err != nil {
+		printErr(err)
+		return nil
 	}
-	return outs
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txEstimateFeeRate(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.EstimateFeeRate(
+		context.Background(), &pb.EstimateFeeRateRequest{
+			ConfTarget: txConfTarget,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txBumpFee(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.BumpFee(context.Background(), &pb.BumpFeeRequest{
+		Txid:             txID,
+		MillisatsPerByte: uint64(satsPerByte * 1000),
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txAccelerate(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.Accelerate(context.Background(), &pb.AccelerateRequest{
+		Txid:             txID,
+		MillisatsPerByte: uint64(satsPerByte * 1000),
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txMoveFunds(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.MoveFunds(context.Background(), &pb.MoveFundsRequest{
+		FromAccount:      accountName,
+		ToAccount:        txToAccount,
+		Asset:            txAsset,
+		Amount:           output{Amount: txAmount}.proto().GetAmount(),
+		MillisatsPerByte: uint64(satsPerByte * 1000),
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txEnqueuePayment(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	receiver := output{}
+	if err := json.Unmarshal([]byte(txReceiverJSON), &receiver); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	reply, err := client.EnqueuePayment(
+		context.Background(), &pb.EnqueuePaymentRequest{
+			AccountName: accountName,
+			Receiver:    receiver.proto(),
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txCancelPayment(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.CancelPayment(
+		context.Background(), &pb.CancelPaymentRequest{
+			Id: txPaymentID,
+		},
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("payment canceled")
+	return nil
+}
+
+func txQueuedPayments(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ListQueuedPayments(
+		context.Background(), &pb.ListQueuedPaymentsRequest{
+			AccountName: accountName,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+type output struct {
+	Address string  `json:"address"`
+	Amount  float64 `json:"amount"`
+	Asset   string  `json:"asset"`
+}
+
+func (o output) proto() *pb.Output {
+	btc := decimal.NewFromFloat(math.Pow10(8))
+	amount := decimal.NewFromFloat(o.Amount).Mul(btc).BigInt().Uint64()
+	return &pb.Output{
+		Address: o.Address,
+		Amount:  amount,
+		Asset:   o.Asset,
+	}
+}
+
+type outputs []output
+
+func (o outputs) proto() []*pb.Output {
+	outs := make([]*pb.Output, 0, len(o))
+	for _, out := range o {
+		outs = append(outs, out.proto())
+	}
+	return outs
+}
+
+func txCreateSwapOffer(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.CreateSwapOffer(
+		context.Background(), &pb.CreateSwapOfferRequest{
+			AccountName: accountName,
+			GiveAsset:   txGiveAsset,
+			GiveAmount:  output{Amount: txGiveAmount}.proto().GetAmount(),
+			WantAsset:   txWantAsset,
+			WantAmount:  output{Amount: txWantAmount}.proto().GetAmount(),
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txAcceptSwap(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.AcceptSwap(context.Background(), &pb.AcceptSwapRequest{
+		AccountName:      accountName,
+		Pset:             txPset,
+		MillisatsPerByte: uint64(satsPerByte * 1000),
+	})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txCompleteSwap(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	unblindedIns := make([]*pb.UnblindedInput, 0, len(txUnblindedIns))
+	for _, in := range txUnblindedIns {
+		unblindedIn := &pb.UnblindedInput{}
+		if err := protojson.Unmarshal([]byte(in), unblindedIn); err != nil {
+			printErr(err)
+			return nil
+		}
+		unblindedIns = append(unblindedIns, unblindedIn)
+	}
+
+	reply, err := client.CompleteSwap(
+		context.Background(), &pb.CompleteSwapRequest{
+			Id:              txSwapID,
+			Pset:            txPset,
+			UnblindedInputs: unblindedIns,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txCreateFragment(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	utxos, err := parseUtxos([]string{txUtxo})
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	reply, err := client.CreateFragment(
+		context.Background(), &pb.CreateFragmentRequest{
+			AccountName: accountName,
+			Utxo:        utxos[0],
+			WantAsset:   txWantAsset,
+			WantAmount:  output{Amount: txWantAmount}.proto().GetAmount(),
+			BlindOutput: txBlindOutput,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txFillFragments(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	fragments := make([]*pb.Fragment, 0, len(txFragmentsJSON))
+	for _, f := range txFragmentsJSON {
+		fragment := &pb.Fragment{}
+		if err := protojson.Unmarshal([]byte(f), fragment); err != nil {
+			printErr(err)
+			return nil
+		}
+		fragments = append(fragments, fragment)
+	}
+
+	reply, err := client.FillFragments(
+		context.Background(), &pb.FillFragmentsRequest{
+			AccountName:      accountName,
+			Fragments:        fragments,
+			MillisatsPerByte: uint64(satsPerByte * 1000),
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txDecodePset(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.DecodePset(
+		context.Background(), &pb.DecodePsetRequest{Pset: txPset},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txCombinePsets(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.CombinePsets(
+		context.Background(), &pb.CombinePsetsRequest{Psets: txPsets},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txFinalizePset(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.FinalizePset(
+		context.Background(), &pb.FinalizePsetRequest{Pset: txPset},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txSetPolicy(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	policy := &pb.SpendingPolicy{}
+	if err := protojson.Unmarshal([]byte(txPolicyJSON), policy); err != nil {
+		printErr(err)
+		return nil
+	}
+	policy.AccountName = accountName
+
+	if _, err := client.SetSpendingPolicy(
+		context.Background(), &pb.SetSpendingPolicyRequest{
+			Policy:   policy,
+			Password: password,
+		},
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("spending policy set")
+	return nil
+}
+
+func txGetPolicy(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.GetSpendingPolicy(
+		context.Background(), &pb.GetSpendingPolicyRequest{
+			AccountName: accountName,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txDeletePolicy(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.DeleteSpendingPolicy(
+		context.Background(), &pb.DeleteSpendingPolicyRequest{
+			AccountName: accountName,
+			Password:    password,
+		},
+	); err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println("spending policy removed")
+	return nil
+}
+
+func txApproveSpending(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ApproveSpending(
+		context.Background(), &pb.ApproveSpendingRequest{
+			Id:        txSpendingID,
+			Approver:  txApprover,
+			Signature: txApprovalSig,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txSpendings(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ListSpendings(
+		context.Background(), &pb.ListSpendingsRequest{
+			AccountName: accountName,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
+}
+
+func txPolicyViolations(_ *cobra.Command, _ []string) error {
+	client, cleanup, err := getTransactionClient()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ListPolicyViolations(
+		context.Background(), &pb.ListPolicyViolationsRequest{
+			AccountName: accountName,
+		},
+	)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	jsonReply, err := jsonResponse(reply)
+	if err != nil {
+		printErr(err)
+		return nil
+	}
+
+	fmt.Println(jsonReply)
+	return nil
 }
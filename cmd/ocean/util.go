@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/spf13/cobra"
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -179,6 +180,35 @@ func capitalize(s string) string {
 	return ss
 }
 
+// parseUtxos parses the given list of utxos in the form <txid>:<vout>.
+func parseUtxos(utxos []string) ([]*pb.Input, error) {
+	inputs := make([]*pb.Input, 0, len(utxos))
+	for _, u := range utxos {
+		parts := strings.Split(u, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid utxo %s, must be <txid>:<vout>", u)
+		}
+		vout, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid utxo %s, vout must be a number", u)
+		}
+		inputs = append(inputs, &pb.Input{
+			Txid:  parts[0],
+			Index: uint32(vout),
+		})
+	}
+	return inputs, nil
+}
+
+// parseMinConfirmations returns the value of the min-confirmations flag of
+// the given command, or nil if not set so that the daemon uses its default.
+func parseMinConfirmations(cmd *cobra.Command) *uint32 {
+	if !cmd.Flags().Changed("min-confirmations") {
+		return nil
+	}
+	return &minConfirmations
+}
+
 func formatVersion() string {
 	return fmt.Sprintf(
 		"\nVersion: %s\nCommit: %s\nDate: %s", version, commit, date,
@@ -12,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	appconfig "github.com/vulpemventures/ocean/internal/app-config"
 	"github.com/vulpemventures/ocean/internal/config"
+	"github.com/vulpemventures/ocean/internal/core/application"
 	electrum_scanner "github.com/vulpemventures/ocean/internal/infrastructure/blockchain-scanner/electrum"
 	postgresdb "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres"
 	"github.com/vulpemventures/ocean/internal/interfaces"
@@ -50,8 +51,24 @@ var (
 	dbName             = config.GetString(config.DbNameKey)
 	migrationSourceURL = config.GetString(config.DbMigrationPath)
 	dustAmount         = uint64(config.GetInt(config.DustAmountKey))
+	minConfirmations   = uint32(config.GetInt(config.MinConfirmationsKey))
+	discountCT         = config.GetDiscountCT()
 	walletPassword     = config.GetString(config.PasswordKey)
 	walletMnemonic     = config.GetString(config.MnemonicKey)
+
+	consolidationInterval            = time.Duration(config.GetInt(config.ConsolidationIntervalKey))
+	consolidationAccount             = config.GetString(config.ConsolidationAccountKey)
+	consolidationAsset               = config.GetString(config.ConsolidationAssetKey)
+	consolidationMaxInputs           = uint32(config.GetInt(config.ConsolidationMaxInputsKey))
+	consolidationMaxUtxoValue        = uint64(config.GetInt(config.ConsolidationMaxUtxoValueKey))
+	consolidationMillisatsPerByte    = uint64(config.GetInt(config.ConsolidationMillisatsPerByteKey))
+	consolidationMaxMillisatsPerByte = uint64(config.GetInt(config.ConsolidationMaxMillisatsPerByteKey))
+
+	batchingInterval         = time.Duration(config.GetInt(config.BatchingIntervalKey))
+	batchingMaxPayments      = uint32(config.GetInt(config.BatchingMaxPaymentsKey))
+	batchingMillisatsPerByte = uint64(config.GetInt(config.BatchingMillisatsPerByteKey))
+
+	maxFeeRate = uint64(config.GetInt(config.MaxMillisatsPerByteKey))
 )
 
 func main() {
@@ -91,12 +108,17 @@ func main() {
 		Network:                 network,
 		UtxoExpiryDuration:      utxoExpiryDuration * time.Second,
 		DustAmount:              dustAmount,
+		MinConfirmations:        minConfirmations,
+		DiscountCT:              discountCT,
 		Password:                walletPassword,
 		Mnemonic:                walletMnemonic,
 		RepoManagerType:         dbType,
 		BlockchainScannerType:   bcScannerType,
 		RepoManagerConfig:       repoManagerConfig,
 		BlockchainScannerConfig: bcScannerConfig,
+		Consolidation:           consolidationConfig(),
+		Batching:                batchingConfig(),
+		MaxMillisatsPerByte:     maxFeeRate,
 	}
 
 	serviceManager, err := interfaces.NewGrpcServiceManager(serviceCfg, appCfg)
@@ -114,6 +136,38 @@ func main() {
 	<-sigChan
 }
 
+func consolidationConfig() *application.ConsolidationConfig {
+	if consolidationInterval <= 0 {
+		return nil
+	}
+
+	asset := consolidationAsset
+	if asset == "" {
+		asset = network.AssetID
+	}
+	return &application.ConsolidationConfig{
+		Interval:            consolidationInterval * time.Second,
+		AccountName:         consolidationAccount,
+		Asset:               asset,
+		MaxInputs:           consolidationMaxInputs,
+		MaxUtxoValue:        consolidationMaxUtxoValue,
+		MillisatsPerByte:    consolidationMillisatsPerByte,
+		MaxMillisatsPerByte: consolidationMaxMillisatsPerByte,
+	}
+}
+
+func batchingConfig() *application.BatchingConfig {
+	if batchingInterval <= 0 {
+		return nil
+	}
+
+	return &application.BatchingConfig{
+		Interval:         batchingInterval * time.Second,
+		MaxPayments:      batchingMaxPayments,
+		MillisatsPerByte: batchingMillisatsPerByte,
+	}
+}
+
 func dbConfigFromType() interface{} {
 	switch dbType {
 	case "postgres":
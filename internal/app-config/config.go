@@ -27,10 +27,15 @@ import (
 //   - RootPath - (optional) Wallet root HD path (defaults to m/84'/0').
 //   - Network - (required) The Liquid network (mainnet, testnet, regtest).
 //   - UtxoExpiryDuration - (required) The duration in seconds for the app service to wait until unlocking one or more previously locked utxo.
+//   - MinConfirmations - (optional) The minimum number of confirmations for a utxo to be considered confirmed in balances and to be selected as tx input (defaults to 0, same as 1, ie. utxos are considered confirmed as soon as included in a block; unconfirmed utxos are never selected as tx inputs).
+//   - DiscountCT - (optional) Whether to estimate fees based on the discounted virtual size of confidential transactions.
 //   - RepoManagerType - (required) One of the supported repository manager types.
 //   - BlockchainScannerType - (required) One of the supported blockchain scanner types.
 //   - RepoManagerConfig - (optional) Custom config args for the repository manager based on its type.
 //   - BlockchainScannerConfig - (optional) Custom config args for the blockchain scanner based on its type.
+//   - Consolidation - (optional) Config of the background job that periodically consolidates the small utxos of an account.
+//   - Batching - (optional) Config of the background job that periodically sends the queued payments with batched transactions. The queue of payments is disabled if not set.
+//   - MaxMillisatsPerByte - (optional) The mSats/byte fee ratio ceiling above which transactions are not signed unless explicitly overridden. The fee guard is disabled if not set.
 type AppConfig struct {
 	Version string
 	Commit  string
@@ -40,6 +45,8 @@ type AppConfig struct {
 	Network            *network.Network
 	UtxoExpiryDuration time.Duration
 	DustAmount         uint64
+	MinConfirmations   uint32
+	DiscountCT         bool
 	Password           string
 	Mnemonic           string
 
@@ -47,6 +54,9 @@ type AppConfig struct {
 	BlockchainScannerType   string
 	RepoManagerConfig       interface{}
 	BlockchainScannerConfig interface{}
+	Consolidation           *application.ConsolidationConfig
+	Batching                *application.BatchingConfig
+	MaxMillisatsPerByte     uint64
 
 	rm         ports.RepoManager
 	bcs        ports.BlockchainScanner
@@ -104,6 +114,22 @@ func (c *AppConfig) Validate() error {
 	if _, err := path.ParseRootDerivationPath(c.RootPath); err != nil {
 		return err
 	}
+	if c.Consolidation != nil {
+		if c.Consolidation.Interval <= 0 {
+			return fmt.Errorf("missing consolidation interval")
+		}
+		if c.Consolidation.AccountName == "" {
+			return fmt.Errorf("missing consolidation account name")
+		}
+		if c.Consolidation.MaxInputs < 2 {
+			return fmt.Errorf("consolidation max inputs must be at least 2")
+		}
+	}
+	if c.Batching != nil {
+		if c.Batching.Interval <= 0 {
+			return fmt.Errorf("missing batching interval")
+		}
+	}
 	if len(c.Mnemonic) > 0 {
 		if !bip39.IsMnemonicValid(c.Mnemonic) {
 			return fmt.Errorf("invalid mnemonic")
@@ -263,7 +289,9 @@ func (c *AppConfig) accountService() *application.AccountService {
 
 	rm, _ := c.repoManager()
 	bcs, _ := c.bcScanner()
-	c.accountSvc = application.NewAccountService(rm, bcs)
+	c.accountSvc = application.NewAccountService(
+		rm, bcs, c.Network, c.MinConfirmations,
+	)
 	return c.accountSvc
 }
 
@@ -276,7 +304,17 @@ func (c *AppConfig) transactionService() *application.TransactionService {
 	bcs, _ := c.bcScanner()
 	c.txSvc = application.NewTransactionService(
 		rm, bcs, c.Network, c.UtxoExpiryDuration, c.DustAmount,
+		c.MinConfirmations, c.DiscountCT,
 	)
+	if c.Consolidation != nil {
+		c.txSvc.ScheduleConsolidation(*c.Consolidation)
+	}
+	if c.Batching != nil {
+		c.txSvc.ScheduleBatching(*c.Batching)
+	}
+	if c.MaxMillisatsPerByte > 0 {
+		c.txSvc.SetFeeGuard(c.MaxMillisatsPerByte)
+	}
 	return c.txSvc
 }
 
@@ -76,6 +76,51 @@ const (
 	DbMigrationPath = "DB_MIGRATION_PATH"
 	// DustAmountKey is the key to customize the dust amount threshold
 	DustAmountKey = "DUST_AMOUNT"
+	// MinConfirmationsKey is the key to customize the minimum number of
+	// confirmations for a utxo to be considered confirmed in balances and to be
+	// selected as input of a transaction.
+	MinConfirmationsKey = "MIN_CONFIRMATIONS"
+	// DiscountCTKey is the key to enable or disable the discounted virtual size
+	// of confidential transactions when estimating fees. If not set, it's
+	// enabled for Liquid and testnet and disabled for regtest.
+	DiscountCTKey = "DISCOUNT_CT"
+	// ConsolidationIntervalKey is the key to enable the background job that
+	// periodically consolidates the small utxos of an account, by setting the
+	// interval in seconds between 2 consecutive rounds.
+	ConsolidationIntervalKey = "CONSOLIDATION_INTERVAL"
+	// ConsolidationAccountKey is the key to set the account whose utxos are
+	// consolidated by the background job.
+	ConsolidationAccountKey = "CONSOLIDATION_ACCOUNT"
+	// ConsolidationAssetKey is the key to customize the asset of the utxos
+	// consolidated by the background job, defaults to the native asset.
+	ConsolidationAssetKey = "CONSOLIDATION_ASSET"
+	// ConsolidationMaxInputsKey is the key to customize the max number of utxos
+	// consolidated by the background job at every round.
+	ConsolidationMaxInputsKey = "CONSOLIDATION_MAX_INPUTS"
+	// ConsolidationMaxUtxoValueKey is the key to set the max value of a utxo to
+	// be consolidated by the background job.
+	ConsolidationMaxUtxoValueKey = "CONSOLIDATION_MAX_UTXO_VALUE"
+	// ConsolidationMillisatsPerByteKey is the key to customize the mSats/byte
+	// fee ratio used by the background job.
+	ConsolidationMillisatsPerByteKey = "CONSOLIDATION_MILLISATS_PER_BYTE"
+	// ConsolidationMaxMillisatsPerByteKey is the key to set the mSats/byte fee
+	// ratio ceiling above which the background job doesn't run.
+	ConsolidationMaxMillisatsPerByteKey = "CONSOLIDATION_MAX_MILLISATS_PER_BYTE"
+	// BatchingIntervalKey is the key to enable the queue of payments, by
+	// setting the interval in seconds between 2 consecutive flushes of the
+	// queued payments with a batched tx.
+	BatchingIntervalKey = "BATCHING_INTERVAL"
+	// BatchingMaxPaymentsKey is the key to set the number of queued payments of
+	// an account that triggers the flush of the queue before the interval
+	// expires.
+	BatchingMaxPaymentsKey = "BATCHING_MAX_PAYMENTS"
+	// BatchingMillisatsPerByteKey is the key to customize the mSats/byte fee
+	// ratio used for the batched txs, estimated if not set.
+	BatchingMillisatsPerByteKey = "BATCHING_MILLISATS_PER_BYTE"
+	// MaxMillisatsPerByteKey is the key to customize the mSats/byte fee ratio
+	// ceiling above which the daemon refuses to sign a transaction unless
+	// explicitly overridden. Set to 0 to disable the fee guard.
+	MaxMillisatsPerByteKey = "MAX_MILLISATS_PER_BYTE"
 	// PasswordKey is the key to set the password for auto-init/auto-unlock.
 	PasswordKey = "PASSWORD"
 	// MnemonicKey is the key to set the mnemonic for auto-init.
@@ -108,6 +153,12 @@ var (
 	defaultUtxoExpiryDuration = 360 // 6 minutes (3 blocks)
 	defaultElectrumUrl        = "ssl://blockstream.info:995"
 	defaultDustAmount         = uint64(450)
+	defaultMinConfirmations   = 0
+
+	defaultConsolidationMaxInputs        = 20
+	defaultConsolidationMillisatsPerByte = 100
+
+	defaultMaxMillisatsPerByte = 100000 // 100 sats/byte
 
 	supportedNetworks = map[string]*network.Network{
 		network.Liquid.Name:  &network.Liquid,
@@ -119,6 +170,11 @@ var (
 		network.Testnet.Name: 1,
 		network.Regtest.Name: 1,
 	}
+	discountCTByNetwork = map[string]bool{
+		network.Liquid.Name:  true,
+		network.Testnet.Name: true,
+		network.Regtest.Name: false,
+	}
 	SupportedDbs = supportedType{
 		"badger":   {},
 		"inmemory": {},
@@ -155,6 +211,12 @@ func init() {
 	vip.SetDefault(DbMigrationPath, "file://internal/infrastructure/storage/db/postgres/migration")
 	vip.SetDefault(ElectrumUrlKey, defaultElectrumUrl)
 	vip.SetDefault(DustAmountKey, defaultDustAmount)
+	vip.SetDefault(MinConfirmationsKey, defaultMinConfirmations)
+	vip.SetDefault(MaxMillisatsPerByteKey, defaultMaxMillisatsPerByte)
+	vip.SetDefault(ConsolidationMaxInputsKey, defaultConsolidationMaxInputs)
+	vip.SetDefault(
+		ConsolidationMillisatsPerByteKey, defaultConsolidationMillisatsPerByte,
+	)
 
 	if err := validate(); err != nil {
 		log.Fatalf("invalid config: %s", err)
@@ -230,6 +292,25 @@ func validate() error {
 		return fmt.Errorf("password must be defined if mnemonic is set")
 	}
 
+	if GetInt(ConsolidationIntervalKey) > 0 {
+		if len(GetString(ConsolidationAccountKey)) == 0 {
+			return fmt.Errorf(
+				"consolidation account must be defined if consolidation interval " +
+					"is set",
+			)
+		}
+		if GetInt(ConsolidationMaxInputsKey) < 2 {
+			return fmt.Errorf("consolidation max inputs must be at least 2")
+		}
+	}
+
+	if GetInt(BatchingIntervalKey) < 0 {
+		return fmt.Errorf("batching interval must not be negative")
+	}
+	if GetInt(BatchingMaxPaymentsKey) < 0 {
+		return fmt.Errorf("batching max payments must not be negative")
+	}
+
 	return nil
 }
 
@@ -259,6 +340,13 @@ func GetRootPath() string {
 	return fmt.Sprintf("m/84'/%d'", coinType)
 }
 
+func GetDiscountCT() bool {
+	if IsSet(DiscountCTKey) {
+		return GetBool(DiscountCTKey)
+	}
+	return discountCTByNetwork[GetString(NetworkKey)]
+}
+
 func GetString(key string) string {
 	return vip.GetString(key)
 }
@@ -2,25 +2,37 @@ package application
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
 )
 
+// historyPageSize is the number of txs read from the repository at a time
+// while exporting the history of an account.
+const historyPageSize = 100
+
 // AccountService is responsible for operations related to wallet accounts:
 //   - Create a new account.
 //   - Derive addresses for an existing account.
 //   - List derived addresses for an existing account.
 //   - Get balance of an existing account.
 //   - List utxos of an existing account.
+//   - Export the transaction history of an existing account.
 //   - Delete an existing account.
 //
 // The service registers 3 handlers related to the following wallet events:
 //   - domain.WalletAccountCreated - whenever an account is created, the service initializes a dedicated blockchain scanner and starts listening for its reports.
+//     In case of chain reorgs, the confirmations of the txs/utxos included in orphaned blocks are rolled back, and the utxos created by txs evicted from the blockchain are removed.
 //   - domain.WalletAccountAddressesDerived - whenever one or more addresses are derived for an account, they are added to the list of those watched by the account's scanner.
 //   - domain.WalletAccountDeleted - whenever an account is deleted, the relative scanner is stopped and removed.
 //
@@ -32,14 +44,18 @@ import (
 type AccountService struct {
 	repoManager ports.RepoManager
 	bcScanner   ports.BlockchainScanner
+	network     *network.Network
 	txQueue     *transactionQueue
 
+	minConfirmations uint32
+
 	log  func(format string, a ...interface{})
 	warn func(err error, format string, a ...interface{})
 }
 
 func NewAccountService(
 	repoManager ports.RepoManager, bcScanner ports.BlockchainScanner,
+	net *network.Network, minConfirmations uint32,
 ) *AccountService {
 	txQueue := newTransactionQueue()
 	logFn := func(format string, a ...interface{}) {
@@ -51,7 +67,9 @@ func NewAccountService(
 		log.WithError(err).Warnf(format, a...)
 	}
 
-	svc := &AccountService{repoManager, bcScanner, txQueue, logFn, warnFn}
+	svc := &AccountService{
+		repoManager, bcScanner, net, txQueue, minConfirmations, logFn, warnFn,
+	}
 	svc.registerHandlerForWalletEvents()
 	return svc
 }
@@ -141,8 +159,13 @@ func (as *AccountService) ListAddressesForAccount(
 	return AddressesInfo(addressesInfo), nil
 }
 
+// GetBalanceForAccount returns the balance of the given account. Only the
+// utxos with at least minConfirmations are considered confirmed; if nil, the
+// value the service is configured with is used. Since a utxo is confirmed as
+// soon as it's included in a block, 0 means that also the unconfirmed ones are
+// counted in the confirmed balance.
 func (as *AccountService) GetBalanceForAccount(
-	ctx context.Context, accountName string,
+	ctx context.Context, accountName string, minConfirmations *uint32,
 ) (BalanceInfo, error) {
 	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
 	if err != nil {
@@ -154,9 +177,34 @@ func (as *AccountService) GetBalanceForAccount(
 		return nil, err
 	}
 
-	return as.repoManager.UtxoRepository().GetBalanceForAccount(
+	minConfs := as.minConfirmations
+	if minConfirmations != nil {
+		minConfs = *minConfirmations
+	} else if minConfs == 0 {
+		minConfs = 1
+	}
+	// A single confirmation is what the repository already considers confirmed.
+	if minConfs == 1 {
+		return as.repoManager.UtxoRepository().GetBalanceForAccount(
+			ctx, account.Namespace,
+		)
+	}
+
+	var tipHeight uint32
+	if minConfs > 1 {
+		if _, tipHeight, err = as.bcScanner.GetLatestBlock(); err != nil {
+			return nil, err
+		}
+	}
+
+	utxos, err := as.repoManager.UtxoRepository().GetAllUtxosForAccount(
 		ctx, account.Namespace,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBalanceInfo(utxos, uint64(tipHeight), minConfs), nil
 }
 
 func (as *AccountService) ListUtxosForAccount(
@@ -186,13 +234,202 @@ func (as *AccountService) ListUtxosForAccount(
 		return nil, err
 	}
 
-	return &UtxoInfo{spendableUtxos, lockedUtxos}, nil
+	frozenUtxos, err := as.repoManager.UtxoRepository().GetFrozenUtxosForAccount(
+		ctx, account.Namespace,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UtxoInfo{spendableUtxos, lockedUtxos, frozenUtxos}, nil
+}
+
+// FreezeUtxos excludes the given utxos of the account from any coin selection
+// until they're unfrozen, regardless of any lock.
+func (as *AccountService) FreezeUtxos(
+	ctx context.Context, accountName string, utxoKeys []domain.UtxoKey,
+	reason string,
+) (int, error) {
+	if reason == "" {
+		return -1, fmt.Errorf("missing freeze reason")
+	}
+
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	account, err := w.GetAccount(accountName)
+	if err != nil {
+		return -1, err
+	}
+	if err := as.checkUtxosOwnership(ctx, account.Namespace, utxoKeys); err != nil {
+		return -1, err
+	}
+
+	count, err := as.repoManager.UtxoRepository().FreezeUtxos(
+		ctx, utxoKeys, reason, time.Now().Unix(),
+	)
+	if err != nil {
+		return -1, err
+	}
+	if count > 0 {
+		as.log(
+			"froze %d utxo(s) for account %s (%s)",
+			count, account.Namespace, UtxoKeys(utxoKeys),
+		)
+	}
+	return count, nil
+}
+
+// UnfreezeUtxos makes the given frozen utxos of the account spendable again.
+func (as *AccountService) UnfreezeUtxos(
+	ctx context.Context, accountName string, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	account, err := w.GetAccount(accountName)
+	if err != nil {
+		return -1, err
+	}
+	if err := as.checkUtxosOwnership(ctx, account.Namespace, utxoKeys); err != nil {
+		return -1, err
+	}
+
+	count, err := as.repoManager.UtxoRepository().UnfreezeUtxos(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+	if count > 0 {
+		as.log(
+			"unfroze %d utxo(s) for account %s (%s)",
+			count, account.Namespace, UtxoKeys(utxoKeys),
+		)
+	}
+	return count, nil
+}
+
+// ExportHistoryForAccount passes every entry of the history of the given
+// account to the given handler, from the oldest to the newest tx, with the
+// unconfirmed ones last. The txs are read from the repository one page at a
+// time so that the history is never loaded at once. Any error returned by
+// the handler stops the export.
+func (as *AccountService) ExportHistoryForAccount(
+	ctx context.Context, accountName string,
+	handler func(entry HistoryEntry) error,
+) error {
+	w, err := as.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return err
+	}
+
+	account, err := w.GetAccount(accountName)
+	if err != nil {
+		return err
+	}
+
+	utxos, err := as.repoManager.UtxoRepository().GetAllUtxosForAccount(
+		ctx, account.Namespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Map received and spent amounts by txid and asset.
+	receivedAmounts := make(map[string]map[string]uint64)
+	spentAmounts := make(map[string]map[string]uint64)
+	for _, u := range utxos {
+		if _, ok := receivedAmounts[u.TxID]; !ok {
+			receivedAmounts[u.TxID] = make(map[string]uint64)
+		}
+		receivedAmounts[u.TxID][u.Asset] += u.Value
+
+		if u.IsSpent() {
+			txid := u.SpentStatus.Txid
+			if _, ok := spentAmounts[txid]; !ok {
+				spentAmounts[txid] = make(map[string]uint64)
+			}
+			spentAmounts[txid][u.Asset] += u.Value
+		}
+	}
+
+	label := accountLabel(account)
+	txRepo := as.repoManager.TransactionRepository()
+	for offset := 0; ; offset += historyPageSize {
+		txs, err := txRepo.GetTransactionsForAccountPage(
+			ctx, account.Namespace, offset, historyPageSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range txs {
+			received := receivedAmounts[tx.TxID]
+			spent := spentAmounts[tx.TxID]
+
+			assets := make([]string, 0, len(received)+len(spent))
+			for asset := range received {
+				assets = append(assets, asset)
+			}
+			for asset := range spent {
+				if _, ok := received[asset]; !ok {
+					assets = append(assets, asset)
+				}
+			}
+			if len(assets) <= 0 {
+				continue
+			}
+			sort.Strings(assets)
+
+			var fee uint64
+			var feeAsset, counterparty string
+			// Fees and counterparties are relevant only if the account funded the
+			// tx, otherwise the only known counterparties are the other accounts of
+			// the wallet involved in an internal transfer.
+			if len(spent) > 0 {
+				fee, feeAsset, counterparty, err = as.parseTxForHistory(
+					tx.TxHex, account, w.Accounts,
+				)
+				if err != nil {
+					as.warn(err, "error while parsing tx %s", tx.TxID)
+				}
+			} else if tx.Internal {
+				counterparty = otherAccountLabels(tx, account, w.Accounts)
+			}
+
+			for _, asset := range assets {
+				entry := HistoryEntry{
+					Timestamp:    tx.BlockTime,
+					TxID:         tx.TxID,
+					BlockHeight:  tx.BlockHeight,
+					Asset:        asset,
+					Amount:       int64(received[asset]) - int64(spent[asset]),
+					Label:        label,
+					Counterparty: counterparty,
+					Internal:     tx.Internal,
+				}
+				if asset == feeAsset {
+					entry.Fee = fee
+				}
+				if err := handler(entry); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(txs) < historyPageSize {
+			return nil
+		}
+	}
 }
 
 func (as *AccountService) DeleteAccount(
 	ctx context.Context, accountName string,
 ) (err error) {
-	balance, err := as.GetBalanceForAccount(ctx, accountName)
+	balance, err := as.GetBalanceForAccount(ctx, accountName, nil)
 	if err != nil {
 		return
 	}
@@ -240,6 +477,10 @@ func (as *AccountService) registerHandlerForWalletEvents() {
 				go as.listenToTxChannel(
 					account.Namespace, as.bcScanner.GetTxChannel(account.Namespace),
 				)
+				go as.listenToEvictedTxChannel(
+					account.Namespace,
+					as.bcScanner.GetEvictedTxChannel(account.Namespace),
+				)
 			}
 		},
 	)
@@ -251,8 +492,10 @@ func (as *AccountService) registerHandlerForWalletEvents() {
 			)
 			chUtxos := as.bcScanner.GetUtxoChannel(event.AccountName)
 			chTxs := as.bcScanner.GetTxChannel(event.AccountName)
+			chEvictedTxs := as.bcScanner.GetEvictedTxChannel(event.AccountName)
 			go as.listenToUtxoChannel(event.AccountName, chUtxos)
 			go as.listenToTxChannel(event.AccountName, chTxs)
+			go as.listenToEvictedTxChannel(event.AccountName, chEvictedTxs)
 		},
 	)
 	// Start watching account address as soon as it's derived.
@@ -284,6 +527,10 @@ func (as *AccountService) listenToUtxoChannel(
 			utxoKeys = append(utxoKeys, u.Key())
 		}
 
+		if utxos[0].IsSpent() {
+			as.dropConflictingTransactions(utxoKeys, utxos[0].SpentStatus.Txid)
+		}
+
 		if utxos[0].IsConfirmedSpent() {
 			count, err := as.repoManager.UtxoRepository().ConfirmSpendUtxos(
 				context.Background(), utxoKeys, utxos[0].SpentStatus,
@@ -367,6 +614,84 @@ func (as *AccountService) listenToTxChannel(
 	}
 }
 
+func (as *AccountService) listenToEvictedTxChannel(
+	accountName string, chTxids chan string,
+) {
+	if chTxids == nil {
+		return
+	}
+	as.log("start listening to evicted tx channel for account %s", accountName)
+
+	for txid := range chTxids {
+		ctx := context.Background()
+		as.log("tx %s has been evicted", txid)
+
+		tx, _ := as.repoManager.TransactionRepository().GetTransaction(ctx, txid)
+		if tx != nil && tx.IsConfirmed() {
+			if _, _, err := as.rollbackTransaction(ctx, txid); err != nil {
+				as.warn(err, "error while rolling back evicted transaction %s", txid)
+				continue
+			}
+		}
+
+		unspent, deleted, err := dropTransaction(ctx, as.repoManager, txid)
+		if err != nil {
+			as.warn(err, "error while dropping evicted tx %s", txid)
+			continue
+		}
+		if unspent > 0 {
+			as.log("restored %d utxo(s) spent by evicted tx %s", unspent, txid)
+		}
+		if deleted > 0 {
+			as.log("deleted %d utxo(s) created by evicted tx %s", deleted, txid)
+		}
+	}
+}
+
+// dropConflictingTransactions drops the txs, other than the given one, that
+// spend any of the given utxos. These have been replaced or double-spent, and
+// won't ever be confirmed.
+func (as *AccountService) dropConflictingTransactions(
+	utxoKeys []domain.UtxoKey, txid string,
+) {
+	ctx := context.Background()
+	utxos, err := as.repoManager.UtxoRepository().GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		as.warn(err, "error while getting utxos spent by tx %s", txid)
+		return
+	}
+
+	conflictingTxids := make(map[string]struct{})
+	for _, u := range utxos {
+		if !u.IsSpent() || u.IsConfirmedSpent() || u.SpentStatus.Txid == txid {
+			continue
+		}
+		conflictingTxids[u.SpentStatus.Txid] = struct{}{}
+	}
+
+	for conflictingTxid := range conflictingTxids {
+		as.log("tx %s has been replaced by %s", conflictingTxid, txid)
+
+		unspent, deleted, err := dropTransaction(
+			ctx, as.repoManager, conflictingTxid,
+		)
+		if err != nil {
+			as.warn(err, "error while dropping replaced tx %s", conflictingTxid)
+			continue
+		}
+		if unspent > 0 {
+			as.log(
+				"restored %d utxo(s) spent by replaced tx %s", unspent, conflictingTxid,
+			)
+		}
+		if deleted > 0 {
+			as.log(
+				"deleted %d utxo(s) created by replaced tx %s", deleted, conflictingTxid,
+			)
+		}
+	}
+}
+
 func (as *AccountService) storeQueuedTransactions() {
 	txs := as.txQueue.pop()
 	ctx := context.Background()
@@ -385,6 +710,28 @@ func (as *AccountService) storeQueuedTransactions() {
 			continue
 		}
 
+		// The block including the tx has been orphaned by a reorg, the tx might
+		// be back in mempool or included in a different block.
+		var reorgedUtxos, reorgedSpentUtxos []domain.UtxoKey
+		if gotTx.IsConfirmed() && gotTx.BlockHash != tx.BlockHash {
+			as.log(
+				"tx %s has been reorged out of block %s", tx.TxID, gotTx.BlockHash,
+			)
+
+			utxoKeys, spentUtxoKeys, err := as.rollbackTransaction(ctx, tx.TxID)
+			if err != nil {
+				as.warn(
+					err, "error while rolling back transaction %s for account(s) %s",
+					tx.TxID, accounts,
+				)
+				continue
+			}
+			as.log("unconfirmed transaction %s for account(s) %s", tx.TxID, accounts)
+
+			gotTx.Unconfirm()
+			reorgedUtxos, reorgedSpentUtxos = utxoKeys, spentUtxoKeys
+		}
+
 		if !gotTx.IsConfirmed() && tx.IsConfirmed() {
 			as.log("received confirmed tx %s from channel", tx.TxID)
 
@@ -398,6 +745,273 @@ func (as *AccountService) storeQueuedTransactions() {
 				continue
 			}
 			as.log("confirmed transaction %s for account(s) %s", tx.TxID, accounts)
+
+			// The utxos of a reorged tx are confirmed here because the scanner might
+			// have notified about their new status before the rollback.
+			status := domain.UtxoStatus{
+				BlockHash:   tx.BlockHash,
+				BlockHeight: tx.BlockHeight,
+				BlockTime:   tx.BlockTime,
+			}
+			utxoRepo := as.repoManager.UtxoRepository()
+			if len(reorgedUtxos) > 0 {
+				if _, err := utxoRepo.ConfirmUtxos(
+					ctx, reorgedUtxos, status,
+				); err != nil {
+					as.warn(
+						err, "error while confirming utxos of reorged tx %s", tx.TxID,
+					)
+				}
+			}
+			if len(reorgedSpentUtxos) > 0 {
+				status.Txid = tx.TxID
+				if _, err := utxoRepo.ConfirmSpendUtxos(
+					ctx, reorgedSpentUtxos, status,
+				); err != nil {
+					as.warn(
+						err, "error while confirming spent utxos of reorged tx %s",
+						tx.TxID,
+					)
+				}
+			}
+		}
+	}
+}
+
+// rollbackTransaction resets the confirmation status of the given tx and of
+// the utxos it creates or spends. It returns the keys of the utxos affected.
+func (as *AccountService) rollbackTransaction(
+	ctx context.Context, txid string,
+) ([]domain.UtxoKey, []domain.UtxoKey, error) {
+	if _, err := as.repoManager.TransactionRepository().UnconfirmTransaction(
+		ctx, txid,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	utxoRepo := as.repoManager.UtxoRepository()
+	utxos, err := utxoRepo.GetAllUtxos(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	utxoKeys := make([]domain.UtxoKey, 0)
+	spentUtxoKeys := make([]domain.UtxoKey, 0)
+	for _, u := range utxos {
+		if u.TxID == txid {
+			utxoKeys = append(utxoKeys, u.Key())
 		}
+		if u.SpentStatus.Txid == txid {
+			spentUtxoKeys = append(spentUtxoKeys, u.Key())
+		}
+	}
+
+	if len(utxoKeys) > 0 {
+		if _, err := utxoRepo.UnconfirmUtxos(ctx, utxoKeys); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(spentUtxoKeys) > 0 {
+		if _, err := utxoRepo.UnconfirmSpendUtxos(ctx, spentUtxoKeys); err != nil {
+			return nil, nil, err
+		}
+	}
+	return utxoKeys, spentUtxoKeys, nil
+}
+
+// dropTransaction reverts the effects of the given unconfirmed tx, for example
+// in case it's evicted from mempool or replaced: the utxos it spends are
+// marked as unspent, those it creates are deleted as well as the tx and
+// transfer records. Utxos are looked up by key from the stored tx, and only
+// those still spent by it are restored. It returns the number of utxos unspent
+// and deleted.
+func dropTransaction(
+	ctx context.Context, repoManager ports.RepoManager, txid string,
+) (int, int, error) {
+	tx, _ := repoManager.TransactionRepository().GetTransaction(ctx, txid)
+	if tx == nil {
+		return 0, 0, nil
+	}
+	rawTx, err := transaction.NewTxFromHex(tx.TxHex)
+	if err != nil {
+		return -1, -1, err
+	}
+
+	inputKeys := make([]domain.UtxoKey, 0, len(rawTx.Inputs))
+	for _, in := range rawTx.Inputs {
+		inputKeys = append(inputKeys, domain.UtxoKey{
+			TxID: elementsutil.TxIDFromBytes(in.Hash),
+			VOut: in.Index,
+		})
+	}
+	utxoRepo := repoManager.UtxoRepository()
+	inputs, err := utxoRepo.GetUtxosByKey(ctx, inputKeys)
+	if err != nil {
+		return -1, -1, err
+	}
+	spentKeys := make([]domain.UtxoKey, 0, len(inputs))
+	for _, u := range inputs {
+		if u.SpentStatus.Txid == txid {
+			spentKeys = append(spentKeys, u.Key())
+		}
+	}
+	createdKeys := make([]domain.UtxoKey, 0, len(rawTx.Outputs))
+	for i := range rawTx.Outputs {
+		createdKeys = append(createdKeys, domain.UtxoKey{
+			TxID: txid,
+			VOut: uint32(i),
+		})
+	}
+
+	unspent, err := utxoRepo.UnspendUtxos(ctx, spentKeys)
+	if err != nil {
+		return -1, -1, err
+	}
+	deleted, err := utxoRepo.DeleteUtxos(ctx, createdKeys)
+	if err != nil {
+		return -1, -1, err
+	}
+	if _, err := repoManager.TransactionRepository().DeleteTransaction(
+		ctx, txid,
+	); err != nil {
+		return -1, -1, err
+	}
+	if _, err := repoManager.TransferRepository().DeleteTransfer(
+		ctx, txid,
+	); err != nil {
+		return -1, -1, err
+	}
+	return unspent, deleted, nil
+}
+
+// checkUtxosOwnership makes sure that all the given utxos exist and are owned
+// by the given account.
+func (as *AccountService) checkUtxosOwnership(
+	ctx context.Context, accountName string, utxoKeys []domain.UtxoKey,
+) error {
+	if len(utxoKeys) <= 0 {
+		return fmt.Errorf("missing utxos")
+	}
+
+	utxos, err := as.repoManager.UtxoRepository().GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return err
+	}
+	utxosByKey := make(map[domain.UtxoKey]*domain.Utxo)
+	for _, u := range utxos {
+		utxosByKey[u.Key()] = u
+	}
+	for _, key := range utxoKeys {
+		u, ok := utxosByKey[key]
+		if !ok || u.AccountName != accountName {
+			return fmt.Errorf("utxo %s not found for account %s", key, accountName)
+		}
+	}
+	return nil
+}
+
+// parseTxForHistory returns the fee amount and asset of the given tx, and the
+// list of comma-separated counterparties of the outputs not owned by the
+// account. Those owned by other accounts of the wallet are referred by label
+// rather than by address.
+func (as *AccountService) parseTxForHistory(
+	txHex string, account *domain.Account, accounts map[string]*domain.Account,
+) (uint64, string, string, error) {
+	tx, err := transaction.NewTxFromHex(txHex)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	var fee uint64
+	var feeAsset string
+	counterparties := make([]string, 0)
+	for _, out := range tx.Outputs {
+		if len(out.Script) <= 0 {
+			value, _ := elementsutil.ValueFromBytes(out.Value)
+			fee += value
+			feeAsset = elementsutil.AssetHashFromBytes(out.Asset)
+			continue
+		}
+		script := hex.EncodeToString(out.Script)
+		if _, ok := account.DerivationPathByScript[script]; ok {
+			continue
+		}
+		if owner := findScriptOwner(script, accounts); owner != nil {
+			counterparties = append(counterparties, accountLabel(owner))
+			continue
+		}
+		if addr := addressFromScript(out.Script, as.network); addr != "" {
+			counterparties = append(counterparties, addr)
+		}
+	}
+
+	return fee, feeAsset, strings.Join(counterparties, ","), nil
+}
+
+// otherAccountLabels returns the comma-separated labels of the accounts of the
+// wallet involved in the given tx, other than the given one.
+func otherAccountLabels(
+	tx *domain.Transaction, account *domain.Account,
+	accounts map[string]*domain.Account,
+) string {
+	labels := make([]string, 0, len(tx.Accounts))
+	for namespace := range tx.Accounts {
+		if namespace == account.Namespace {
+			continue
+		}
+		if other, ok := accounts[namespace]; ok {
+			labels = append(labels, accountLabel(other))
+		}
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ",")
+}
+
+func findScriptOwner(
+	script string, accounts map[string]*domain.Account,
+) *domain.Account {
+	for _, account := range accounts {
+		if _, ok := account.DerivationPathByScript[script]; ok {
+			return account
+		}
+	}
+	return nil
+}
+
+func accountLabel(account *domain.Account) string {
+	if account.Label != "" {
+		return account.Label
+	}
+	return account.Namespace
+}
+
+func addressFromScript(script []byte, net *network.Network) string {
+	switch address.GetScriptType(script) {
+	case address.P2PkhScript:
+		return address.ToBase58(&address.Base58{
+			Version: net.PubKeyHash,
+			Data:    script[3 : len(script)-2],
+		})
+	case address.P2ShScript:
+		return address.ToBase58(&address.Base58{
+			Version: net.ScriptHash,
+			Data:    script[2 : len(script)-1],
+		})
+	case address.P2WpkhScript, address.P2WshScript:
+		addr, _ := address.ToBech32(&address.Bech32{
+			Prefix:  net.Bech32,
+			Version: 0,
+			Program: script[2:],
+		})
+		return addr
+	case address.P2TRScript:
+		addr, _ := address.ToBech32(&address.Bech32{
+			Prefix:  net.Bech32,
+			Version: 1,
+			Program: script[2:],
+		})
+		return addr
+	default:
+		return ""
 	}
 }
@@ -2,9 +2,14 @@ package application_test
 
 import (
 	"encoding/hex"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/application"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
@@ -19,7 +24,7 @@ func TestAccountService(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, repoManager)
 
-	svc := application.NewAccountService(repoManager, mockedBcScanner)
+	svc := application.NewAccountService(repoManager, mockedBcScanner, regtest, 0)
 
 	addresses, err := svc.DeriveAddressesForAccount(ctx, accountName, 0)
 	require.Error(t, err)
@@ -49,24 +54,327 @@ func TestAccountService(t *testing.T) {
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, len(addresses), 2)
 
-	utxos, err := svc.ListUtxosForAccount(ctx, accountName)
-	require.NoError(t, err)
-	require.NotNil(t, utxos)
-	require.NotEmpty(t, utxos.Spendable)
+	// Utxos are stored asynchronously, let's wait for those of all derived
+	// addresses to show up.
+	var utxos *application.UtxoInfo
+	require.Eventually(t, func() bool {
+		utxos, err = svc.ListUtxosForAccount(ctx, accountName)
+		return err == nil && len(utxos.Spendable) == 3
+	}, 3*time.Second, 100*time.Millisecond)
 	require.Empty(t, utxos.Locked)
 
-	balance, err := svc.GetBalanceForAccount(ctx, accountName)
+	balance, err := svc.GetBalanceForAccount(ctx, accountName, nil)
+	require.NoError(t, err)
+	require.NotNil(t, balance)
+
+	// No utxo can be that deep in the chain, therefore the whole balance is
+	// expected to be considered unconfirmed.
+	maxConfirmations := uint32(math.MaxUint32)
+	balance, err = svc.GetBalanceForAccount(ctx, accountName, &maxConfirmations)
 	require.NoError(t, err)
 	require.NotNil(t, balance)
+	for _, b := range balance {
+		require.Zero(t, b.Confirmed)
+		require.Positive(t, b.Unconfirmed)
+	}
+
+	// With an explicit 0, the whole balance is expected to be confirmed.
+	zeroConfirmations := uint32(0)
+	balance, err = svc.GetBalanceForAccount(ctx, accountName, &zeroConfirmations)
+	require.NoError(t, err)
+	require.NotNil(t, balance)
+	for _, b := range balance {
+		require.Positive(t, b.Confirmed)
+		require.Zero(t, b.Unconfirmed)
+	}
+
+	frozenUtxo := utxos.Spendable[0]
+	frozenKeys := []domain.UtxoKey{frozenUtxo.Key()}
+	_, err = svc.FreezeUtxos(ctx, accountName, frozenKeys, "")
+	require.Error(t, err)
+	_, err = svc.FreezeUtxos(ctx, "wrongAccount", frozenKeys, "dust")
+	require.Error(t, err)
+
+	count, err := svc.FreezeUtxos(ctx, accountName, frozenKeys, "dust")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	frozenUtxos, err := svc.ListUtxosForAccount(ctx, accountName)
+	require.NoError(t, err)
+	require.Len(t, frozenUtxos.Spendable, len(utxos.Spendable)-1)
+	require.Len(t, frozenUtxos.Frozen, 1)
+	require.Equal(t, "dust", frozenUtxos.Frozen[0].FreezeReason)
+
+	balance, err = svc.GetBalanceForAccount(ctx, accountName, nil)
+	require.NoError(t, err)
+	require.Equal(t, frozenUtxo.Value, balance[frozenUtxo.Asset].Frozen)
+
+	count, err = svc.UnfreezeUtxos(ctx, accountName, frozenKeys)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	frozenUtxos, err = svc.ListUtxosForAccount(ctx, accountName)
+	require.NoError(t, err)
+	require.Len(t, frozenUtxos.Spendable, len(utxos.Spendable))
+	require.Empty(t, frozenUtxos.Frozen)
+
+	// Same goes for txs.
+	var history []application.HistoryEntry
+	require.Eventually(t, func() bool {
+		history, err = exportHistory(svc, accountName)
+		return err == nil && len(history) == len(utxos.Spendable)
+	}, 3*time.Second, 100*time.Millisecond)
+	for _, entry := range history {
+		require.Positive(t, entry.Amount)
+		require.Zero(t, entry.Fee)
+		require.Empty(t, entry.Counterparty)
+		require.Equal(t, accountName, entry.Label)
+	}
+
+	// Simulate a chain reorg that brings back to mempool the tx creating one of
+	// the utxos, and its later eviction.
+	reorgedUtxo := utxos.Spendable[0]
+	mockedBcScanner.chTxs <- &domain.Transaction{
+		TxID:     reorgedUtxo.TxID,
+		Accounts: map[string]struct{}{accountName: {}},
+	}
+	require.Eventually(t, func() bool {
+		list, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{reorgedUtxo.Key()},
+		)
+		return err == nil && len(list) == 1 && !list[0].IsConfirmed()
+	}, 3*time.Second, 100*time.Millisecond)
+
+	tx, err := repoManager.TransactionRepository().GetTransaction(
+		ctx, reorgedUtxo.TxID,
+	)
+	require.NoError(t, err)
+	require.False(t, tx.IsConfirmed())
+
+	// Unconfirmed utxos must be counted in the balance regardless of the
+	// required number of confirmations.
+	balance, err = svc.GetBalanceForAccount(ctx, accountName, nil)
+	require.NoError(t, err)
+	require.Positive(t, balance[reorgedUtxo.Asset].Unconfirmed)
+	deepBalance, err := svc.GetBalanceForAccount(
+		ctx, accountName, &maxConfirmations,
+	)
+	require.NoError(t, err)
+	for asset, b := range balance {
+		require.Equal(
+			t, b.Confirmed+b.Unconfirmed, deepBalance[asset].Unconfirmed,
+		)
+	}
+
+	// Simulate the eviction of an unconfirmed tx spending one of the utxos and
+	// creating a new one: the spent utxo is expected to be spendable again,
+	// while the created one and the tx are expected to be removed.
+	spentUtxo := utxos.Spendable[1]
+	evictedTx := transaction.NewTx(2)
+	prevoutHash, _ := elementsutil.TxIDToBytes(spentUtxo.TxID)
+	evictedTx.AddInput(transaction.NewTxInput(prevoutHash, spentUtxo.VOut))
+	value, _ := elementsutil.ValueToBytes(spentUtxo.Value)
+	asset, _ := elementsutil.AssetHashToBytes(spentUtxo.Asset)
+	evictedTx.AddOutput(transaction.NewTxOutput(asset, value, spentUtxo.Script))
+	evictedTxHex, err := evictedTx.ToHex()
+	require.NoError(t, err)
+	evictedTxid := evictedTx.TxHash().String()
+	createdUtxoKey := domain.UtxoKey{TxID: evictedTxid, VOut: 0}
+
+	mockedBcScanner.chUtxos <- []*domain.Utxo{{
+		UtxoKey:     createdUtxoKey,
+		Value:       spentUtxo.Value,
+		Asset:       spentUtxo.Asset,
+		Script:      spentUtxo.Script,
+		AccountName: spentUtxo.AccountName,
+	}}
+	mockedBcScanner.chTxs <- &domain.Transaction{
+		TxID:     evictedTxid,
+		TxHex:    evictedTxHex,
+		Accounts: map[string]struct{}{accountName: {}},
+	}
+	_, err = repoManager.UtxoRepository().SpendUtxos(
+		ctx, []domain.UtxoKey{spentUtxo.Key()}, evictedTxid,
+	)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		tx, _ := repoManager.TransactionRepository().GetTransaction(
+			ctx, evictedTxid,
+		)
+		list, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{createdUtxoKey},
+		)
+		return tx != nil && err == nil && len(list) == 1
+	}, 3*time.Second, 100*time.Millisecond)
+
+	mockedBcScanner.chEvictedTxs <- evictedTxid
+	require.Eventually(t, func() bool {
+		list, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{createdUtxoKey},
+		)
+		return err == nil && len(list) == 0
+	}, 3*time.Second, 100*time.Millisecond)
+
+	list, err := repoManager.UtxoRepository().GetUtxosByKey(
+		ctx, []domain.UtxoKey{spentUtxo.Key()},
+	)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.False(t, list[0].IsSpent())
+
+	tx, err = repoManager.TransactionRepository().GetTransaction(
+		ctx, evictedTxid,
+	)
+	require.Error(t, err)
+	require.Nil(t, tx)
+
+	// Simulate the replacement of the same tx: once the scanner notifies that
+	// the utxo is spent by another tx, the replaced one is expected to be
+	// dropped like in case of eviction.
+	replacedTxid := evictedTxid
+	replacementTxid := randomHex(32)
+	mockedBcScanner.chUtxos <- []*domain.Utxo{{
+		UtxoKey:     createdUtxoKey,
+		Value:       spentUtxo.Value,
+		Asset:       spentUtxo.Asset,
+		Script:      spentUtxo.Script,
+		AccountName: spentUtxo.AccountName,
+	}}
+	mockedBcScanner.chTxs <- &domain.Transaction{
+		TxID:     replacedTxid,
+		TxHex:    evictedTxHex,
+		Accounts: map[string]struct{}{accountName: {}},
+	}
+	_, err = repoManager.UtxoRepository().SpendUtxos(
+		ctx, []domain.UtxoKey{spentUtxo.Key()}, replacedTxid,
+	)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		tx, _ := repoManager.TransactionRepository().GetTransaction(
+			ctx, replacedTxid,
+		)
+		list, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{createdUtxoKey},
+		)
+		return tx != nil && err == nil && len(list) == 1
+	}, 3*time.Second, 100*time.Millisecond)
+
+	mockedBcScanner.chUtxos <- []*domain.Utxo{{
+		UtxoKey:     spentUtxo.Key(),
+		Value:       spentUtxo.Value,
+		Asset:       spentUtxo.Asset,
+		Script:      spentUtxo.Script,
+		AccountName: spentUtxo.AccountName,
+		SpentStatus: domain.UtxoStatus{Txid: replacementTxid},
+	}}
+	require.Eventually(t, func() bool {
+		list, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{createdUtxoKey},
+		)
+		return err == nil && len(list) == 0
+	}, 3*time.Second, 100*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		list, err := repoManager.UtxoRepository().GetUtxosByKey(
+			ctx, []domain.UtxoKey{spentUtxo.Key()},
+		)
+		return err == nil && len(list) == 1 &&
+			list[0].SpentStatus.Txid == replacementTxid
+	}, 3*time.Second, 100*time.Millisecond)
+
+	tx, err = repoManager.TransactionRepository().GetTransaction(
+		ctx, replacedTxid,
+	)
+	require.Error(t, err)
+	require.Nil(t, tx)
+
+	// Simulate an outgoing tx spending an lbtc utxo of the account to pay an
+	// external receiver, with change and fee: the history entry is expected to
+	// report the amount sent plus the fee as a negative amount, the fee and
+	// the receiver as counterparty.
+	fundingUtxo := randomUtxo(accountNamespace, "")
+	fundingUtxo.Asset = regtest.AssetID
+	fundingUtxo.Value = 100000
+	fundingUtxo.Script = utxos.Spendable[2].Script
+	_, err = repoManager.UtxoRepository().AddUtxos(
+		ctx, []*domain.Utxo{fundingUtxo},
+	)
+	require.NoError(t, err)
+
+	sentAmount, changeAmount, feeAmount := uint64(60000), uint64(39500), uint64(500)
+	lbtc, _ := elementsutil.AssetHashToBytes(regtest.AssetID)
+	outgoingTx := transaction.NewTx(2)
+	prevoutHash, _ = elementsutil.TxIDToBytes(fundingUtxo.TxID)
+	outgoingTx.AddInput(transaction.NewTxInput(prevoutHash, fundingUtxo.VOut))
+	value, _ = elementsutil.ValueToBytes(sentAmount)
+	outgoingTx.AddOutput(
+		transaction.NewTxOutput(lbtc, value, receiverAddrInfo.Script),
+	)
+	value, _ = elementsutil.ValueToBytes(changeAmount)
+	outgoingTx.AddOutput(
+		transaction.NewTxOutput(lbtc, value, fundingUtxo.Script),
+	)
+	value, _ = elementsutil.ValueToBytes(feeAmount)
+	outgoingTx.AddOutput(transaction.NewTxOutput(lbtc, value, nil))
+	outgoingTxHex, err := outgoingTx.ToHex()
+	require.NoError(t, err)
+	outgoingTxid := outgoingTx.TxHash().String()
+
+	_, err = repoManager.TransactionRepository().AddTransaction(
+		ctx, &domain.Transaction{
+			TxID:     outgoingTxid,
+			TxHex:    outgoingTxHex,
+			Accounts: map[string]struct{}{accountNamespace: {}},
+		},
+	)
+	require.NoError(t, err)
+	_, err = repoManager.UtxoRepository().SpendUtxos(
+		ctx, []domain.UtxoKey{fundingUtxo.Key()}, outgoingTxid,
+	)
+	require.NoError(t, err)
+	changeUtxo := randomUtxo(accountNamespace, "")
+	changeUtxo.UtxoKey = domain.UtxoKey{TxID: outgoingTxid, VOut: 1}
+	changeUtxo.Asset = regtest.AssetID
+	changeUtxo.Value = changeAmount
+	changeUtxo.Script = fundingUtxo.Script
+	_, err = repoManager.UtxoRepository().AddUtxos(
+		ctx, []*domain.Utxo{changeUtxo},
+	)
+	require.NoError(t, err)
+
+	receiverAddr, err := address.ToBech32(&address.Bech32{
+		Prefix:  regtest.Bech32,
+		Version: 0,
+		Program: receiverAddrInfo.Script[2:],
+	})
+	require.NoError(t, err)
+
+	history, err = exportHistory(svc, accountName)
+	require.NoError(t, err)
+	outgoingEntries := make([]application.HistoryEntry, 0)
+	for _, entry := range history {
+		if entry.TxID == outgoingTxid {
+			outgoingEntries = append(outgoingEntries, entry)
+		}
+	}
+	require.Len(t, outgoingEntries, 1)
+	require.Equal(t, regtest.AssetID, outgoingEntries[0].Asset)
+	require.Equal(
+		t, -int64(sentAmount+feeAmount), outgoingEntries[0].Amount,
+	)
+	require.Equal(t, feeAmount, outgoingEntries[0].Fee)
+	require.Equal(t, receiverAddr, outgoingEntries[0].Counterparty)
+	require.False(t, outgoingEntries[0].Internal)
 
 	// Cannot delete an account with non-zero balance.
 	err = svc.DeleteAccount(ctx, accountName)
 	require.Error(t, err)
 
 	// Simulate withdrawing all funds by spending every spendable utxo coming
-	// from ListUtxosForAccount.
+	// from ListUtxosForAccount, plus the change of the outgoing tx.
 	txid := hex.EncodeToString(make([]byte, 32))
-	_, err = repoManager.UtxoRepository().SpendUtxos(ctx, utxos.Spendable.Keys(), txid)
+	keys := append(utxos.Spendable.Keys(), changeUtxo.Key())
+	_, err = repoManager.UtxoRepository().SpendUtxos(ctx, keys, txid)
 	require.NoError(t, err)
 
 	// Now deleting the account should work without errors.
@@ -102,3 +410,18 @@ func newRepoManagerForAccountService() (ports.RepoManager, error) {
 
 	return rm, nil
 }
+
+func exportHistory(
+	svc *application.AccountService, accountName string,
+) ([]application.HistoryEntry, error) {
+	history := make([]application.HistoryEntry, 0)
+	if err := svc.ExportHistoryForAccount(
+		ctx, accountName, func(entry application.HistoryEntry) error {
+			history = append(history, entry)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
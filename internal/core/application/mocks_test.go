@@ -16,14 +16,16 @@ import (
 // ports.BlockchainScanner
 type mockBcScanner struct {
 	mock.Mock
-	chTxs   chan *domain.Transaction
-	chUtxos chan []*domain.Utxo
+	chTxs        chan *domain.Transaction
+	chUtxos      chan []*domain.Utxo
+	chEvictedTxs chan string
 }
 
 func newMockedBcScanner() *mockBcScanner {
 	return &mockBcScanner{
-		chTxs:   make(chan *domain.Transaction),
-		chUtxos: make(chan []*domain.Utxo),
+		chTxs:        make(chan *domain.Transaction),
+		chUtxos:      make(chan []*domain.Utxo),
+		chEvictedTxs: make(chan string),
 	}
 }
 
@@ -38,7 +40,7 @@ func (m *mockBcScanner) WatchForAccount(
 		m.chUtxos <- utxos
 
 		for _, u := range utxos {
-			tx := randomTx(accountName, u.TxID)
+			tx := randomTx(u.TxID, accountName)
 			m.chTxs <- tx
 		}
 	}
@@ -64,7 +66,7 @@ func (m *mockBcScanner) WatchForUtxos(
 		m.chUtxos <- list
 
 		for _, u := range utxos {
-			tx := randomTx(accountName, u.TxID)
+			tx := randomTx(u.TxID, accountName)
 			m.chTxs <- tx
 		}
 	}
@@ -92,6 +94,7 @@ func (m *mockBcScanner) RestoreAccount(
 func (m *mockBcScanner) StopWatchForAccount(accountName string) {
 	close(m.chTxs)
 	close(m.chUtxos)
+	close(m.chEvictedTxs)
 }
 
 func (m *mockBcScanner) GetUtxoChannel(accountName string) chan []*domain.Utxo {
@@ -102,6 +105,10 @@ func (m *mockBcScanner) GetTxChannel(accountName string) chan *domain.Transactio
 	return m.chTxs
 }
 
+func (m *mockBcScanner) GetEvictedTxChannel(accountName string) chan string {
+	return m.chEvictedTxs
+}
+
 func (m *mockBcScanner) GetLatestBlock() ([]byte, uint32, error) {
 	args := m.Called()
 	var res []byte
@@ -160,6 +167,15 @@ func (m *mockBcScanner) BroadcastTransaction(txHex string) (string, error) {
 	return res, args.Error(1)
 }
 
+func (m *mockBcScanner) EstimateFeeRate(confTarget uint32) (uint64, error) {
+	args := m.Called(confTarget)
+	var res uint64
+	if a := args.Get(0); a != nil {
+		res = a.(uint64)
+	}
+	return res, args.Error(1)
+}
+
 func (m *mockBcScanner) GetTransactions(txids []string) ([]domain.Transaction, error) {
 	args := m.Called(txids)
 	var res []domain.Transaction
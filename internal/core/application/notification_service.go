@@ -14,16 +14,20 @@ import (
 )
 
 // Notification service has the very simple task of making the event channels
-// of the used domain.TransactionRepository and domain.UtxoRepository
-// accessible by external clients so that they can get real-time updates on the
+// of the used domain.TransactionRepository, domain.UtxoRepository,
+// domain.PaymentRepository and domain.SpendingPolicyRepository accessible by external clients so that they can get real-time updates on the
 // status of the internal wallet.
 type NotificationService struct {
 	repoManager ports.RepoManager
 	bcScanner   ports.BlockchainScanner
 	chUtxos     chan domain.UtxoEvent
 	chTxs       chan domain.TransactionEvent
+	chPayments  chan domain.PaymentEvent
+	chPolicies  chan domain.PolicyEvent
 	utxoLock    *sync.Mutex
 	txLock      *sync.Mutex
+	paymentLock *sync.Mutex
+	policyLock  *sync.Mutex
 
 	log func(format string, a ...interface{})
 }
@@ -37,15 +41,22 @@ func NewNotificationService(
 	}
 	chUtxos := make(chan domain.UtxoEvent)
 	chTxs := make(chan domain.TransactionEvent)
+	chPayments := make(chan domain.PaymentEvent)
+	chPolicies := make(chan domain.PolicyEvent)
 	utxoLock := &sync.Mutex{}
 	txLock := &sync.Mutex{}
+	paymentLock := &sync.Mutex{}
+	policyLock := &sync.Mutex{}
 
 	svc := &NotificationService{
-		repoManager, bcScanner, chUtxos, chTxs, utxoLock, txLock, logFn,
+		repoManager, bcScanner, chUtxos, chTxs, chPayments, chPolicies,
+		utxoLock, txLock, paymentLock, policyLock, logFn,
 	}
 	svc.registerHandlersForExternalScripts()
 	go svc.listenToInternalTxs()
 	go svc.listenToInternalUtxos()
+	go svc.listenToPayments()
+	go svc.listenToPolicies()
 
 	return svc
 }
@@ -62,6 +73,18 @@ func (ns *NotificationService) GetUtxoChannel(
 	return ns.chUtxos, nil
 }
 
+func (ns *NotificationService) GetPaymentChannel(
+	ctx context.Context,
+) (chan domain.PaymentEvent, error) {
+	return ns.chPayments, nil
+}
+
+func (ns *NotificationService) GetPolicyChannel(
+	ctx context.Context,
+) (chan domain.PolicyEvent, error) {
+	return ns.chPolicies, nil
+}
+
 func (ns *NotificationService) WatchScript(
 	ctx context.Context, scriptHex, blindingKey string,
 ) (string, error) {
@@ -125,6 +148,20 @@ func (ns *NotificationService) listenToInternalUtxos() {
 	}
 }
 
+func (ns *NotificationService) listenToPayments() {
+	chPayments := ns.repoManager.PaymentRepository().GetEventChannel()
+	for event := range chPayments {
+		go ns.publishPayment(event)
+	}
+}
+
+func (ns *NotificationService) listenToPolicies() {
+	chPolicies := ns.repoManager.SpendingPolicyRepository().GetEventChannel()
+	for event := range chPolicies {
+		go ns.publishPolicy(event)
+	}
+}
+
 func (ns *NotificationService) publishUtxo(event domain.UtxoEvent) {
 	ns.utxoLock.Lock()
 	defer ns.utxoLock.Unlock()
@@ -139,6 +176,20 @@ func (ns *NotificationService) publishTx(event domain.TransactionEvent) {
 	ns.chTxs <- event
 }
 
+func (ns *NotificationService) publishPayment(event domain.PaymentEvent) {
+	ns.paymentLock.Lock()
+	defer ns.paymentLock.Unlock()
+
+	ns.chPayments <- event
+}
+
+func (ns *NotificationService) publishPolicy(event domain.PolicyEvent) {
+	ns.policyLock.Lock()
+	defer ns.policyLock.Unlock()
+
+	ns.chPolicies <- event
+}
+
 func (ns *NotificationService) registerHandlersForExternalScripts() {
 	// Start watching external scripts as soon as they are persisted.
 	ns.repoManager.RegisterHandlerForExternalScriptEvent(
@@ -3,17 +3,25 @@ package application
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/txscript"
 	log "github.com/sirupsen/logrus"
 	"github.com/vulpemventures/go-bip32"
 	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/confidential"
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/network"
 	"github.com/vulpemventures/go-elements/psetv2"
@@ -25,6 +33,106 @@ import (
 )
 
 var (
+	ErrMissingLeaseID = fmt.Errorf("missing lease id")
+	ErrLeaseNotFound  = fmt.Errorf("lease not found")
+
+	ErrInvalidNumOfFragments = fmt.Errorf(
+		"number of fragments must be in range [1, %d]", MaxFragments,
+	)
+	ErrBalanceTooLowToFragment = fmt.Errorf(
+		"balance is too low to be split into the given fragments",
+	)
+
+	ErrExtraInputsNotAllowed = fmt.Errorf("extra inputs are not allowed")
+
+	ErrMissingFeeOutput = fmt.Errorf(
+		"at least one lbtc output is required to subtract fees from",
+	)
+	ErrSendAllMultipleOutputs = fmt.Errorf(
+		"only one output per asset is allowed when sending all funds",
+	)
+
+	ErrInvalidMaxInputs     = fmt.Errorf("max number of inputs must be at least 2")
+	ErrFeeRateAboveCeiling  = fmt.Errorf("fee rate exceeds the given ceiling")
+	ErrNothingToConsolidate = fmt.Errorf("not found enough utxos to consolidate")
+
+	ErrTxAlreadyConfirmed = fmt.Errorf("transaction is already confirmed")
+	ErrTxNotReplaceable   = fmt.Errorf(
+		"transaction either doesn't signal replaceability or was not crafted " +
+			"by a transfer",
+	)
+	ErrFeeRateTooLow = fmt.Errorf("fee rate is too low")
+
+	ErrTxWithoutOwnedOutputs = fmt.Errorf(
+		"transaction has no spendable outputs owned by the wallet",
+	)
+
+	ErrSameAccount = fmt.Errorf("source and target accounts must be different")
+
+	ErrPaymentBatchingDisabled = fmt.Errorf("payment batching is disabled")
+	ErrPaymentNotFound         = fmt.Errorf("payment not found")
+	ErrPaymentBeingSent        = fmt.Errorf(
+		"payment is being sent and can't be canceled",
+	)
+
+	ErrInvalidSwapTerms = fmt.Errorf(
+		"swap assets must be different and amounts must be greater than zero",
+	)
+	ErrInvalidSwapOffer = fmt.Errorf(
+		"swap offer must give exactly one asset in exchange for another one",
+	)
+	ErrSwapExpired                = fmt.Errorf("swap utxos are no longer locked")
+	ErrSwapNotAccepted            = fmt.Errorf("swap has not been accepted yet")
+	ErrMissingSwapUnblindedInputs = fmt.Errorf(
+		"the unblinded inputs of the counterparty are required to blind the swap",
+	)
+	ErrSwapUtxosMismatch = fmt.Errorf(
+		"swap pset must spend all and only the utxos locked for the swap",
+	)
+	ErrSwapAmountsMismatch = fmt.Errorf("swap amounts don't match the offer")
+
+	ErrInvalidFragmentTerms = fmt.Errorf(
+		"fragment must exchange a utxo for a positive amount of another asset",
+	)
+	ErrInvalidFragment = fmt.Errorf(
+		"fragment must have one input signed with SIGHASH_SINGLE|ANYONECANPAY " +
+			"and one output",
+	)
+	ErrMissingFragments    = fmt.Errorf("missing fragments to fill")
+	ErrBlindUnconfFragment = fmt.Errorf(
+		"cannot blind the output of a fragment of an unconfidential account",
+	)
+	ErrFragmentsNeedBlinding = fmt.Errorf(
+		"confidential fragments can be filled only by a confidential account",
+	)
+
+	ErrFeeGuardExceeded = fmt.Errorf(
+		"transaction fee rate exceeds the sanity ceiling, override the fee " +
+			"guard to sign it anyway",
+	)
+
+	ErrSpendingPolicyViolation = fmt.Errorf(
+		"transaction violates the spending policy of the account",
+	)
+	ErrSpendingApprovalRequired = fmt.Errorf(
+		"transaction requires the approval of the spending",
+	)
+	ErrMissingRequiredApprovals = fmt.Errorf(
+		"approval thresholds require a positive number of required approvals",
+	)
+	ErrNotEnoughApprovers = fmt.Errorf(
+		"required approvals exceed the number of approvers of the policy",
+	)
+	ErrMissingApprover          = fmt.Errorf("missing approver")
+	ErrMissingApprovalSignature = fmt.Errorf("missing approval signature")
+	ErrUnknownApprover          = fmt.Errorf(
+		"approver is not registered in the spending policy of the account",
+	)
+	ErrInvalidApprovalSignature = fmt.Errorf(
+		"approval signature does not match the spending digest",
+	)
+	ErrSpendingExpired = fmt.Errorf("spending is no longer pending")
+
 	ErrForbiddenUnlockedInputs = fmt.Errorf(
 		"the utxos used within 'external' transactions must be coming from a " +
 			"wallet's coin selection so that they can be temporary locked and " +
@@ -32,22 +140,47 @@ var (
 	)
 )
 
+// fragmentSighashType makes the input of a fragment commit only to itself and
+// to the output at the same index.
+const fragmentSighashType = txscript.SigHashSingle | txscript.SigHashAnyOneCanPay
+
+// spendingWindow is the rolling period within which the signed spendings
+// count towards the daily limits and the pending ones can be approved.
+const spendingWindow = 24 * time.Hour
+
 // TransactionService is responsible for operations related to one or more
 // accounts:
 //   - Get info about a wallet-related transaction.
 //   - Select a subset of the utxos of an existing account to cover a target amount. The selected utxos will be temporary locked to prevent double spending them.
+//   - Release the utxos locked by a lease before it expires, extend the lease, or list the active ones.
 //   - Estimate the fee amount for a transation composed by X inputs and Y outputs. It is required that the inputs owned by the wallet are locked utxos.
+//   - Estimate the fee rate for a transaction to be confirmed within X blocks.
 //   - Sign a raw transaction (in hex format). It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Broadcast a raw transaction (in hex format). It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Create a partial transaction (v2) given a list of inputs and outputs. It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Add inputs or outputs to partial transaction (v2). It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Blind a partial transaction (v2) either as non-last or last blinder. It is required that the inputs of the tx owned by the wallet are locked utxos.
 //   - Sign a partial transaction (v2). It is required that the inputs of the tx owned by the wallet are locked utxos.
-//   - Craft a finalized transaction to transfer some funds from an existing account to somewhere else, given a list of outputs.
+//   - Decode a partial transaction (v2) to know which inputs and outputs are owned by which account and how it changes their balances.
+//   - Combine copies of the same partial transaction (v2) updated by different parties, or finalize and extract it, also for inputs locked by multisig and other template scripts.
+//   - Craft a finalized transaction to transfer some funds from an existing account to somewhere else, given a list of outputs and optionally the utxos to spend, or just preview it.
+//   - Replace an unconfirmed transaction crafted by a transfer with one paying higher fees, and broadcast it.
+//   - Accelerate an unconfirmed transaction by spending its outputs owned by the wallet with a child paying for both (CPFP), and broadcast it.
+//   - Move funds between two accounts of the wallet with a transaction recorded as internal for both, and broadcast it.
+//   - Craft a finalized transaction to split the balance of an asset of an existing account into many utxos.
+//   - Craft a finalized transaction to sweep the smallest utxos of an asset of an existing account into one, optionally on a regular basis.
+//   - Queue payments of an existing account, cancel or list them. If enabled, the queued payments of every account are periodically sent with a single batched transaction.
+//   - Create a swap offer of some funds of an existing account in exchange for another asset, accept the offer of a counterparty or complete a swap by blinding and signing the partial transaction (v2). The utxos spent by the account are locked for the lifetime of the swap and revealed to the counterparty so that either party can blind the transaction.
+//   - Create a fragment, that is a partial transaction (v2) exchanging a utxo of an existing account for an output at the same index, signed with SIGHASH_SINGLE|ANYONECANPAY, or fill some fragments by merging them into a transaction funded by an existing account. The fragments are verified before being merged.
+//   - Refuse to sign a raw or partial transaction (v2) whose effective fee rate exceeds the ceiling of the fee guard, if enabled, unless explicitly overridden.
+//   - Set, get or delete the spending policy of an existing account, approve its pending spendings, list them or list the violations of the policy. Every transaction spending funds of an account with a policy is checked against it before being signed: amounts per tx and per rolling day, destinations, fee rate and amount, and approvals above a threshold.
 //
 // The service registers 1 handler for the following utxo event:
 //   - domain.UtxoLocked - whenever one or more utxos are locked, the service spawns a so-called unlocker, a goroutine wating for X seconds before unlocking them if necessary. The operation is just skipped if the utxos have been spent meanwhile.
 //
+// The service registers 1 handler for the following tx event:
+//   - domain.TransactionConfirmed - whenever a tx is confirmed, the service deletes the info stored to eventually replace it.
+//
 // The service guarantees that any locked utxo is eventually unlocked ASAP
 // after the waiting time expires.
 // Therefore, at startup, it makes sure to unlock any still-locked utxo that
@@ -59,24 +192,40 @@ type TransactionService struct {
 	network            *network.Network
 	utxoExpiryDuration time.Duration
 	dustAmount         uint64
+	minConfirmations   uint32
+	discountCT         bool
+	batching           *BatchingConfig
+	paymentLock        *sync.Mutex
+	maxFeeRate         uint64
+	spendingLocks      map[string]*sync.Mutex
+	spendingLocksLock  *sync.Mutex
 
-	log func(format string, a ...interface{})
+	log  func(format string, a ...interface{})
+	warn func(err error, format string, a ...interface{})
 }
 
 func NewTransactionService(
 	repoManager ports.RepoManager, bcScanner ports.BlockchainScanner,
 	net *network.Network, utxoExpiryDuration time.Duration, dustAmount uint64,
+	minConfirmations uint32, discountCT bool,
 ) *TransactionService {
 	logFn := func(format string, a ...interface{}) {
 		format = fmt.Sprintf("transaction service: %s", format)
 		log.Debugf(format, a...)
 	}
+	warnFn := func(err error, format string, a ...interface{}) {
+		format = fmt.Sprintf("transaction service: %s", format)
+		log.WithError(err).Warnf(format, a...)
+	}
 
 	svc := &TransactionService{
-		repoManager, bcScanner, net, utxoExpiryDuration, dustAmount, logFn,
+		repoManager, bcScanner, net, utxoExpiryDuration, dustAmount,
+		minConfirmations, discountCT, nil, &sync.Mutex{}, 0,
+		make(map[string]*sync.Mutex), &sync.Mutex{}, logFn, warnFn,
 	}
 	svc.registerHandlerForUtxoEvents()
 	svc.registerHandlerForWalletEvents()
+	svc.registerHandlerForTxEvents()
 
 	return svc
 }
@@ -97,72 +246,169 @@ func (ts *TransactionService) GetTransactionInfo(
 
 func (ts *TransactionService) SelectUtxos(
 	ctx context.Context, accountName, targetAsset string, targetAmount uint64,
-	coinSelectionStrategy int,
-) (Utxos, uint64, int64, error) {
+	coinSelectionStrategy int, minConfirmations *uint32,
+) (Utxos, uint64, *Lease, error) {
 	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
-		return nil, 0, -1, err
+		return nil, 0, nil, err
 	}
 
-	utxos, err := ts.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
-		ctx, account.Namespace,
-	)
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, minConfirmations)
 	if err != nil {
-		return nil, 0, -1, err
+		return nil, 0, nil, err
 	}
 
 	coinSelector := DefaultCoinSelector
 	if factory, ok := coinSelectorByType[coinSelectionStrategy]; ok {
-		coinSelector = factory()
+		coinSelector = factory(ts.network.AssetID, MinMillisatsPerByte, ts.discountCT)
 	}
 
 	utxos, change, err := coinSelector.SelectUtxos(utxos, targetAmount, targetAsset)
 	if err != nil {
-		return nil, 0, -1, err
+		return nil, 0, nil, err
 	}
-	now := time.Now()
-	lockExpiration := now.Add(ts.utxoExpiryDuration).Unix()
-	keys := Utxos(utxos).Keys()
-	count, err := ts.repoManager.UtxoRepository().LockUtxos(
-		ctx, keys, now.Unix(), lockExpiration,
-	)
+
+	lease, err := ts.lockUtxos(ctx, account.Namespace, Utxos(utxos).Keys())
 	if err != nil {
-		return nil, 0, -1, err
-	}
-	if count > 0 {
-		ts.log(
-			"locked %d utxo(s) for account %s (%s)",
-			count, account.Namespace, UtxoKeys(keys),
-		)
+		return nil, 0, nil, err
 	}
 
-	return utxos, change, lockExpiration, nil
+	return utxos, change, lease, nil
 }
 
 func (ts *TransactionService) LockUtxos(
 	ctx context.Context, accountName string, ins Inputs,
-) (int64, error) {
+) (*Lease, error) {
 	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
-	now := time.Now()
-	lockExpiration := now.Add(ts.utxoExpiryDuration).Unix()
+	return ts.lockUtxos(ctx, account.Namespace, ins.Keys())
+}
+
+// UnlockUtxos releases the given utxos locked by the given lease, or all of
+// them if no utxo is specified.
+func (ts *TransactionService) UnlockUtxos(
+	ctx context.Context, leaseID string, ins Inputs,
+) (int, error) {
+	if leaseID == "" {
+		return -1, ErrMissingLeaseID
+	}
+
+	utxoRepo := ts.repoManager.UtxoRepository()
 	keys := ins.Keys()
-	count, err := ts.repoManager.UtxoRepository().LockUtxos(
-		ctx, keys, now.Unix(), lockExpiration,
-	)
+	if len(keys) <= 0 {
+		utxos, err := utxoRepo.GetUtxosForLease(ctx, leaseID)
+		if err != nil {
+			return -1, err
+		}
+		if len(utxos) <= 0 {
+			return -1, ErrLeaseNotFound
+		}
+		keys = Utxos(utxos).Keys()
+	}
+
+	count, err := utxoRepo.ReleaseUtxos(ctx, keys, leaseID)
 	if err != nil {
 		return -1, err
 	}
 	if count > 0 {
 		ts.log(
-			"locked %d utxo(s) for account %s (%s)",
-			count, account.Namespace, UtxoKeys(keys),
+			"released %d utxo(s) locked by lease %s (%s)",
+			count, leaseID, UtxoKeys(keys),
+		)
+	}
+	return count, nil
+}
+
+// ExtendLease postpones the expiration of the given lease so that it expires
+// after the given duration from now. If not specified, the duration defaults
+// to the one used when locking utxos.
+func (ts *TransactionService) ExtendLease(
+	ctx context.Context, leaseID string, duration time.Duration,
+) (*Lease, error) {
+	if leaseID == "" {
+		return nil, ErrMissingLeaseID
+	}
+	if duration <= 0 {
+		duration = ts.utxoExpiryDuration
+	}
+
+	utxoRepo := ts.repoManager.UtxoRepository()
+	utxos, err := utxoRepo.GetUtxosForLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(utxos) <= 0 {
+		return nil, ErrLeaseNotFound
+	}
+
+	keys := Utxos(utxos).Keys()
+	expiration := time.Now().Add(duration).Unix()
+	if _, err := utxoRepo.ExtendUtxosLease(
+		ctx, keys, leaseID, expiration,
+	); err != nil {
+		return nil, err
+	}
+	ts.log(
+		"extended lease %s until %s (%s)",
+		leaseID, time.Unix(expiration, 0).Format(time.RFC3339), UtxoKeys(keys),
+	)
+
+	lease := newLease(utxos)
+	lease.ExpiryTimestamp = expiration
+	return lease, nil
+}
+
+// ListLeases returns the active leases of the given account, or those of all
+// accounts if not specified.
+func (ts *TransactionService) ListLeases(
+	ctx context.Context, accountName string,
+) ([]Lease, error) {
+	accounts := make([]string, 0)
+	if accountName != "" {
+		account, err := ts.getAccount(ctx, accountName)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account.Namespace)
+	} else {
+		w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for namespace := range w.Accounts {
+			accounts = append(accounts, namespace)
+		}
+	}
+
+	leases := make([]Lease, 0)
+	for _, namespace := range accounts {
+		utxos, err := ts.repoManager.UtxoRepository().GetLockedUtxosForAccount(
+			ctx, namespace,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		leaseIDs := make([]string, 0)
+		utxosByLease := make(map[string][]*domain.Utxo)
+		for _, u := range utxos {
+			if _, ok := utxosByLease[u.LeaseID]; !ok {
+				leaseIDs = append(leaseIDs, u.LeaseID)
+			}
+			utxosByLease[u.LeaseID] = append(utxosByLease[u.LeaseID], u)
+		}
+		for _, id := range leaseIDs {
+			leases = append(leases, *newLease(utxosByLease[id]))
+		}
 	}
-	return lockExpiration, nil
+
+	sort.SliceStable(leases, func(i, j int) bool {
+		return leases[i].LockTimestamp < leases[j].LockTimestamp
+	})
+	return leases, nil
 }
 
 func (ts *TransactionService) EstimateFees(
@@ -187,13 +433,37 @@ func (ts *TransactionService) EstimateFees(
 	}
 
 	inputs := append(walletInputs, externalInputs...)
-	return wallet.EstimateFees(
+	return ts.estimateFees(
 		inputs, outs.toWalletOutputs(), millisatsPerByte,
 	), nil
 }
 
+// EstimateFeeRate returns the fee rate (in mSats/byte) required for a tx to
+// confirm within the given number of blocks, as estimated by the blockchain
+// scanner. The confirmation target defaults to DefaultConfTarget, and the
+// returned fee rate is never lower than MinMillisatsPerByte.
+func (ts *TransactionService) EstimateFeeRate(
+	ctx context.Context, confTarget uint32,
+) (uint64, error) {
+	if confTarget == 0 {
+		confTarget = DefaultConfTarget
+	}
+
+	feeRate, err := ts.bcScanner.EstimateFeeRate(confTarget)
+	if err != nil {
+		return 0, err
+	}
+	if feeRate < MinMillisatsPerByte {
+		feeRate = MinMillisatsPerByte
+	}
+	return feeRate, nil
+}
+
+// SignTransaction signs the inputs of the given raw transaction owned by the
+// wallet. Unless ignoreFeeGuard is true, the operation is refused if the
+// effective fee rate of the tx exceeds the ceiling of the fee guard.
 func (ts *TransactionService) SignTransaction(
-	ctx context.Context, txHex string, sighashType uint32,
+	ctx context.Context, txHex string, sighashType uint32, ignoreFeeGuard bool,
 ) (string, error) {
 	w, err := ts.getWallet(ctx)
 	if err != nil {
@@ -205,11 +475,34 @@ func (ts *TransactionService) SignTransaction(
 		return "", err
 	}
 
-	return w.SignTransaction(singlesig.SignTransactionArgs{
+	tx, _ := transaction.NewTxFromHex(txHex)
+	summary, err := ts.summarizeTransaction(ctx, w, tx, inputs)
+	if err != nil {
+		return "", err
+	}
+	if !ignoreFeeGuard {
+		if err := ts.checkFeeGuard(summary); err != nil {
+			return "", err
+		}
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return "", err
+	}
+
+	signedTx, err := w.SignTransaction(singlesig.SignTransactionArgs{
 		TxHex:        txHex,
 		InputsToSign: inputs,
 		SigHashType:  txscript.SigHashType(sighashType),
 	})
+	if err != nil {
+		return "", err
+	}
+	commitSpending()
+
+	return signedTx, nil
 }
 
 func (ts *TransactionService) BroadcastTransaction(
@@ -236,8 +529,11 @@ func (ts *TransactionService) BroadcastTransaction(
 	return ts.bcScanner.BroadcastTransaction(txHex)
 }
 
+// CreatePset creates a partial transaction spending the given locked utxos.
+// The sequences of the inputs, if any, and the locktime, if not zero, are set
+// in the pset and preserved when blinding and signing it.
 func (ts *TransactionService) CreatePset(
-	ctx context.Context, inputs Inputs, outputs Outputs,
+	ctx context.Context, inputs Inputs, outputs Outputs, lockTime uint32,
 ) (string, error) {
 	if _, err := ts.getWallet(ctx); err != nil {
 		return "", err
@@ -253,13 +549,18 @@ func (ts *TransactionService) CreatePset(
 	}
 
 	return wallet.CreatePset(wallet.CreatePsetArgs{
-		Inputs:  walletInputs,
-		Outputs: outputs.toWalletOutputs(),
+		Inputs:   walletInputs,
+		Outputs:  outputs.toWalletOutputs(),
+		LockTime: lockTime,
 	})
 }
 
+// UpdatePset adds the given locked utxos and outputs to a partial transaction.
+// The locktime, if not zero, is set unless the pset already has a different
+// one.
 func (ts *TransactionService) UpdatePset(
 	ctx context.Context, ptx string, inputs Inputs, outputs Outputs,
+	lockTime uint32,
 ) (string, error) {
 	if _, err := ts.getWallet(ctx); err != nil {
 		return "", err
@@ -278,6 +579,7 @@ func (ts *TransactionService) UpdatePset(
 		PsetBase64: ptx,
 		Inputs:     walletInputs,
 		Outputs:    outputs.toWalletOutputs(),
+		LockTime:   lockTime,
 	})
 }
 
@@ -330,8 +632,11 @@ func (ts *TransactionService) BlindPset(
 	)
 }
 
+// SignPset signs the inputs of the given partial transaction owned by the
+// wallet. Unless ignoreFeeGuard is true, the operation is refused if the
+// effective fee rate of the tx exceeds the ceiling of the fee guard.
 func (ts *TransactionService) SignPset(
-	ctx context.Context, ptx string, sighashType uint32,
+	ctx context.Context, ptx string, sighashType uint32, ignoreFeeGuard bool,
 ) (string, error) {
 	w, err := ts.getWallet(ctx)
 	if err != nil {
@@ -348,26 +653,100 @@ func (ts *TransactionService) SignPset(
 		derivationPaths[script] = in.DerivationPath
 	}
 
-	return w.SignPset(singlesig.SignPsetArgs{
+	pset, _ := psetv2.NewPsetFromBase64(ptx)
+	summary, err := ts.summarizePset(ctx, w, pset)
+	if err != nil {
+		return "", err
+	}
+	if summary.txid, err = unsignedTxid(pset); err != nil {
+		return "", err
+	}
+	if !ignoreFeeGuard {
+		if err := ts.checkFeeGuard(summary); err != nil {
+			return "", err
+		}
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return "", err
+	}
+
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
 		PsetBase64:        ptx,
 		DerivationPathMap: derivationPaths,
 		SigHashType:       txscript.SigHashType(sighashType),
 	})
+	if err != nil {
+		return "", err
+	}
+	commitSpending()
+
+	return signedPtx, nil
 }
 
-func (ts *TransactionService) Transfer(
-	ctx context.Context, accountName string, outputs Outputs,
-	millisatsPerByte uint64,
+// CombinePsets merges the signatures and blinding data of the given copies of
+// the same partial transaction, updated by different parties.
+func (ts *TransactionService) CombinePsets(
+	ctx context.Context, psets []string,
 ) (string, error) {
-	// Ensure lbtc outs are not dust.
-	for _, out := range outputs {
-		if out.Asset == ts.network.AssetID {
-			if out.Amount < ts.dustAmount {
-				return "", fmt.Errorf("lbtc output amount must not be dust")
-			}
-		}
+	if _, err := ts.getWallet(ctx); err != nil {
+		return "", err
+	}
+
+	return wallet.CombinePsets(wallet.CombinePsetsArgs{PsetsBase64: psets})
+}
+
+// FinalizePset finalizes all the inputs of the given partial transaction and
+// returns the extracted raw transaction in hex format along with its txid.
+func (ts *TransactionService) FinalizePset(
+	ctx context.Context, ptx string,
+) (string, string, error) {
+	if _, err := ts.getWallet(ctx); err != nil {
+		return "", "", err
+	}
+
+	return wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionArgs{PsetBase64: ptx},
+	)
+}
+
+// DecodePset returns the inputs and outputs of the given partial transaction
+// along with what it does to the balances of the accounts owning them.
+// Inputs and outputs are attributed to the accounts by script and, if
+// confidential, unblinded with their blinding keys. Those not owned by the
+// wallet are revealed only if explicit or proven with blind proofs.
+func (ts *TransactionService) DecodePset(
+	ctx context.Context, psetBase64 string,
+) (*DecodedPset, error) {
+	ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid partial transaction: %s", err)
+	}
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	return ts.decodePset(ctx, w, ptx)
+}
+
+// Transfer returns a signed transaction that sends funds of the given account
+// to the given outputs.
+// If some inputs are given, all of them are spent and, only if allowed and
+// needed, other utxos are selected to cover the target amounts and fees.
+// Otherwise, the utxos to spend are all selected by the service.
+// If SubtractFee is true, the fees are deducted from the lbtc outputs rather
+// than being paid with other funds. If SendAll is true, the amount of every
+// output is replaced by the whole spendable balance of its asset and the
+// fees are deducted from the lbtc one, or paid with lbtc utxos if no lbtc is
+// sent.
+// If a lease id is given, the utxos it reserved are spent as well.
+func (ts *TransactionService) Transfer(
+	ctx context.Context, accountName string, outputs Outputs,
+	millisatsPerByte uint64, minConfirmations *uint32, opts TransferOptions,
+) (string, error) {
 	w, err := ts.getWallet(ctx)
 	if err != nil {
 		return "", err
@@ -377,102 +756,612 @@ func (ts *TransactionService) Transfer(
 		return "", err
 	}
 
-	utxoRepo := ts.repoManager.UtxoRepository()
-	walletRepo := ts.repoManager.WalletRepository()
-
-	balance, err := utxoRepo.GetBalanceForAccount(ctx, account.Namespace)
+	plan, err := ts.planTransfer(
+		ctx, account, outputs, millisatsPerByte, minConfirmations, opts, false,
+	)
 	if err != nil {
 		return "", err
 	}
-	if len(balance) <= 0 {
-		return "", fmt.Errorf("account %s has 0 balance", accountName)
-	}
-	for asset, amount := range outputs.totalAmountByAsset() {
-		if (balance[asset].Confirmed + balance[asset].Unconfirmed) < amount {
-			return "", fmt.Errorf(
-				"not enough funds to cover amount %d of asset %s", amount, asset,
-			)
+
+	// The utxos reserved by the given lease, if any, are already locked.
+	keys := make([]domain.UtxoKey, 0, len(plan.utxos))
+	for _, u := range plan.utxos {
+		if opts.LeaseID != "" && u.LeaseID == opts.LeaseID {
+			continue
 		}
+		keys = append(keys, u.Key())
 	}
 
-	utxos, err := utxoRepo.GetSpendableUtxosForAccount(
-		ctx, account.Namespace,
+	txHex, err := ts.craftTransaction(
+		ctx, w, account, plan.inputs, plan.outs, plan.lockTime, keys,
+		opts.OverrideFeeGuard,
 	)
 	if err != nil {
 		return "", err
 	}
-	if len(utxos) == 0 {
-		return "", fmt.Errorf("no utxos found for account %s", accountName)
+
+	ts.storeTransfer(ctx, account.Namespace, txHex, plan)
+	return txHex, nil
+}
+
+// BumpFee replaces the given unconfirmed tx, crafted by Transfer, with one
+// paying the given fee rate, or the estimated one if not specified.
+// The replacement spends the same inputs and sends the same amounts to the
+// same receivers. The higher fees are paid by shrinking the lbtc change, and
+// more inputs are added only if this is not enough.
+// The replacement is signed and broadcasted, and its txid is returned.
+func (ts *TransactionService) BumpFee(
+	ctx context.Context, txid string, millisatsPerByte uint64,
+) (string, error) {
+	txInfo, err := ts.GetTransactionInfo(ctx, txid)
+	if err != nil {
+		return "", err
+	}
+	if (*domain.Transaction)(txInfo).IsConfirmed() {
+		return "", ErrTxAlreadyConfirmed
+	}
+	tx, err := transaction.NewTxFromHex(txInfo.TxHex)
+	if err != nil {
+		return "", err
+	}
+	if !signalsReplaceability(tx) {
+		return "", ErrTxNotReplaceable
+	}
+	transfer, err := ts.repoManager.TransferRepository().GetTransfer(ctx, txid)
+	if err != nil {
+		return "", ErrTxNotReplaceable
 	}
 
-	changeByAsset := make(map[string]uint64)
-	selectedUtxos := make([]*domain.Utxo, 0)
-	lbtc := ts.network.AssetID
-	dust := uint64(0)
-	for targetAsset, targetAmount := range outputs.totalAmountByAsset() {
-		utxos, change, err := DefaultCoinSelector.SelectUtxos(utxos, targetAmount, targetAsset)
-		if err != nil {
-			return "", err
-		}
-		selectedUtxos = append(selectedUtxos, utxos...)
-		if change > 0 {
-			// If the lbtc change is dust, it is added as fee amount.
-			if targetAsset == lbtc && change < ts.dustAmount {
-				dust = change
-			} else {
-				changeByAsset[targetAsset] = change
-			}
-		}
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return "", err
+	}
+	account, err := ts.getAccount(ctx, transfer.AccountName)
+	if err != nil {
+		return "", err
 	}
 
-	inputs := make([]wallet.Input, 0, len(utxos))
-	inputsByIndex := make(map[uint32]wallet.Input)
-	for i, u := range selectedUtxos {
-		input := wallet.Input{
-			TxID:            u.TxID,
-			TxIndex:         u.VOut,
-			Value:           u.Value,
-			Asset:           u.Asset,
-			Script:          u.Script,
-			ValueBlinder:    u.ValueBlinder,
-			AssetBlinder:    u.AssetBlinder,
-			ValueCommitment: u.ValueCommitment,
-			AssetCommitment: u.AssetCommitment,
-			Nonce:           u.Nonce,
+	keys, _ := utxoKeysFromRawTx(txInfo.TxHex)
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return "", err
+	}
+	if len(utxos) != len(keys) {
+		return "", fmt.Errorf("%w: not all inputs are owned", ErrTxNotReplaceable)
+	}
+	for _, u := range utxos {
+		if u.AccountName != account.Namespace {
+			return "", fmt.Errorf(
+				"%w: input %s not owned by account %s",
+				ErrTxNotReplaceable, u.Key(), account.Namespace,
+			)
 		}
-		inputs = append(inputs, input)
-		inputsByIndex[uint32(i)] = input
 	}
 
-	changeOutputs := make([]wallet.Output, 0)
-	if len(changeByAsset) > 0 {
-		addressesInfo, err := walletRepo.DeriveNextInternalAddressesForAccount(
-			ctx, account.Namespace, uint64(len(changeByAsset)),
+	feeAmount := getTxFeeAmount(tx)
+	millisatsPerByte = ts.getFeeRate(ctx, millisatsPerByte)
+	feeRate := feeAmount * 1000 / uint64(tx.VirtualSize())
+	if millisatsPerByte <= feeRate {
+		return "", fmt.Errorf(
+			"%w: must be higher than %d", ErrFeeRateTooLow, feeRate,
 		)
-		if err != nil {
-			return "", err
-		}
+	}
 
-		i := 0
-		for asset, amount := range changeByAsset {
-			script, _ := hex.DecodeString(addressesInfo[i].Script)
-			var blindingKey []byte
-			if !account.Unconf {
-				addr, _ := address.FromConfidential(addressesInfo[i].Address)
-				blindingKey = addr.BlindingKey
+	outputs := make(Outputs, 0, len(transfer.Receivers))
+	for _, r := range transfer.Receivers {
+		outputs = append(outputs, Output{
+			Asset:       r.Asset,
+			Amount:      r.Amount,
+			Script:      r.Script,
+			BlindingKey: r.BlindingKey,
+		})
+	}
+	opts := TransferOptions{
+		AllowExtraInputs: true,
+		LockTime:         tx.Locktime,
+		replaced:         &replacedTx{txid, utxos},
+	}
+	plan, err := ts.planTransfer(
+		ctx, account, outputs, millisatsPerByte, nil, opts, false,
+	)
+	if err != nil {
+		return "", err
+	}
+	// The replacement must also pay for its own relay (BIP-125 rule 4).
+	outs := make([]wallet.Output, 0, len(plan.outs))
+	for _, out := range plan.outs {
+		if len(out.Script) > 0 {
+			outs = append(outs, out)
+		}
+	}
+	txSize := ts.estimateTxSize(plan.inputs, outs)
+	minFeeAmount := feeAmount + txSize*MinMillisatsPerByte/1000
+	if plan.feeAmount < minFeeAmount {
+		return "", fmt.Errorf(
+			"%w: fee amount must be at least %d", ErrFeeRateTooLow, minFeeAmount,
+		)
+	}
+
+	// Only the extra inputs require to be locked, the others are already spent.
+	extraUtxos := getRemainingUtxos(plan.utxos, utxos)
+	txHex, err := ts.craftTransaction(
+		ctx, w, account, plan.inputs, plan.outs, plan.lockTime,
+		Utxos(extraUtxos).Keys(), false,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	newTxid, err := ts.bcScanner.BroadcastTransaction(txHex)
+	if err != nil {
+		return "", err
+	}
+	ts.log("replaced tx %s with %s", txid, newTxid)
+
+	// The replaced tx is dropped once the replacement is notified by the
+	// blockchain scanner, here it's just made not replaceable anymore.
+	ts.storeTransfer(ctx, account.Namespace, txHex, plan)
+	if _, err := ts.repoManager.TransferRepository().DeleteTransfer(
+		ctx, txid,
+	); err != nil {
+		ts.warn(err, "failed to delete transfer of replaced tx %s", txid)
+	}
+
+	return newTxid, nil
+}
+
+// Accelerate crafts and broadcasts a transaction spending the unconfirmed
+// outputs of the given tx owned by the wallet (child-pays-for-parent), so
+// that the package made of parent and child pays the given fee rate.
+// The outputs are sent to fresh internal addresses of the account owning them,
+// and other lbtc utxos of the same account are spent if they're not enough to
+// cover the fees of the package.
+func (ts *TransactionService) Accelerate(
+	ctx context.Context, txid string, millisatsPerByte uint64,
+) (string, error) {
+	txInfo, err := ts.GetTransactionInfo(ctx, txid)
+	if err != nil {
+		return "", err
+	}
+	if (*domain.Transaction)(txInfo).IsConfirmed() {
+		return "", ErrTxAlreadyConfirmed
+	}
+	tx, err := transaction.NewTxFromHex(txInfo.TxHex)
+	if err != nil {
+		return "", err
+	}
+
+	parentUtxos, err := ts.getOwnedOutputs(ctx, txid)
+	if err != nil {
+		return "", err
+	}
+	if len(parentUtxos) <= 0 {
+		return "", ErrTxWithoutOwnedOutputs
+	}
+
+	parentFeeAmount := getTxFeeAmount(tx)
+	parentSize := uint64(tx.VirtualSize())
+	millisatsPerByte = ts.getFeeRate(ctx, millisatsPerByte)
+	feeRate := parentFeeAmount * 1000 / parentSize
+	if millisatsPerByte <= feeRate {
+		return "", fmt.Errorf(
+			"%w: must be higher than %d", ErrFeeRateTooLow, feeRate,
+		)
+	}
+
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return "", err
+	}
+	account, err := ts.getAccount(ctx, parentUtxos[0].AccountName)
+	if err != nil {
+		return "", err
+	}
+
+	lbtc := ts.network.AssetID
+	amountByAsset := map[string]uint64{lbtc: 0}
+	for _, u := range parentUtxos {
+		amountByAsset[u.Asset] += u.Value
+	}
+	outs, err := ts.newChangeOutputs(ctx, account, amountByAsset, false)
+	if err != nil {
+		return "", err
+	}
+	lbtcIndex := 0
+	for i, out := range outs {
+		if out.Asset == lbtc {
+			lbtcIndex = i
+			break
+		}
+	}
+
+	// The child pays for its own size plus the missing fees of the parent.
+	packageFees := func(inputs []wallet.Input) uint64 {
+		feeAmount := ts.estimateFees(inputs, outs, millisatsPerByte)
+		parentTargetFee := parentSize * millisatsPerByte / 1000
+		if parentTargetFee > parentFeeAmount {
+			feeAmount += parentTargetFee - parentFeeAmount
+		}
+		return feeAmount
+	}
+
+	selectedUtxos := parentUtxos
+	inputs := Utxos(parentUtxos).toWalletInputs()
+	feeAmount := packageFees(inputs)
+	if outs[lbtcIndex].Amount < feeAmount+ts.dustAmount {
+		// Extra lbtc utxos are selected by assuming to add one input to the tx.
+		feeAmount = packageFees(append(inputs, inputs[0]))
+		utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, nil)
+		if err != nil {
+			return "", err
+		}
+		remainingUtxos := getRemainingUtxos(utxos, parentUtxos)
+		feeUtxos, _, err := DefaultCoinSelector.SelectUtxos(
+			remainingUtxos, feeAmount+ts.dustAmount-outs[lbtcIndex].Amount, lbtc,
+		)
+		if err != nil {
+			return "", err
+		}
+		selectedUtxos = append(selectedUtxos, feeUtxos...)
+		inputs = append(inputs, Utxos(feeUtxos).toWalletInputs()...)
+		for _, u := range feeUtxos {
+			outs[lbtcIndex].Amount += u.Value
+		}
+		feeAmount = packageFees(inputs)
+	}
+	if outs[lbtcIndex].Amount < feeAmount {
+		return "", fmt.Errorf("not enough lbtc funds to cover fee amount")
+	}
+	outs[lbtcIndex].Amount -= feeAmount
+	// If the lbtc output is dust, it is added as fee amount, unless it's the
+	// only one of the tx.
+	if outs[lbtcIndex].Amount < ts.dustAmount && len(outs) > 1 {
+		feeAmount += outs[lbtcIndex].Amount
+		outs = append(outs[:lbtcIndex], outs[lbtcIndex+1:]...)
+	}
+	// The child alone pays a fee rate higher than the requested one by design,
+	// therefore the fee guard is checked against the rate of the package.
+	if err := ts.checkFeeGuard(&spendingSummary{
+		feeAmount: parentFeeAmount + feeAmount,
+		txSize:    parentSize + ts.estimateTxSize(inputs, outs),
+	}); err != nil {
+		return "", err
+	}
+	outs = append(outs, wallet.Output{
+		Asset:  lbtc,
+		Amount: feeAmount,
+	})
+
+	txHex, err := ts.craftTransaction(
+		ctx, w, account, inputs, outs, 0, Utxos(selectedUtxos).Keys(), true,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	childTxid, err := ts.bcScanner.BroadcastTransaction(txHex)
+	if err != nil {
+		return "", err
+	}
+	ts.log("accelerated tx %s with child %s", txid, childTxid)
+
+	return childTxid, nil
+}
+
+// MoveFunds sends the given amount of asset from an account to another one of
+// the same wallet, to the next receiving address of the target account. The
+// output is confidential unless the target is an unconfidential account.
+// The tx is broadcasted and recorded for both accounts, marked as internal so
+// that it shows as an internal transfer in their history. Its txid is
+// returned.
+func (ts *TransactionService) MoveFunds(
+	ctx context.Context, fromAccount, toAccount, asset string, amount uint64,
+	millisatsPerByte uint64,
+) (string, error) {
+	from, err := ts.getAccount(ctx, fromAccount)
+	if err != nil {
+		return "", err
+	}
+	to, err := ts.getAccount(ctx, toAccount)
+	if err != nil {
+		return "", err
+	}
+	if from.Namespace == to.Namespace {
+		return "", ErrSameAccount
+	}
+
+	// The receiving address of the target account is only peeked here and
+	// derived once the transfer is made, so that a failing one doesn't consume
+	// any address of the account.
+	walletRepo := ts.repoManager.WalletRepository()
+	w, err := walletRepo.GetWallet(ctx)
+	if err != nil {
+		return "", err
+	}
+	addressesInfo, err := w.PeekNextExternalAddressesForAccount(to.Namespace, 1)
+	if err != nil {
+		return "", err
+	}
+	script, _ := hex.DecodeString(addressesInfo[0].Script)
+	var blindingKey []byte
+	if !to.Unconf {
+		addr, _ := address.FromConfidential(addressesInfo[0].Address)
+		blindingKey = addr.BlindingKey
+	}
+	output := Output{
+		Asset:       asset,
+		Amount:      amount,
+		Script:      script,
+		BlindingKey: blindingKey,
+	}
+
+	txHex, err := ts.Transfer(
+		ctx, from.Namespace, Outputs{output}, millisatsPerByte, nil,
+		TransferOptions{},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := walletRepo.DeriveNextExternalAddressesForAccount(
+		ctx, to.Namespace, 1,
+	); err != nil {
+		return "", err
+	}
+
+	txid, err := ts.BroadcastTransaction(ctx, txHex)
+	if err != nil {
+		return "", err
+	}
+	ts.log(
+		"moved %d of asset %s from account %s to %s with tx %s",
+		amount, asset, from.Namespace, to.Namespace, txid,
+	)
+
+	tx := &domain.Transaction{TxID: txid, TxHex: txHex, Internal: true}
+	tx.AddAccount(from.Namespace)
+	tx.AddAccount(to.Namespace)
+	txRepo := ts.repoManager.TransactionRepository()
+	if gotTx, _ := txRepo.GetTransaction(ctx, txid); gotTx == nil {
+		_, err = txRepo.AddTransaction(ctx, tx)
+	} else {
+		err = txRepo.UpdateTransaction(
+			ctx, txid, func(t *domain.Transaction) (*domain.Transaction, error) {
+				t.Internal = true
+				t.AddAccount(from.Namespace)
+				t.AddAccount(to.Namespace)
+				return t, nil
+			},
+		)
+	}
+	if err != nil {
+		ts.warn(err, "failed to record internal tx %s", txid)
+	}
+
+	return txid, nil
+}
+
+// PreviewTransfer is the dry-run version of Transfer. It returns the unsigned
+// and unblinded pset, along with the selected utxos, the change outputs and
+// the fee amount, without locking any utxo nor deriving any change address.
+// If reserve is true, the selected utxos are instead locked so that they can
+// be spent by a later transfer referencing the returned lease before it
+// expires.
+func (ts *TransactionService) PreviewTransfer(
+	ctx context.Context, accountName string, outputs Outputs,
+	millisatsPerByte uint64, minConfirmations *uint32, opts TransferOptions,
+	reserve bool,
+) (*TransferPreview, error) {
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := ts.planTransfer(
+		ctx, account, outputs, millisatsPerByte, minConfirmations, opts, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:   plan.inputs,
+		Outputs:  plan.outs,
+		LockTime: plan.lockTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := plan.toPreview()
+	preview.Pset = ptx
+	if reserve {
+		lease, err := ts.lockUtxos(
+			ctx, account.Namespace, Utxos(plan.utxos).Keys(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		preview.Reservation = lease
+	}
+	return preview, nil
+}
+
+// planTransfer selects the utxos to spend and computes the change and fee
+// outputs of a transfer. In dry-run mode, the change addresses are not
+// derived, meaning that they're not persisted for the account.
+func (ts *TransactionService) planTransfer(
+	ctx context.Context, account *domain.Account, outputs Outputs,
+	millisatsPerByte uint64, minConfirmations *uint32, opts TransferOptions,
+	dryRun bool,
+) (*transferPlan, error) {
+	millisatsPerByte = ts.getFeeRate(ctx, millisatsPerByte)
+
+	// Ensure lbtc outs are not dust.
+	for _, out := range outputs {
+		if opts.SendAll {
+			break
+		}
+		if out.Asset == ts.network.AssetID {
+			if out.Amount < ts.dustAmount {
+				return nil, fmt.Errorf("lbtc output amount must not be dust")
+			}
+		}
+	}
+
+	utxoRepo := ts.repoManager.UtxoRepository()
+
+	chosenUtxos, err := ts.getChosenUtxos(ctx, account.Namespace, opts.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	reservedUtxos, err := ts.getReservedUtxos(ctx, account.Namespace, opts.LeaseID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.replaced != nil {
+		reservedUtxos = append(reservedUtxos, opts.replaced.utxos...)
+	}
+	chosenUtxos = append(chosenUtxos, reservedUtxos...)
+
+	balance, err := utxoRepo.GetBalanceForAccount(ctx, account.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(balance) <= 0 {
+		return nil, fmt.Errorf("account %s has 0 balance", account.Namespace)
+	}
+	reservedAmountByAsset := make(map[string]uint64)
+	for _, u := range reservedUtxos {
+		reservedAmountByAsset[u.Asset] += u.Value
+	}
+	for asset, amount := range outputs.totalAmountByAsset() {
+		available := reservedAmountByAsset[asset]
+		if b, ok := balance[asset]; ok {
+			available += b.Confirmed + b.Unconfirmed
+		}
+		if available < amount {
+			return nil, fmt.Errorf(
+				"not enough funds to cover amount %d of asset %s", amount, asset,
+			)
+		}
+	}
+
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, minConfirmations)
+	if err != nil {
+		return nil, err
+	}
+	if opts.replaced != nil {
+		spendableUtxos := make([]*domain.Utxo, 0, len(utxos))
+		for _, u := range utxos {
+			if u.TxID != opts.replaced.txid {
+				spendableUtxos = append(spendableUtxos, u)
 			}
-			changeOutputs = append(changeOutputs, wallet.Output{
-				Asset:       asset,
-				Amount:      amount,
-				Script:      script,
-				BlindingKey: blindingKey,
-			})
-			i++
 		}
+		utxos = spendableUtxos
+	}
+	if len(utxos) == 0 && len(reservedUtxos) == 0 {
+		return nil, fmt.Errorf("no utxos found for account %s", account.Namespace)
+	}
+
+	if opts.SendAll || opts.SubtractFee {
+		plan, err := ts.planTransferSubtractingFee(
+			ctx, account, outputs, utxos, chosenUtxos, opts.AllowExtraInputs,
+			opts.SendAll, millisatsPerByte, dryRun,
+		)
+		if err != nil {
+			return nil, err
+		}
+		plan.setTimelocks(opts.Inputs, opts.LockTime)
+		return plan, nil
 	}
 
+	allowExtraInputs := opts.AllowExtraInputs
+	changeByAsset := make(map[string]uint64)
+	selectedUtxos := make([]*domain.Utxo, 0)
+	lbtc := ts.network.AssetID
+	dust := uint64(0)
 	outs := outputs.toWalletOutputs()
-	feeAmount := wallet.EstimateFees(
+	coinSelector := coinSelectorByType[CoinSelectionStrategyBranchAndBound](
+		lbtc, millisatsPerByte, ts.discountCT,
+	)
+	targetAmountByAsset := outputs.totalAmountByAsset()
+	// Fees are paid in lbtc, therefore some must be selected even if not sent.
+	if _, ok := targetAmountByAsset[lbtc]; !ok {
+		targetAmountByAsset[lbtc] = 0
+	}
+	// All chosen utxos are spent, even those of assets not sent.
+	for _, u := range chosenUtxos {
+		if _, ok := targetAmountByAsset[u.Asset]; !ok {
+			targetAmountByAsset[u.Asset] = 0
+		}
+	}
+	for targetAsset, targetAmount := range targetAmountByAsset {
+		// The lbtc target amount includes the fees for the outputs so that the
+		// coin selector can look for an input set that doesn't require change.
+		feeAmount := uint64(0)
+		if targetAsset == lbtc {
+			feeAmount = ts.estimateFees(nil, outs, millisatsPerByte)
+		}
+		selected, change, err := selectTransferUtxos(
+			coinSelector, utxos, chosenUtxos, allowExtraInputs,
+			targetAmount+feeAmount, targetAsset,
+		)
+		// If the fees can't be covered, the whole balance is likely being sent,
+		// therefore they will be subtracted from the outputs later.
+		if err != nil && feeAmount > 0 && targetAmount > 0 {
+			feeAmount = 0
+			selected, change, err = selectTransferUtxos(
+				coinSelector, utxos, chosenUtxos, allowExtraInputs,
+				targetAmount, targetAsset,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+		change += feeAmount
+		selectedUtxos = append(selectedUtxos, selected...)
+		if change > 0 {
+			// If the lbtc change is dust, it is added as fee amount.
+			if targetAsset == lbtc && change < ts.dustAmount {
+				dust = change
+			} else {
+				changeByAsset[targetAsset] = change
+			}
+		}
+	}
+
+	inputs := Utxos(selectedUtxos).toWalletInputs()
+
+	changeOutputs, err := ts.newChangeOutputs(ctx, account, changeByAsset, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the lbtc change covers the fees of the tx only without the change
+	// output, the latter is dropped and the whole change goes to fees.
+	for i, out := range changeOutputs {
+		if out.Asset != lbtc {
+			continue
+		}
+		otherChangeOutputs := append(
+			append([]wallet.Output{}, changeOutputs[:i]...), changeOutputs[i+1:]...,
+		)
+		feeAmount := ts.estimateFees(
+			inputs, append(outs, otherChangeOutputs...), millisatsPerByte,
+		)
+		feeAmountWithChange := ts.estimateFees(
+			inputs, append(outs, changeOutputs...), millisatsPerByte,
+		)
+		if out.Amount >= feeAmount && out.Amount < feeAmountWithChange {
+			dust = out.Amount
+			delete(changeByAsset, lbtc)
+			changeOutputs = otherChangeOutputs
+		}
+		break
+	}
+
+	feeAmount := ts.estimateFees(
 		inputs, append(outs, changeOutputs...), millisatsPerByte,
 	)
 	if dust >= feeAmount {
@@ -537,37 +1426,30 @@ func (ts *TransactionService) Transfer(
 					}
 				}
 			} else {
+				if len(chosenUtxos) > 0 && !allowExtraInputs {
+					return nil, fmt.Errorf(
+						"%w: chosen inputs don't cover fee amount %d",
+						ErrExtraInputsNotAllowed, feeAmount,
+					)
+				}
+
 				targetAsset := lbtc
-				targetAmount := changeByAsset[lbtc] - feeAmount
+				targetAmount := feeAmount - changeByAsset[lbtc]
 
 				// Coin-selection must be done over remaining utxos.
 				remainingUtxos := getRemainingUtxos(utxos, selectedUtxos)
-				selectedUtxos, _, err := DefaultCoinSelector.SelectUtxos(
+				moreUtxos, _, err := DefaultCoinSelector.SelectUtxos(
 					remainingUtxos, targetAmount, targetAsset,
 				)
 				if err != nil {
-					return "", err
+					return nil, err
 				}
 
-				for _, u := range selectedUtxos {
-					input := wallet.Input{
-						TxID:            u.TxID,
-						TxIndex:         u.VOut,
-						Value:           u.Value,
-						Asset:           u.Asset,
-						Script:          u.Script,
-						ValueBlinder:    u.ValueBlinder,
-						AssetBlinder:    u.AssetBlinder,
-						ValueCommitment: u.ValueCommitment,
-						AssetCommitment: u.AssetCommitment,
-						Nonce:           u.Nonce,
-					}
-					inputs = append(inputs, input)
-					inputsByIndex[uint32(len(inputs))] = input
-				}
+				selectedUtxos = append(selectedUtxos, moreUtxos...)
+				inputs = append(inputs, Utxos(moreUtxos).toWalletInputs()...)
 
 				// Now that we have all inputs and outputs, estimate the real fee amount.
-				feeAmount := wallet.EstimateFees(
+				feeAmount = ts.estimateFees(
 					inputs, append(outs, changeOutputs...), millisatsPerByte,
 				)
 
@@ -613,220 +1495,1692 @@ func (ts *TransactionService) Transfer(
 		Amount: feeAmount,
 	})
 
-	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
-		Inputs:  inputs,
-		Outputs: outs,
-	})
-	if err != nil {
-		return "", err
+	plan := &transferPlan{
+		utxos:         selectedUtxos,
+		inputs:        inputs,
+		outs:          outs,
+		changeOutputs: changeOutputs,
+		feeAmount:     feeAmount,
 	}
+	plan.setTimelocks(opts.Inputs, opts.LockTime)
+	return plan, nil
+}
 
-	blindedPtx, err := wallet.BlindPsetWithOwnedInputs(
-		wallet.BlindPsetWithOwnedInputsArgs{
-			PsetBase64:         ptx,
-			OwnedInputsByIndex: inputsByIndex,
-			LastBlinder:        true,
-		},
-	)
+// planTransferSubtractingFee is the variant of planTransfer where the fees are
+// deducted from the lbtc outputs, equally split among them. If sendAll is
+// true, every output amount is replaced by the whole balance of its asset
+// and, if there's no lbtc output, the fees are paid with lbtc utxos instead.
+// The eventual lbtc dust change goes to the first lbtc output so that no dust
+// utxo is left behind.
+func (ts *TransactionService) planTransferSubtractingFee(
+	ctx context.Context, account *domain.Account,
+	outputs Outputs, utxos, chosenUtxos []*domain.Utxo,
+	allowExtraInputs, sendAll bool, millisatsPerByte uint64, dryRun bool,
+) (*transferPlan, error) {
+	lbtc := ts.network.AssetID
+	feeOutIndexes := make([]int, 0)
+	for i, out := range outputs {
+		if out.Asset == lbtc {
+			feeOutIndexes = append(feeOutIndexes, i)
+		}
+	}
+	if len(feeOutIndexes) <= 0 && (!sendAll || len(outputs) <= 0) {
+		return nil, ErrMissingFeeOutput
+	}
+
+	outputs = append(Outputs{}, outputs...)
+	selectedUtxos := make([]*domain.Utxo, 0)
+	changeByAsset := make(map[string]uint64)
+	spendableUtxos := utxos
+	if sendAll {
+		if len(chosenUtxos) > 0 {
+			spendableUtxos = chosenUtxos
+			if allowExtraInputs {
+				spendableUtxos = append(
+					append([]*domain.Utxo{}, chosenUtxos...),
+					getRemainingUtxos(utxos, chosenUtxos)...,
+				)
+			}
+		}
+
+		sentAssets := make(map[string]struct{})
+		for i, out := range outputs {
+			if _, ok := sentAssets[out.Asset]; ok {
+				return nil, ErrSendAllMultipleOutputs
+			}
+			sentAssets[out.Asset] = struct{}{}
+			amount := uint64(0)
+			for _, u := range spendableUtxos {
+				if u.Asset == out.Asset {
+					selectedUtxos = append(selectedUtxos, u)
+					amount += u.Value
+				}
+			}
+			if amount == 0 {
+				return nil, fmt.Errorf(
+					"account %s has no spendable funds of asset %s",
+					account.Namespace, out.Asset,
+				)
+			}
+			outputs[i].Amount = amount
+		}
+	} else {
+		coinSelector := coinSelectorByType[CoinSelectionStrategyBranchAndBound](
+			lbtc, millisatsPerByte, ts.discountCT,
+		)
+		for asset, amount := range outputs.totalAmountByAsset() {
+			selected, change, err := selectTransferUtxos(
+				coinSelector, utxos, chosenUtxos, allowExtraInputs, amount, asset,
+			)
+			if err != nil {
+				return nil, err
+			}
+			selectedUtxos = append(selectedUtxos, selected...)
+			if change > 0 {
+				changeByAsset[asset] = change
+			}
+		}
+	}
+	// All chosen utxos are spent, even those of assets not sent.
+	for _, u := range getRemainingUtxos(chosenUtxos, selectedUtxos) {
+		selectedUtxos = append(selectedUtxos, u)
+		changeByAsset[u.Asset] += u.Value
+	}
+
+	// If only other assets are swept, the fees are paid with lbtc utxos.
+	if len(feeOutIndexes) <= 0 {
+		return ts.planSweepPayingFee(
+			ctx, account, outputs, selectedUtxos,
+			getRemainingUtxos(spendableUtxos, selectedUtxos), changeByAsset,
+			millisatsPerByte, dryRun,
+		)
+	}
+
+	if change := changeByAsset[lbtc]; change > 0 && change < ts.dustAmount {
+		outputs[feeOutIndexes[0]].Amount += change
+		delete(changeByAsset, lbtc)
+	}
+
+	changeOutputs, err := ts.newChangeOutputs(ctx, account, changeByAsset, dryRun)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	outs := append(outputs.toWalletOutputs(), changeOutputs...)
 
-	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
-		PsetBase64:        blindedPtx,
-		DerivationPathMap: account.DerivationPathByScript,
+	inputs := Utxos(selectedUtxos).toWalletInputs()
+	feeAmount := ts.estimateFees(inputs, outs, millisatsPerByte)
+	numFeeOuts := uint64(len(feeOutIndexes))
+	for k, i := range feeOutIndexes {
+		amount := feeAmount / numFeeOuts
+		if k == 0 {
+			amount += feeAmount % numFeeOuts
+		}
+		if outs[i].Amount < amount+ts.dustAmount {
+			return nil, fmt.Errorf(
+				"lbtc output amount %d is too low to pay fee amount %d",
+				outs[i].Amount, amount,
+			)
+		}
+		outs[i].Amount -= amount
+	}
+	outs = append(outs, wallet.Output{
+		Asset:  lbtc,
+		Amount: feeAmount,
 	})
+
+	return &transferPlan{
+		utxos:         selectedUtxos,
+		inputs:        inputs,
+		outs:          outs,
+		changeOutputs: changeOutputs,
+		feeAmount:     feeAmount,
+	}, nil
+}
+
+// planSweepPayingFee completes the plan of a send-all transfer that doesn't
+// send any lbtc. The fees are paid with the lbtc of the selected utxos and,
+// if not enough, with other lbtc utxos selected among the given extra ones.
+// The remaining lbtc goes to a change output, unless it's dust.
+func (ts *TransactionService) planSweepPayingFee(
+	ctx context.Context, account *domain.Account, outputs Outputs,
+	selectedUtxos, extraUtxos []*domain.Utxo, changeByAsset map[string]uint64,
+	millisatsPerByte uint64, dryRun bool,
+) (*transferPlan, error) {
+	lbtc := ts.network.AssetID
+	lbtcAmount := changeByAsset[lbtc]
+
+	// The fees are estimated by assuming an lbtc change output whose address
+	// is just peeked since it might be dropped.
+	changeByAsset[lbtc] = lbtcAmount
+	changeOutputs, err := ts.newChangeOutputs(ctx, account, changeByAsset, true)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	outs := append(outputs.toWalletOutputs(), changeOutputs...)
+	inputs := Utxos(selectedUtxos).toWalletInputs()
+	feeAmount := ts.estimateFees(inputs, outs, millisatsPerByte)
 
-	txHex, _, err := wallet.FinalizeAndExtractTransaction(wallet.FinalizeAndExtractTransactionArgs{
-		PsetBase64: signedPtx,
+	if lbtcAmount < feeAmount+ts.dustAmount {
+		// Lbtc utxos are selected by assuming to add one input to the tx.
+		feeAmount = ts.estimateFees(
+			append(inputs, inputs[0]), outs, millisatsPerByte,
+		)
+		feeUtxos, _, err := DefaultCoinSelector.SelectUtxos(
+			extraUtxos, feeAmount+ts.dustAmount-lbtcAmount, lbtc,
+		)
+		if err != nil {
+			return nil, err
+		}
+		selectedUtxos = append(selectedUtxos, feeUtxos...)
+		inputs = append(inputs, Utxos(feeUtxos).toWalletInputs()...)
+		for _, u := range feeUtxos {
+			lbtcAmount += u.Value
+		}
+		feeAmount = ts.estimateFees(inputs, outs, millisatsPerByte)
+	}
+	if lbtcAmount < feeAmount {
+		return nil, fmt.Errorf("not enough lbtc funds to cover fee amount")
+	}
+
+	// If the change is dust, it is added as fee amount.
+	if change := lbtcAmount - feeAmount; change < ts.dustAmount {
+		feeAmount += change
+		delete(changeByAsset, lbtc)
+	} else {
+		changeByAsset[lbtc] = change
+	}
+
+	changeOutputs, err = ts.newChangeOutputs(ctx, account, changeByAsset, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	outs = append(outputs.toWalletOutputs(), changeOutputs...)
+	outs = append(outs, wallet.Output{
+		Asset:  lbtc,
+		Amount: feeAmount,
 	})
+
+	return &transferPlan{
+		utxos:         selectedUtxos,
+		inputs:        inputs,
+		outs:          outs,
+		changeOutputs: changeOutputs,
+		feeAmount:     feeAmount,
+	}, nil
+}
+
+// FragmentUtxos splits the balance of the given asset owned by an account
+// into many outputs sent to fresh addresses of the same account, within a
+// single transaction.
+// If a fragment amount is given, the balance is split into the given number
+// of outputs of such amount, or into as many as possible if the number is not
+// specified. Otherwise, the balance is split into the given number of
+// geometric buckets, each holding half the amount of the previous one.
+// The signed tx is returned in hex format.
+func (ts *TransactionService) FragmentUtxos(
+	ctx context.Context, accountName, asset string,
+	numFragments uint32, fragmentAmount, millisatsPerByte uint64,
+	minConfirmations *uint32,
+) (string, error) {
+	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
 		return "", err
 	}
 
-	keys := Utxos(selectedUtxos).Keys()
-	now := time.Now()
-	lockExpiration := now.Add(ts.utxoExpiryDuration)
-	count, err := ts.repoManager.UtxoRepository().LockUtxos(
-		ctx, keys, now.Unix(), lockExpiration.Unix(),
-	)
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, minConfirmations)
 	if err != nil {
 		return "", err
 	}
-	if count > 0 {
-		ts.log(
-			"locked %d utxo(s) for account %s (%s) ",
-			count, account.Namespace, UtxoKeys(keys),
-		)
+	balance := uint64(0)
+	for _, u := range utxos {
+		if u.Asset == asset {
+			balance += u.Value
+		}
 	}
 
-	return txHex, nil
+	amounts, err := fragmentBalance(balance, numFragments, fragmentAmount)
+	if err != nil {
+		return "", err
+	}
+
+	// The transfer is first planned with peeked addresses so that no address
+	// is derived for the account in case it fails.
+	outputs, err := ts.newFragmentOutputs(ctx, account, asset, amounts, true)
+	if err != nil {
+		return "", err
+	}
+	if _, err := ts.PreviewTransfer(
+		ctx, accountName, outputs, millisatsPerByte, minConfirmations,
+		TransferOptions{}, false,
+	); err != nil {
+		return "", err
+	}
+
+	outputs, err = ts.newFragmentOutputs(ctx, account, asset, amounts, false)
+	if err != nil {
+		return "", err
+	}
+
+	return ts.Transfer(
+		ctx, accountName, outputs, millisatsPerByte, minConfirmations,
+		TransferOptions{},
+	)
 }
 
-func (ts *TransactionService) SignPsetWithSchnorrKey(
-	ctx context.Context, tx string, sighashType uint32,
+// Consolidate sweeps the smallest spendable utxos of the given asset owned by
+// an account into one output sent to a fresh internal address of the same
+// account.
+// At most maxInputs utxos are spent and, if maxUtxoValue is specified, only
+// those whose value doesn't exceed it are taken into account. Locked and
+// frozen utxos are never spent.
+// The operation is refused if the given fee rate exceeds the maxMillisatsPerByte
+// ceiling, if specified.
+// The signed tx is returned in hex format.
+func (ts *TransactionService) Consolidate(
+	ctx context.Context, accountName, asset string, maxInputs uint32,
+	maxUtxoValue, millisatsPerByte, maxMillisatsPerByte uint64,
 ) (string, error) {
-	wallet, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if maxInputs < 2 {
+		return "", ErrInvalidMaxInputs
+	}
+	millisatsPerByte = ts.getFeeRate(ctx, millisatsPerByte)
+	if maxMillisatsPerByte > 0 && millisatsPerByte > maxMillisatsPerByte {
+		return "", ErrFeeRateAboveCeiling
+	}
+
+	w, err := ts.getWallet(ctx)
 	if err != nil {
 		return "", err
 	}
-	mnemonic, err := wallet.GetMnemonic()
+	account, err := ts.getAccount(ctx, accountName)
 	if err != nil {
 		return "", err
 	}
-	ssWallet, err := singlesig.NewWalletFromMnemonic(singlesig.NewWalletFromMnemonicArgs{
-		RootPath: wallet.RootPath,
-		Mnemonic: mnemonic,
-	})
+
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, nil)
 	if err != nil {
 		return "", err
 	}
 
-	ptx, err := psetv2.NewPsetFromBase64(tx)
+	selectedUtxos := make([]*domain.Utxo, 0)
+	for _, u := range utxos {
+		if u.Asset != asset {
+			continue
+		}
+		if maxUtxoValue > 0 && u.Value > maxUtxoValue {
+			continue
+		}
+		selectedUtxos = append(selectedUtxos, u)
+	}
+	if len(selectedUtxos) < 2 {
+		return "", ErrNothingToConsolidate
+	}
+	sort.SliceStable(selectedUtxos, func(i, j int) bool {
+		return selectedUtxos[i].Value < selectedUtxos[j].Value
+	})
+	if len(selectedUtxos) > int(maxInputs) {
+		selectedUtxos = selectedUtxos[:maxInputs]
+	}
+
+	amount := uint64(0)
+	for _, u := range selectedUtxos {
+		amount += u.Value
+	}
+
+	// The addresses are only peeked until all checks pass so that none is
+	// derived for the account if the consolidation is refused.
+	addressesInfo, err := ts.nextInternalAddresses(ctx, account, 2, true)
 	if err != nil {
 		return "", err
 	}
-	if len(ptx.Global.Xpubs) < 1 {
-		return "", fmt.Errorf("missing pset global xpubs")
-	}
+	consolidatedOut := newOutput(account, addressesInfo[0], asset, amount)
 
-	// For each global xpub, retrieve account info if it belongs to the wallet.
-	// Account info are required to know its derivation index, used later.
-	xpubsInfo := make([]struct {
-		account *domain.Account
-		xpub    *bip32.Key
-	}, 0, len(ptx.Global.Xpubs))
-	for _, xpub := range ptx.Global.Xpubs {
-		for _, account := range wallet.Accounts {
-			hdNode, err := bip32.B58Deserialize(account.Xpub)
-			if err != nil {
-				return "", err
-			}
-			accountXpub, err := hdNode.Serialize()
-			if err != nil {
-				return "", err
-			}
+	inputs := Utxos(selectedUtxos).toWalletInputs()
+	outs := []wallet.Output{consolidatedOut}
+	var feeAmount uint64
+	if asset == ts.network.AssetID {
+		feeAmount = ts.estimateFees(
+			inputs, []wallet.Output{consolidatedOut}, millisatsPerByte,
+		)
+		if amount < feeAmount+ts.dustAmount {
+			return "", ErrNothingToConsolidate
+		}
+		outs[0].Amount -= feeAmount
+	} else {
+		// Fees must be paid with lbtc utxos, that are selected by assuming to add
+		// one input and a change output to the tx.
+		changeOut := newOutput(account, addressesInfo[1], ts.network.AssetID, 0)
+		feeAmount = ts.estimateFees(
+			append(inputs, inputs[0]),
+			[]wallet.Output{consolidatedOut, changeOut}, millisatsPerByte,
+		)
+		remainingUtxos := getRemainingUtxos(utxos, selectedUtxos)
+		feeUtxos, _, err := DefaultCoinSelector.SelectUtxos(
+			remainingUtxos, feeAmount+ts.dustAmount, ts.network.AssetID,
+		)
+		if err != nil {
+			return "", err
+		}
+		selectedUtxos = append(selectedUtxos, feeUtxos...)
+		inputs = append(inputs, Utxos(feeUtxos).toWalletInputs()...)
 
-			if bytes.Equal(xpub.ExtendedKey, accountXpub[:len(accountXpub)-4]) {
-				xpubsInfo = append(xpubsInfo, struct {
-					account *domain.Account
-					xpub    *bip32.Key
-				}{account, hdNode})
-				break
-			}
+		feeAmount = ts.estimateFees(
+			inputs, []wallet.Output{consolidatedOut, changeOut}, millisatsPerByte,
+		)
+		for _, u := range feeUtxos {
+			changeOut.Amount += u.Value
+		}
+		if changeOut.Amount < feeAmount {
+			return "", fmt.Errorf("not enough lbtc funds to cover fee amount")
+		}
+		changeOut.Amount -= feeAmount
+		// If the change is dust, it is added as fee amount.
+		if changeOut.Amount < ts.dustAmount {
+			feeAmount += changeOut.Amount
+		} else {
+			outs = append(outs, changeOut)
 		}
 	}
 
-	// For each input that has a taproot bip32 derivation field,
-	// construct the derivation path by attaching the bip32 derivation to the
-	// account's index. This derivation path format is needed by the signing wallet.
-	derivationPathMap := make(map[string]string)
-	for _, in := range ptx.Inputs {
-		for _, derivation := range in.TapBip32Derivation {
-			for _, info := range xpubsInfo {
-				if derivation.MasterKeyFingerprint == binary.LittleEndian.Uint32(info.xpub.FingerPrint) {
-					derivationPath := []string{fmt.Sprintf("%d'", info.account.Index)}
-					for _, step := range derivation.Bip32Path {
-						derivationPath = append(derivationPath, fmt.Sprintf("%d", step))
-					}
-					derivationPathMap[hex.EncodeToString(in.GetUtxo().Script)] = strings.Join(derivationPath, "/")
-					break
-				}
-			}
-		}
+	addressesInfo, err = ts.nextInternalAddresses(
+		ctx, account, uint64(len(outs)), false,
+	)
+	if err != nil {
+		return "", err
+	}
+	for i, out := range outs {
+		outs[i] = newOutput(account, addressesInfo[i], out.Asset, out.Amount)
 	}
 
-	return ssWallet.SignTaproot(singlesig.SignTaprootArgs{
-		PsetBase64:        tx,
-		DerivationPathMap: derivationPathMap,
-		GenesisBlockHash:  ts.network.GenesisBlockHash,
-		SighashType:       txscript.SigHashType(sighashType),
+	outs = append(outs, wallet.Output{
+		Asset:  ts.network.AssetID,
+		Amount: feeAmount,
 	})
-}
-
-func (ts *TransactionService) registerHandlerForWalletEvents() {
-	ts.repoManager.RegisterHandlerForWalletEvent(
-		domain.WalletUnlocked, func(_ domain.WalletEvent) {
-			ts.scheduleUtxoUnlocker()
-		},
-	)
-}
 
-func (ts *TransactionService) registerHandlerForUtxoEvents() {
-	ts.repoManager.RegisterHandlerForUtxoEvent(
-		domain.UtxoLocked, func(event domain.UtxoEvent) {
-			keys := UtxosInfo(event.Utxos).Keys()
-			ts.spawnUtxoUnlocker(keys)
-		},
+	return ts.craftTransaction(
+		ctx, w, account, inputs, outs, 0, Utxos(selectedUtxos).Keys(), false,
 	)
 }
 
-// scheduleUtxoUnlocker waits 5 seconds before whether unlocking or spawning an
-// unlocker for all the locked utxos of each account.
-// Since this method is called when the service is istantiated, the idea is to
-// to give the account service enough time to receive notification from the
-// blockchain scanner and spend the locked utxos.
-func (ts *TransactionService) scheduleUtxoUnlocker() {
-	time.Sleep(5 * time.Second)
-
-	ctx := context.Background()
-	utxoRepo := ts.repoManager.UtxoRepository()
-	w, _ := ts.repoManager.WalletRepository().GetWallet(ctx)
+// ScheduleConsolidation spawns a goroutine that periodically consolidates the
+// utxos of an account as described by the given config, and broadcasts the
+// resulting transaction.
+// A round is skipped if the wallet is locked, or if there's nothing to
+// consolidate, or if the fee rate exceeds the configured ceiling.
+func (ts *TransactionService) ScheduleConsolidation(cfg ConsolidationConfig) {
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
 
-	for accountName := range w.Accounts {
-		utxos, _ := utxoRepo.GetLockedUtxosForAccount(
-			ctx, accountName,
-		)
-		if len(utxos) > 0 {
-			utxosToUnlock := make([]domain.UtxoKey, 0, len(utxos))
-			utxosToSpawnUnlocker := make([]domain.UtxoKey, 0, len(utxos))
-			for _, u := range utxos {
-				if u.CanUnlock() {
-					utxosToUnlock = append(utxosToUnlock, u.Key())
-				} else {
-					utxosToSpawnUnlocker = append(utxosToSpawnUnlocker, u.Key())
-				}
+		for range ticker.C {
+			ctx := context.Background()
+			w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+			if err != nil || w.IsLocked() {
+				continue
 			}
 
-			if len(utxosToUnlock) > 0 {
-				count, err := utxoRepo.UnlockUtxos(ctx, utxosToUnlock)
-				if err != nil {
-					utxosToSpawnUnlocker = append(utxosToSpawnUnlocker, utxosToUnlock...)
-				}
-				if count > 0 {
-					ts.log(
-						"unlocked %d utxo(s) for account %s (%s)",
-						count, accountName, UtxoKeys(utxosToUnlock),
-					)
+			txHex, err := ts.Consolidate(
+				ctx, cfg.AccountName, cfg.Asset, cfg.MaxInputs, cfg.MaxUtxoValue,
+				cfg.MillisatsPerByte, cfg.MaxMillisatsPerByte,
+			)
+			if err != nil {
+				if err == ErrNothingToConsolidate || err == ErrFeeRateAboveCeiling {
+					ts.log("skipped consolidation round: %s", err)
+					continue
 				}
+				ts.warn(err, "error while consolidating utxos")
+				continue
 			}
-			if len(utxosToSpawnUnlocker) > 0 {
-				ts.spawnUtxoUnlocker(utxosToSpawnUnlocker)
+
+			txid, err := ts.BroadcastTransaction(ctx, txHex)
+			if err != nil {
+				ts.warn(err, "error while broadcasting consolidation tx")
+				continue
 			}
+			ts.log(
+				"consolidated utxos of account %s in tx %s", cfg.AccountName, txid,
+			)
 		}
-	}
+	}()
 }
 
-// spawnUtxoUnlocker groups the locked utxos identified by the given keys by their
-// locking timestamps, and then creates a goroutine for each group in order to unlock
-// the utxos if they are still locked when their expiration time comes.
-func (ts *TransactionService) spawnUtxoUnlocker(utxoKeys []domain.UtxoKey) {
-	ctx := context.Background()
-	utxos, _ := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, utxoKeys)
+// SetFeeGuard enables the sanity check on the fee of every transaction signed
+// by the service: those paying an effective fee rate higher than the given
+// mSats/byte ceiling are refused unless explicitly overridden, where allowed.
+// A zero ceiling disables the check.
+func (ts *TransactionService) SetFeeGuard(maxMillisatsPerByte uint64) {
+	ts.maxFeeRate = maxMillisatsPerByte
+}
 
-	utxosByLockTimestamp := make(map[int64][]domain.UtxoKey)
-	for _, u := range utxos {
-		utxosByLockTimestamp[u.LockTimestamp] = append(
-			utxosByLockTimestamp[u.LockTimestamp], u.Key(),
-		)
-	}
+// ScheduleBatching enables the queue of payments and spawns a goroutine that
+// periodically sends the queued payments of every account with a single
+// batched transaction, as described by the given config.
+func (ts *TransactionService) ScheduleBatching(cfg BatchingConfig) {
+	ts.batching = &cfg
 
-	for timestamp := range utxosByLockTimestamp {
-		keys := utxosByLockTimestamp[timestamp]
-		unlockTime := ts.utxoExpiryDuration - time.Since(time.Unix(timestamp, 0))
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			ts.flushPayments(context.Background(), "")
+		}
+	}()
+}
+
+// EnqueuePayment adds a payment of the given account to the queue and returns
+// its id. The payment is sent at the next flush of the queue.
+func (ts *TransactionService) EnqueuePayment(
+	ctx context.Context, accountName string, output Output,
+) (string, error) {
+	if ts.batching == nil {
+		return "", ErrPaymentBatchingDisabled
+	}
+	if err := output.Validate(); err != nil {
+		return "", err
+	}
+	if output.Amount <= 0 {
+		return "", fmt.Errorf("missing payment amount")
+	}
+	if output.Asset == ts.network.AssetID && output.Amount < ts.dustAmount {
+		return "", fmt.Errorf("lbtc output amount must not be dust")
+	}
+
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := newPaymentID()
+	if err != nil {
+		return "", err
+	}
+
+	paymentRepo := ts.repoManager.PaymentRepository()
+	if _, err := paymentRepo.AddPayment(ctx, &domain.Payment{
+		ID:          id,
+		AccountName: account.Namespace,
+		Asset:       output.Asset,
+		Amount:      output.Amount,
+		Script:      output.Script,
+		BlindingKey: output.BlindingKey,
+		Timestamp:   time.Now().Unix(),
+	}); err != nil {
+		return "", err
+	}
+	ts.log("queued payment %s for account %s", id, account.Namespace)
+
+	if ts.batching.MaxPayments > 0 {
+		payments, err := ts.getQueuedPayments(ctx, account.Namespace)
+		if err != nil {
+			ts.warn(err, "failed to get queued payments")
+			return id, nil
+		}
+		count := 0
+		for _, p := range payments {
+			if !p.IsSending() && !p.IsFailed() {
+				count++
+			}
+		}
+		if count >= int(ts.batching.MaxPayments) {
+			go ts.flushPayments(context.Background(), account.Namespace)
+		}
+	}
+
+	return id, nil
+}
+
+// CancelPayment removes the payment with the given id from the queue, unless
+// it's bound to a batched tx that might have been already broadcasted.
+func (ts *TransactionService) CancelPayment(
+	ctx context.Context, id string,
+) error {
+	// Prevent removing a payment that's being sent.
+	ts.paymentLock.Lock()
+	defer ts.paymentLock.Unlock()
+
+	payments, err := ts.getQueuedPayments(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, p := range payments {
+		if p.ID == id && p.IsSending() {
+			return ErrPaymentBeingSent
+		}
+	}
+
+	done, err := ts.repoManager.PaymentRepository().DeletePayment(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !done {
+		return ErrPaymentNotFound
+	}
+	ts.log("canceled payment %s", id)
+	return nil
+}
+
+// ListQueuedPayments returns the queued payments, from the oldest to the
+// newest, optionally filtered by account. These include those being sent and
+// the failed ones, that must be canceled to be removed from the queue.
+func (ts *TransactionService) ListQueuedPayments(
+	ctx context.Context, accountName string,
+) ([]*domain.Payment, error) {
+	if accountName == "" {
+		return ts.getQueuedPayments(ctx, "")
+	}
+
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	return ts.getQueuedPayments(ctx, account.Namespace)
+}
+
+// CreateSwapOffer returns a partial transaction where the given account spends
+// an amount of an asset in exchange for an amount of another one, received at
+// a fresh internal address. Inputs and outputs are not blinded so that the
+// counterparty can verify the terms of the offer.
+// The spent utxos are locked for the lifetime of the offer by a lease whose
+// id identifies the swap, and are returned unblinded along with the offer.
+func (ts *TransactionService) CreateSwapOffer(
+	ctx context.Context, accountName, giveAsset string, giveAmount uint64,
+	wantAsset string, wantAmount uint64,
+) (*SwapOffer, error) {
+	if giveAsset == wantAsset || giveAmount == 0 || wantAmount == 0 {
+		return nil, ErrInvalidSwapTerms
+	}
+	if _, err := ts.getWallet(ctx); err != nil {
+		return nil, err
+	}
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	selectedUtxos, change, err := DefaultCoinSelector.SelectUtxos(
+		utxos, giveAmount, giveAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	receiveOutput, err := ts.newInternalOutput(
+		ctx, account, wantAsset, wantAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	outs := []wallet.Output{receiveOutput}
+	if change > 0 {
+		changeOutput, err := ts.newInternalOutput(ctx, account, giveAsset, change)
+		if err != nil {
+			return nil, err
+		}
+		outs = append(outs, changeOutput)
+	}
+
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:  Utxos(selectedUtxos).toWalletInputs(),
+		Outputs: outs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	swap := domain.Swap{
+		AccountName: account.Namespace,
+		GiveAsset:   giveAsset,
+		GiveAmount:  giveAmount,
+		WantAsset:   wantAsset,
+		WantAmount:  wantAmount,
+	}
+	return ts.storeSwap(ctx, swap, ptx, selectedUtxos, 0)
+}
+
+// AcceptSwap adds to the partial transaction of a swap offer the inputs and
+// outputs of the given account, that is the utxos to pay the wanted amount
+// and the network fees, and the outputs to receive the offered amount and the
+// change. The network fees are paid by the account accepting the offer.
+// Like for the offer, the spent utxos are locked for the lifetime of the swap
+// and are returned unblinded.
+func (ts *TransactionService) AcceptSwap(
+	ctx context.Context, accountName, psetBase64 string,
+	millisatsPerByte uint64,
+) (*SwapOffer, error) {
+	ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid partial transaction: %s", err)
+	}
+	offer, err := parseSwapOffer(ptx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ts.getWallet(ctx); err != nil {
+		return nil, err
+	}
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	receiveOutput, err := ts.newInternalOutput(
+		ctx, account, offer.GiveAsset, offer.GiveAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fees are estimated for the whole tx, including the inputs and outputs
+	// of the offer, and the change outputs are assumed to be like the receiving
+	// one.
+	millisatsPerByte = ts.getFeeRate(ctx, millisatsPerByte)
+	offerInputs, offerOutputs := partialTxInsAndOuts(ptx)
+	selectedUtxos, changeByAsset, feeAmount, err := ts.selectUtxosPayingFees(
+		utxos, map[string]uint64{offer.WantAsset: offer.WantAmount},
+		offerInputs, append(offerOutputs, receiveOutput), receiveOutput,
+		millisatsPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	changeOutputs, err := ts.newChangeOutputs(ctx, account, changeByAsset, false)
+	if err != nil {
+		return nil, err
+	}
+	feeOutput := wallet.Output{Asset: ts.network.AssetID, Amount: feeAmount}
+	outs := append([]wallet.Output{receiveOutput}, changeOutputs...)
+	outs = append(outs, feeOutput)
+	updatedPtx, err := wallet.UpdatePset(wallet.UpdatePsetArgs{
+		PsetBase64: psetBase64,
+		Inputs:     Utxos(selectedUtxos).toWalletInputs(),
+		Outputs:    outs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	swap := domain.Swap{
+		AccountName: account.Namespace,
+		GiveAsset:   offer.WantAsset,
+		GiveAmount:  offer.WantAmount,
+		WantAsset:   offer.GiveAsset,
+		WantAmount:  offer.GiveAmount,
+		FeeAmount:   feeAmount,
+	}
+	return ts.storeSwap(
+		ctx, swap, updatedPtx, selectedUtxos, uint32(len(ptx.Inputs)),
+	)
+}
+
+// CompleteSwap makes sure that the given partial transaction spends the utxos
+// locked for the swap with the given id and matches its terms, then signs the
+// inputs of the account.
+// If not yet blinded, the tx is blinded as last blinder with the help of the
+// unblinded inputs revealed by the counterparty. Otherwise, the service makes
+// sure the outputs of the account can be unblinded with its keys.
+// The signed pset is returned and, if all inputs are signed, also the final tx
+// in hex format, ready to be broadcasted. Unless ignoreFeeGuard is true, the
+// operation is refused if the effective fee rate of the tx exceeds the
+// ceiling of the fee guard.
+func (ts *TransactionService) CompleteSwap(
+	ctx context.Context, swapID, psetBase64 string,
+	unblindedInputs []UnblindedInput, ignoreFeeGuard bool,
+) (string, string, error) {
+	swapRepo := ts.repoManager.SwapRepository()
+	swap, err := swapRepo.GetSwap(ctx, swapID)
+	if err != nil {
+		return "", "", err
+	}
+	ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid partial transaction: %s", err)
+	}
+
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	account, err := ts.getAccount(ctx, swap.AccountName)
+	if err != nil {
+		return "", "", err
+	}
+
+	lockedUtxos, err := ts.repoManager.UtxoRepository().GetUtxosForLease(
+		ctx, swap.ID,
+	)
+	if err != nil {
+		return "", "", err
+	}
+	if len(lockedUtxos) <= 0 {
+		if _, err := swapRepo.DeleteSwap(ctx, swap.ID); err != nil {
+			ts.warn(err, "failed to delete expired swap %s", swap.ID)
+		}
+		return "", "", ErrSwapExpired
+	}
+
+	// The inputs of other accounts of the wallet, if any, are not considered.
+	ownedInputs, err := ts.findLockedInputs(ctx, psetBase64)
+	if err != nil {
+		return "", "", err
+	}
+	lockedKeys := make(map[domain.UtxoKey]struct{})
+	for _, u := range lockedUtxos {
+		lockedKeys[u.Key()] = struct{}{}
+	}
+	amountByAsset := make(map[string]int64)
+	for i, in := range ownedInputs {
+		script := hex.EncodeToString(in.Script)
+		if _, ok := account.DerivationPathByScript[script]; !ok {
+			delete(ownedInputs, i)
+			continue
+		}
+		key := domain.UtxoKey{TxID: in.TxID, VOut: in.TxIndex}
+		if _, ok := lockedKeys[key]; !ok {
+			return "", "", ErrSwapUtxosMismatch
+		}
+		amountByAsset[in.Asset] -= int64(in.Value)
+	}
+	if len(ownedInputs) != len(lockedUtxos) {
+		return "", "", ErrSwapUtxosMismatch
+	}
+	if len(ptx.Inputs) <= len(ownedInputs) {
+		return "", "", ErrSwapNotAccepted
+	}
+
+	for _, out := range ptx.Outputs {
+		script := hex.EncodeToString(out.Script)
+		if _, ok := account.DerivationPathByScript[script]; !ok {
+			continue
+		}
+
+		asset := elementsutil.TxIDFromBytes(out.Asset)
+		if out.IsFullyBlinded() {
+			blindingKey, _, err := w.DeriveBlindingKeyPair(
+				singlesig.DeriveBlindingKeyPairArgs{Script: out.Script},
+			)
+			if err != nil {
+				return "", "", err
+			}
+			revealed, err := confidential.UnblindOutputWithKey(
+				&transaction.TxOutput{
+					Asset:           out.AssetCommitment,
+					Value:           out.ValueCommitment,
+					Script:          out.Script,
+					Nonce:           out.EcdhPubkey,
+					RangeProof:      out.ValueRangeproof,
+					SurjectionProof: out.AssetSurjectionProof,
+				}, blindingKey.Serialize(),
+			)
+			if err != nil || revealed.Value != out.Value ||
+				elementsutil.TxIDFromBytes(revealed.Asset) != asset {
+				return "", "", fmt.Errorf(
+					"failed to unblind output with script %s", script,
+				)
+			}
+		}
+		amountByAsset[asset] += int64(out.Value)
+	}
+
+	expectedAmountByAsset := map[string]int64{
+		swap.WantAsset: int64(swap.WantAmount),
+		swap.GiveAsset: -int64(swap.GiveAmount),
+	}
+	expectedAmountByAsset[ts.network.AssetID] -= int64(swap.FeeAmount)
+	for asset, amount := range expectedAmountByAsset {
+		if amountByAsset[asset] < amount {
+			return "", "", ErrSwapAmountsMismatch
+		}
+	}
+	for asset, amount := range amountByAsset {
+		if amount < expectedAmountByAsset[asset] {
+			return "", "", ErrSwapAmountsMismatch
+		}
+	}
+
+	if ptx.NeedsBlinding() {
+		inputsByIndex, err := revealInputs(ptx, unblindedInputs)
+		if err != nil {
+			return "", "", err
+		}
+		for i, in := range ownedInputs {
+			inputsByIndex[i] = in
+		}
+		if len(inputsByIndex) != len(ptx.Inputs) {
+			return "", "", ErrMissingSwapUnblindedInputs
+		}
+		// Unconfidential inputs are blinded with zero blinders.
+		for i, in := range inputsByIndex {
+			if len(in.AssetBlinder) <= 0 {
+				in.AssetBlinder = make([]byte, 32)
+				in.ValueBlinder = make([]byte, 32)
+				inputsByIndex[i] = in
+			}
+		}
+		psetBase64, err = wallet.BlindPsetWithOwnedInputs(
+			wallet.BlindPsetWithOwnedInputsArgs{
+				PsetBase64:         psetBase64,
+				OwnedInputsByIndex: inputsByIndex,
+				LastBlinder:        true,
+			},
+		)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	blindedPtx, _ := psetv2.NewPsetFromBase64(psetBase64)
+	summary, err := ts.summarizePset(ctx, w, blindedPtx)
+	if err != nil {
+		return "", "", err
+	}
+	if !ignoreFeeGuard {
+		if err := ts.checkFeeGuard(summary); err != nil {
+			return "", "", err
+		}
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return "", "", err
+	}
+
+	derivationPaths := make(map[string]string)
+	for _, in := range ownedInputs {
+		script := hex.EncodeToString(in.Script)
+		derivationPaths[script] = in.DerivationPath
+	}
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        psetBase64,
+		DerivationPathMap: derivationPaths,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	commitSpending()
+
+	if _, err := swapRepo.DeleteSwap(ctx, swap.ID); err != nil {
+		ts.warn(err, "failed to delete completed swap %s", swap.ID)
+	}
+	ts.log("completed swap %s for account %s", swap.ID, swap.AccountName)
+
+	signed, _ := psetv2.NewPsetFromBase64(signedPtx)
+	for _, in := range signed.Inputs {
+		if len(in.PartialSigs) <= 0 && len(in.FinalScriptWitness) <= 0 {
+			return signedPtx, "", nil
+		}
+	}
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionArgs{PsetBase64: signedPtx},
+	)
+	if err != nil {
+		return "", "", err
+	}
+	return signedPtx, txHex, nil
+}
+
+// CreateFragment returns a partial transaction spending the given utxo of the
+// account in exchange for the given amount of another asset, sent to a fresh
+// internal address with an output at the same index of the input.
+// The input is signed with SIGHASH_SINGLE|ANYONECANPAY, therefore the output
+// can't be changed afterwards and is optionally blinded in advance: its
+// blinders are returned, along with the unblinded input, so that whoever
+// fills the fragment can blind the rest of the transaction.
+// The utxo is locked with a lease for the lifetime of the fragment.
+func (ts *TransactionService) CreateFragment(
+	ctx context.Context, accountName string, utxo Input,
+	wantAsset string, wantAmount uint64, blindOutput bool,
+) (*Fragment, error) {
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if blindOutput && account.Unconf {
+		return nil, ErrBlindUnconfFragment
+	}
+
+	utxos, err := ts.getChosenUtxos(ctx, account.Namespace, Inputs{utxo})
+	if err != nil {
+		return nil, err
+	}
+	if utxos[0].Asset == wantAsset || wantAmount == 0 {
+		return nil, ErrInvalidFragmentTerms
+	}
+
+	output, err := ts.newInternalOutput(ctx, account, wantAsset, wantAmount)
+	if err != nil {
+		return nil, err
+	}
+	if !blindOutput {
+		output.BlindingKey = nil
+	}
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:  Utxos(utxos).toWalletInputs(),
+		Outputs: []wallet.Output{output},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var assetBlinder, valueBlinder []byte
+	if blindOutput {
+		ptx, assetBlinder, valueBlinder, err = wallet.BlindOutput(
+			wallet.BlindOutputArgs{PsetBase64: ptx},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pset, _ := psetv2.NewPsetFromBase64(ptx)
+	summary, err := ts.summarizePset(ctx, w, pset)
+	if err != nil {
+		return nil, err
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return nil, err
+	}
+
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        ptx,
+		DerivationPathMap: account.DerivationPathByScript,
+		SigHashType:       fragmentSighashType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := ts.lockUtxos(ctx, account.Namespace, Utxos(utxos).Keys())
+	if err != nil {
+		return nil, err
+	}
+	commitSpending()
+	ts.log(
+		"created fragment for account %s: utxo %s for %d of asset %s",
+		account.Namespace, utxos[0].Key(), wantAmount, wantAsset,
+	)
+
+	fragment := &Fragment{
+		Pset:            signedPtx,
+		UnblindedInput:  newUnblindedInput(utxos[0], 0),
+		LeaseID:         lease.ID,
+		ExpiryTimestamp: lease.ExpiryTimestamp,
+	}
+	if blindOutput {
+		// Blinders are serialized as transaction ids.
+		fragment.OutputAssetBlinder = elementsutil.TxIDFromBytes(assetBlinder)
+		fragment.OutputAmountBlinder = elementsutil.TxIDFromBytes(valueBlinder)
+	}
+	return fragment, nil
+}
+
+// FillFragments merges the given fragments into a transaction funded by the
+// account, which pays the amounts wanted by the fragments and the network
+// fees, and receives the amounts they give to fresh internal addresses.
+// Every fragment is verified before being merged: its input must be signed
+// with SIGHASH_SINGLE|ANYONECANPAY and match the revealed one, and its output,
+// if blinded, must match the revealed blinders.
+// The tx is blinded as last blinder and returned in hex format, ready to be
+// broadcasted. Unless ignoreFeeGuard is true, the operation is refused if the
+// effective fee rate of the tx exceeds the ceiling of the fee guard.
+func (ts *TransactionService) FillFragments(
+	ctx context.Context, accountName string, fragments []Fragment,
+	millisatsPerByte uint64, ignoreFeeGuard bool,
+) (string, error) {
+	if len(fragments) <= 0 {
+		return "", ErrMissingFragments
+	}
+	w, err := ts.getWallet(ctx)
+	if err != nil {
+		return "", err
+	}
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return "", err
+	}
+
+	ptx, inputsByIndex, outAssetBlinders, err := mergeFragments(fragments)
+	if err != nil {
+		return "", err
+	}
+
+	needsBlinding := len(outAssetBlinders) > 0
+	giveAmountByAsset := make(map[string]uint64)
+	wantAmountByAsset := make(map[string]uint64)
+	for i, in := range inputsByIndex {
+		if len(in.AssetCommitment) > 0 {
+			needsBlinding = true
+		}
+		giveAmountByAsset[in.Asset] += in.Value
+		out := ptx.Outputs[i]
+		wantAmountByAsset[elementsutil.TxIDFromBytes(out.Asset)] += out.Value
+	}
+	if needsBlinding && account.Unconf {
+		return "", ErrFragmentsNeedBlinding
+	}
+
+	utxos, err := ts.getSpendableUtxos(ctx, account.Namespace, nil)
+	if err != nil {
+		return "", err
+	}
+	receiveOutputs, err := ts.newChangeOutputs(
+		ctx, account, giveAmountByAsset, false,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	// Like for accepting a swap, the fees are estimated for the whole tx.
+	millisatsPerByte = ts.getFeeRate(ctx, millisatsPerByte)
+	fragmentInputs, fragmentOutputs := partialTxInsAndOuts(ptx)
+	selectedUtxos, changeByAsset, feeAmount, err := ts.selectUtxosPayingFees(
+		utxos, wantAmountByAsset, fragmentInputs,
+		append(fragmentOutputs, receiveOutputs...), receiveOutputs[0],
+		millisatsPerByte,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	changeOutputs, err := ts.newChangeOutputs(ctx, account, changeByAsset, false)
+	if err != nil {
+		return "", err
+	}
+	feeOutput := wallet.Output{Asset: ts.network.AssetID, Amount: feeAmount}
+	outs := append(receiveOutputs, changeOutputs...)
+	outs = append(outs, feeOutput)
+	psetBase64, err := ptx.ToBase64()
+	if err != nil {
+		return "", err
+	}
+	inputs := Utxos(selectedUtxos).toWalletInputs()
+	psetBase64, err = wallet.UpdatePset(wallet.UpdatePsetArgs{
+		PsetBase64: psetBase64,
+		Inputs:     inputs,
+		Outputs:    outs,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i, in := range inputs {
+		inputsByIndex[uint32(len(fragments)+i)] = in
+	}
+	// Unconfidential inputs are blinded with zero blinders.
+	for i, in := range inputsByIndex {
+		if len(in.AssetBlinder) <= 0 {
+			in.AssetBlinder = make([]byte, 32)
+			in.ValueBlinder = make([]byte, 32)
+			inputsByIndex[i] = in
+		}
+	}
+	// The surjection proofs of the blinded outputs of the fragments are made
+	// again against all the inputs of the tx.
+	for i, assetBlinder := range outAssetBlinders {
+		psetBase64, err = wallet.ProveOutputAsset(wallet.ProveOutputAssetArgs{
+			PsetBase64:    psetBase64,
+			Index:         i,
+			AssetBlinder:  assetBlinder,
+			InputsByIndex: inputsByIndex,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	if !account.Unconf {
+		psetBase64, err = wallet.BlindPsetWithOwnedInputs(
+			wallet.BlindPsetWithOwnedInputsArgs{
+				PsetBase64:         psetBase64,
+				OwnedInputsByIndex: inputsByIndex,
+				LastBlinder:        true,
+			},
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	filledPtx, _ := psetv2.NewPsetFromBase64(psetBase64)
+	summary, err := ts.summarizePset(ctx, w, filledPtx)
+	if err != nil {
+		return "", err
+	}
+	if !ignoreFeeGuard {
+		if err := ts.checkFeeGuard(summary); err != nil {
+			return "", err
+		}
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return "", err
+	}
+
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        psetBase64,
+		DerivationPathMap: account.DerivationPathByScript,
+	})
+	if err != nil {
+		return "", err
+	}
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionArgs{PsetBase64: signedPtx},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := ts.lockUtxos(
+		ctx, account.Namespace, Utxos(selectedUtxos).Keys(),
+	); err != nil {
+		return "", err
+	}
+	commitSpending()
+	ts.log("filled %d fragment(s) with account %s", len(fragments), accountName)
+
+	return txHex, nil
+}
+
+// selectUtxosPayingFees selects among the given utxos those to pay the given
+// target amounts and the network fees of a tx made of the given inputs and
+// outputs, plus the selected utxos, their change outputs, assumed to be like
+// the given one, and the fee output. The utxos are selected again until they
+// cover the estimated fees, and the lbtc change, if dust, is added to them.
+// It returns the selected utxos, the change amount by asset and the fee
+// amount.
+func (ts *TransactionService) selectUtxosPayingFees(
+	utxos []*domain.Utxo, targetAmountByAsset map[string]uint64,
+	inputs []wallet.Input, outputs []wallet.Output, changeOutput wallet.Output,
+	millisatsPerByte uint64,
+) ([]*domain.Utxo, map[string]uint64, uint64, error) {
+	lbtc := ts.network.AssetID
+	feeOutput := wallet.Output{Asset: lbtc}
+	outs := append(append([]wallet.Output{}, outputs...), feeOutput)
+	feeAmount := ts.estimateFees(inputs, outs, millisatsPerByte)
+
+	var selectedUtxos []*domain.Utxo
+	var changeByAsset map[string]uint64
+	for {
+		amountByAsset := make(map[string]uint64)
+		for asset, amount := range targetAmountByAsset {
+			amountByAsset[asset] = amount
+		}
+		amountByAsset[lbtc] += feeAmount
+
+		selectedUtxos = make([]*domain.Utxo, 0)
+		changeByAsset = make(map[string]uint64)
+		for asset, amount := range amountByAsset {
+			selected, change, err := DefaultCoinSelector.SelectUtxos(
+				utxos, amount, asset,
+			)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			selectedUtxos = append(selectedUtxos, selected...)
+			if change > 0 {
+				changeByAsset[asset] = change
+			}
+		}
+
+		ins := append(
+			append([]wallet.Input{}, inputs...),
+			Utxos(selectedUtxos).toWalletInputs()...,
+		)
+		outs := append(append([]wallet.Output{}, outputs...), feeOutput)
+		for range changeByAsset {
+			outs = append(outs, changeOutput)
+		}
+		estimatedFeeAmount := ts.estimateFees(ins, outs, millisatsPerByte)
+		if estimatedFeeAmount <= feeAmount {
+			break
+		}
+		feeAmount = estimatedFeeAmount
+	}
+	// If the lbtc change is dust, it is added as fee amount.
+	if change, ok := changeByAsset[lbtc]; ok && change < ts.dustAmount {
+		feeAmount += change
+		delete(changeByAsset, lbtc)
+	}
+
+	return selectedUtxos, changeByAsset, feeAmount, nil
+}
+
+// SetSpendingPolicy sets the rules that every transaction spending the funds
+// of the given account must comply with before being signed, replacing the
+// existing ones, if any. The given password must be the one of the wallet.
+func (ts *TransactionService) SetSpendingPolicy(
+	ctx context.Context, policy domain.SpendingPolicy, password string,
+) error {
+	if err := validateSpendingPolicy(policy); err != nil {
+		return err
+	}
+
+	account, err := ts.getAuthorizedAccount(ctx, policy.AccountName, password)
+	if err != nil {
+		return err
+	}
+	policy.AccountName = account.Namespace
+
+	if err := ts.repoManager.SpendingPolicyRepository().SetPolicy(
+		ctx, &policy,
+	); err != nil {
+		return err
+	}
+	ts.log("set spending policy for account %s", account.Namespace)
+	return nil
+}
+
+// GetSpendingPolicy returns the spending policy of the given account.
+func (ts *TransactionService) GetSpendingPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.repoManager.SpendingPolicyRepository().GetPolicy(
+		ctx, account.Namespace,
+	)
+}
+
+// DeleteSpendingPolicy removes the spending policy of the given account, whose
+// transactions are then signed without restrictions. The given password must
+// be the one of the wallet.
+func (ts *TransactionService) DeleteSpendingPolicy(
+	ctx context.Context, accountName, password string,
+) error {
+	account, err := ts.getAuthorizedAccount(ctx, accountName, password)
+	if err != nil {
+		return err
+	}
+
+	done, err := ts.repoManager.SpendingPolicyRepository().DeletePolicy(
+		ctx, account.Namespace,
+	)
+	if err != nil {
+		return err
+	}
+	if !done {
+		return domain.ErrSpendingPolicyNotFound
+	}
+	ts.log("deleted spending policy of account %s", account.Namespace)
+	return nil
+}
+
+// ApproveSpending adds the given approver to the pending spending with the
+// given id. The approver must be one of the public keys registered in the
+// spending policy of the account, and the signature must be its DER encoded
+// ECDSA signature of the spending digest. Once approved by the number of
+// approvers required by the policy, the transaction can be signed by
+// repeating the same request that was refused.
+func (ts *TransactionService) ApproveSpending(
+	ctx context.Context, id, approver, signature string,
+) (*domain.Spending, error) {
+	if approver == "" {
+		return nil, ErrMissingApprover
+	}
+	if signature == "" {
+		return nil, ErrMissingApprovalSignature
+	}
+
+	policyRepo := ts.repoManager.SpendingPolicyRepository()
+	spending, err := policyRepo.GetSpending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := policyRepo.GetPolicy(ctx, spending.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.IsApprover(approver) {
+		return nil, ErrUnknownApprover
+	}
+	if err := verifyApproval(spending.Digest, approver, signature); err != nil {
+		return nil, err
+	}
+
+	if err := policyRepo.UpdateSpending(
+		ctx, id, func(s *domain.Spending) (*domain.Spending, error) {
+			if s.CreatedAt < spendingWindowStart() {
+				return nil, ErrSpendingExpired
+			}
+			if err := s.Approve(approver); err != nil {
+				return nil, err
+			}
+			spending = s
+			return s, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	ts.log("spending %s approved by %s", id, approver)
+	return spending, nil
+}
+
+// ListSpendings returns the spendings of the given account of the last day,
+// those pending created and those signed within the day, from the oldest to
+// the newest.
+func (ts *TransactionService) ListSpendings(
+	ctx context.Context, accountName string,
+) ([]*domain.Spending, error) {
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.getWindowSpendings(ctx, account.Namespace)
+}
+
+// ListPolicyViolations returns the audit log of the attempts to sign
+// transactions of the given account that violated its spending policy, from
+// the oldest to the newest.
+func (ts *TransactionService) ListPolicyViolations(
+	ctx context.Context, accountName string,
+) ([]*domain.PolicyViolation, error) {
+	account, err := ts.getAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.repoManager.SpendingPolicyRepository().GetViolationsForAccount(
+		ctx, account.Namespace,
+	)
+}
+
+// SignPsetWithSchnorrKey signs the taproot inputs of the given partial
+// transaction owned by the wallet. Unless ignoreFeeGuard is true, the
+// operation is refused if the effective fee rate of the tx exceeds the ceiling
+// of the fee guard.
+func (ts *TransactionService) SignPsetWithSchnorrKey(
+	ctx context.Context, tx string, sighashType uint32, ignoreFeeGuard bool,
+) (string, error) {
+	wallet, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return "", err
+	}
+	mnemonic, err := wallet.GetMnemonic()
+	if err != nil {
+		return "", err
+	}
+	ssWallet, err := singlesig.NewWalletFromMnemonic(singlesig.NewWalletFromMnemonicArgs{
+		RootPath: wallet.RootPath,
+		Mnemonic: mnemonic,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ptx, err := psetv2.NewPsetFromBase64(tx)
+	if err != nil {
+		return "", err
+	}
+	if len(ptx.Global.Xpubs) < 1 {
+		return "", fmt.Errorf("missing pset global xpubs")
+	}
+
+	// For each global xpub, retrieve account info if it belongs to the wallet.
+	// Account info are required to know its derivation index, used later.
+	xpubsInfo := make([]struct {
+		account *domain.Account
+		xpub    *bip32.Key
+	}, 0, len(ptx.Global.Xpubs))
+	for _, xpub := range ptx.Global.Xpubs {
+		for _, account := range wallet.Accounts {
+			hdNode, err := bip32.B58Deserialize(account.Xpub)
+			if err != nil {
+				return "", err
+			}
+			accountXpub, err := hdNode.Serialize()
+			if err != nil {
+				return "", err
+			}
+
+			if bytes.Equal(xpub.ExtendedKey, accountXpub[:len(accountXpub)-4]) {
+				xpubsInfo = append(xpubsInfo, struct {
+					account *domain.Account
+					xpub    *bip32.Key
+				}{account, hdNode})
+				break
+			}
+		}
+	}
+
+	// For each input that has a taproot bip32 derivation field,
+	// construct the derivation path by attaching the bip32 derivation to the
+	// account's index. This derivation path format is needed by the signing wallet.
+	derivationPathMap := make(map[string]string)
+	for _, in := range ptx.Inputs {
+		for _, derivation := range in.TapBip32Derivation {
+			for _, info := range xpubsInfo {
+				if derivation.MasterKeyFingerprint == binary.LittleEndian.Uint32(info.xpub.FingerPrint) {
+					derivationPath := []string{fmt.Sprintf("%d'", info.account.Index)}
+					for _, step := range derivation.Bip32Path {
+						derivationPath = append(derivationPath, fmt.Sprintf("%d", step))
+					}
+					derivationPathMap[hex.EncodeToString(in.GetUtxo().Script)] = strings.Join(derivationPath, "/")
+					break
+				}
+			}
+		}
+	}
+
+	summary, err := ts.summarizePset(ctx, ssWallet, ptx)
+	if err != nil {
+		return "", err
+	}
+	if summary.txid, err = unsignedTxid(ptx); err != nil {
+		return "", err
+	}
+	if !ignoreFeeGuard {
+		if err := ts.checkFeeGuard(summary); err != nil {
+			return "", err
+		}
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return "", err
+	}
+
+	signedPtx, err := ssWallet.SignTaproot(singlesig.SignTaprootArgs{
+		PsetBase64:        tx,
+		DerivationPathMap: derivationPathMap,
+		GenesisBlockHash:  ts.network.GenesisBlockHash,
+		SighashType:       txscript.SigHashType(sighashType),
+	})
+	if err != nil {
+		return "", err
+	}
+	commitSpending()
+
+	return signedPtx, nil
+}
+
+// getSpendableUtxos returns the spendable utxos of the given account with at
+// least minConfirmations. If nil, the value the service is configured with is
+// used. Since unconfirmed utxos are never spendable, 0 and 1 are equivalent.
+func (ts *TransactionService) getSpendableUtxos(
+	ctx context.Context, accountName string, minConfirmations *uint32,
+) ([]*domain.Utxo, error) {
+	utxos, err := ts.repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+		ctx, accountName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	minConfs := ts.minConfirmations
+	if minConfirmations != nil {
+		minConfs = *minConfirmations
+	}
+	if minConfs <= 1 {
+		return utxos, nil
+	}
+
+	_, tipHeight, err := ts.bcScanner.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	return Utxos(utxos).WithMinConfirmations(uint64(tipHeight), minConfs), nil
+}
+
+// lockUtxos locks the given utxos with a brand new lease and returns it.
+// Utxos already locked by another lease are skipped. ErrUtxoAlreadyLocked is
+// returned if none of them can be locked.
+func (ts *TransactionService) lockUtxos(
+	ctx context.Context, accountName string, keys []domain.UtxoKey,
+) (*Lease, error) {
+	leaseID, err := newLeaseID()
+	if err != nil {
+		return nil, err
+	}
+
+	utxoRepo := ts.repoManager.UtxoRepository()
+	now := time.Now()
+	lockExpiration := now.Add(ts.utxoExpiryDuration).Unix()
+	count, err := utxoRepo.LockUtxos(
+		ctx, keys, leaseID, now.Unix(), lockExpiration,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		return nil, domain.ErrUtxoAlreadyLocked
+	}
+
+	ts.log(
+		"locked %d utxo(s) for account %s with lease %s (%s)",
+		count, accountName, leaseID, UtxoKeys(keys),
+	)
+
+	utxos, err := utxoRepo.GetUtxosForLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	return newLease(utxos), nil
+}
+
+func (ts *TransactionService) registerHandlerForWalletEvents() {
+	ts.repoManager.RegisterHandlerForWalletEvent(
+		domain.WalletUnlocked, func(_ domain.WalletEvent) {
+			ts.scheduleUtxoUnlocker()
+		},
+	)
+}
+
+func (ts *TransactionService) registerHandlerForTxEvents() {
+	// Once confirmed, a tx can't be replaced anymore, therefore its transfer
+	// info is no longer required.
+	ts.repoManager.RegisterHandlerForTxEvent(
+		domain.TransactionConfirmed, func(event domain.TransactionEvent) {
+			txid := event.Transaction.TxID
+			if _, err := ts.repoManager.TransferRepository().DeleteTransfer(
+				context.Background(), txid,
+			); err != nil {
+				ts.warn(err, "failed to delete transfer of confirmed tx %s", txid)
+			}
+		},
+	)
+}
+
+func (ts *TransactionService) registerHandlerForUtxoEvents() {
+	ts.repoManager.RegisterHandlerForUtxoEvent(
+		domain.UtxoLocked, func(event domain.UtxoEvent) {
+			keys := UtxosInfo(event.Utxos).Keys()
+			ts.spawnUtxoUnlocker(keys)
+		},
+	)
+}
+
+// scheduleUtxoUnlocker waits 5 seconds before whether unlocking or spawning an
+// unlocker for all the locked utxos of each account.
+// Since this method is called when the service is istantiated, the idea is to
+// to give the account service enough time to receive notification from the
+// blockchain scanner and spend the locked utxos.
+func (ts *TransactionService) scheduleUtxoUnlocker() {
+	time.Sleep(5 * time.Second)
+
+	ctx := context.Background()
+	utxoRepo := ts.repoManager.UtxoRepository()
+	w, _ := ts.repoManager.WalletRepository().GetWallet(ctx)
+
+	for accountName := range w.Accounts {
+		utxos, _ := utxoRepo.GetLockedUtxosForAccount(
+			ctx, accountName,
+		)
+		if len(utxos) > 0 {
+			utxosToUnlock := make([]domain.UtxoKey, 0, len(utxos))
+			utxosToSpawnUnlocker := make([]domain.UtxoKey, 0, len(utxos))
+			for _, u := range utxos {
+				if u.CanUnlock() {
+					utxosToUnlock = append(utxosToUnlock, u.Key())
+				} else {
+					utxosToSpawnUnlocker = append(utxosToSpawnUnlocker, u.Key())
+				}
+			}
+
+			if len(utxosToUnlock) > 0 {
+				count, err := utxoRepo.UnlockUtxos(ctx, utxosToUnlock)
+				if err != nil {
+					utxosToSpawnUnlocker = append(utxosToSpawnUnlocker, utxosToUnlock...)
+				}
+				if count > 0 {
+					ts.log(
+						"unlocked %d utxo(s) for account %s (%s)",
+						count, accountName, UtxoKeys(utxosToUnlock),
+					)
+				}
+			}
+			if len(utxosToSpawnUnlocker) > 0 {
+				ts.spawnUtxoUnlocker(utxosToSpawnUnlocker)
+			}
+		}
+	}
+}
+
+// spawnUtxoUnlocker groups the locked utxos identified by the given keys by their
+// lock expiration, and then creates a goroutine for each group in order to unlock
+// the utxos if they are still locked when their expiration time comes.
+// In case the lease of the utxos is extended meanwhile, the unlocker waits for
+// the new expiration time.
+func (ts *TransactionService) spawnUtxoUnlocker(utxoKeys []domain.UtxoKey) {
+	ctx := context.Background()
+	utxos, _ := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, utxoKeys)
+
+	utxosByLockExpiration := make(map[int64][]domain.UtxoKey)
+	for _, u := range utxos {
+		utxosByLockExpiration[u.LockExpiryTimestamp] = append(
+			utxosByLockExpiration[u.LockExpiryTimestamp], u.Key(),
+		)
+	}
+
+	for expiration := range utxosByLockExpiration {
+		keys := utxosByLockExpiration[expiration]
+		unlockTime := time.Until(time.Unix(expiration, 0))
+		if unlockTime <= 0 {
+			unlockTime = time.Second
+		}
 		t := time.NewTicker(unlockTime)
 		quitChan := make(chan struct{})
 		go func(keys []domain.UtxoKey, t *time.Ticker, quitChan chan struct{}) {
@@ -844,15 +3198,28 @@ func (ts *TransactionService) spawnUtxoUnlocker(utxoKeys []domain.UtxoKey) {
 					utxos, _ := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
 					utxosToUnlock := make([]domain.UtxoKey, 0, len(utxos))
 					spentUtxos := make([]domain.UtxoKey, 0, len(utxos))
+					nextExpiration := int64(0)
 					for _, u := range utxos {
 						if u.IsSpent() {
 							spentUtxos = append(spentUtxos, u.Key())
 
 						} else if u.IsLocked() {
+							if !u.CanUnlock() {
+								if nextExpiration == 0 ||
+									u.LockExpiryTimestamp < nextExpiration {
+									nextExpiration = u.LockExpiryTimestamp
+								}
+								continue
+							}
 							utxosToUnlock = append(utxosToUnlock, u.Key())
 						}
 					}
 
+					// The lease has been extended, wait for the new expiration.
+					if nextExpiration > 0 {
+						t.Reset(time.Until(time.Unix(nextExpiration, 0)) + time.Second)
+					}
+
 					if len(utxosToUnlock) > 0 {
 						// In case of errors here, the ticker is possibly reset to a shortest
 						// duration to keep retring to unlock the locked utxos as soon as
@@ -871,7 +3238,9 @@ func (ts *TransactionService) spawnUtxoUnlocker(utxoKeys []domain.UtxoKey) {
 						if count > 0 {
 							ts.log("unlocked %d utxo(s) %s", count, UtxoKeys(keys))
 						}
-						t.Stop()
+						if nextExpiration == 0 {
+							t.Stop()
+						}
 					}
 					if len(spentUtxos) > 0 {
 						ts.log(
@@ -887,40 +3256,677 @@ func (ts *TransactionService) spawnUtxoUnlocker(utxoKeys []domain.UtxoKey) {
 	}
 }
 
-func (ts *TransactionService) getWallet(
-	ctx context.Context,
-) (*singlesig.Wallet, error) {
-	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+func (ts *TransactionService) getWallet(
+	ctx context.Context,
+) (*singlesig.Wallet, error) {
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mnemonic, err := w.GetMnemonic()
+	if err != nil {
+		return nil, err
+	}
+
+	return singlesig.NewWalletFromMnemonic(singlesig.NewWalletFromMnemonicArgs{
+		RootPath: w.RootPath,
+		Mnemonic: mnemonic,
+	})
+}
+
+func (ts *TransactionService) getAccount(
+	ctx context.Context, accountName string,
+) (*domain.Account, error) {
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return w.GetAccount(accountName)
+}
+
+// getAuthorizedAccount returns the given account only if the given password
+// is the one of the wallet.
+func (ts *TransactionService) getAuthorizedAccount(
+	ctx context.Context, accountName, password string,
+) (*domain.Account, error) {
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !w.IsValidPassword(password) {
+		return nil, domain.ErrWalletInvalidPassword
+	}
+	return w.GetAccount(accountName)
+}
+
+// getWindowSpendings returns the spendings of the given account within the
+// spending window, that is those pending created and those signed within
+// the window. Since a pending spending can be signed until the end of its
+// window, the signed ones may have been created up to a window earlier.
+func (ts *TransactionService) getWindowSpendings(
+	ctx context.Context, accountName string,
+) ([]*domain.Spending, error) {
+	windowStart := spendingWindowStart()
+	spendings, err := ts.repoManager.SpendingPolicyRepository().
+		GetSpendingsForAccount(
+			ctx, accountName, windowStart-int64(spendingWindow.Seconds()),
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	windowSpendings := make([]*domain.Spending, 0, len(spendings))
+	for _, s := range spendings {
+		if s.IsSigned() && s.SignedAt < windowStart ||
+			!s.IsSigned() && s.CreatedAt < windowStart {
+			continue
+		}
+		windowSpendings = append(windowSpendings, s)
+	}
+	return windowSpendings, nil
+}
+
+// getChosenUtxos returns the utxos with the given keys making sure they are
+// owned by the given account, and that they are neither spent, nor locked or
+// frozen.
+func (ts *TransactionService) getChosenUtxos(
+	ctx context.Context, accountName string, ins Inputs,
+) ([]*domain.Utxo, error) {
+	if len(ins) <= 0 {
+		return nil, nil
+	}
+
+	keys := ins.Keys()
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	utxosByKey := make(map[domain.UtxoKey]*domain.Utxo)
+	for _, u := range utxos {
+		utxosByKey[u.Key()] = u
+	}
+
+	chosenUtxos := make([]*domain.Utxo, 0, len(keys))
+	for _, key := range keys {
+		u, ok := utxosByKey[key]
+		if !ok || u.AccountName != accountName {
+			return nil, fmt.Errorf(
+				"utxo %s not found for account %s", key, accountName,
+			)
+		}
+		if u.IsSpent() {
+			return nil, fmt.Errorf("utxo %s is already spent", key)
+		}
+		if u.IsLocked() {
+			return nil, fmt.Errorf("utxo %s: %w", key, domain.ErrUtxoAlreadyLocked)
+		}
+		if u.IsFrozen() {
+			return nil, fmt.Errorf("utxo %s: %w", key, domain.ErrUtxoFrozen)
+		}
+		chosenUtxos = append(chosenUtxos, u)
+	}
+	return chosenUtxos, nil
+}
+
+// estimateFees returns the fee amount for a tx with the given inputs and
+// outputs, based on its discounted virtual size if enabled for the network.
+func (ts *TransactionService) estimateFees(
+	inputs []wallet.Input, outputs []wallet.Output, millisatsPerByte uint64,
+) uint64 {
+	if ts.discountCT {
+		return wallet.EstimateDiscountedFees(inputs, outputs, millisatsPerByte)
+	}
+	return wallet.EstimateFees(inputs, outputs, millisatsPerByte)
+}
+
+// getFeeRate returns the given fee rate or, if not specified, the estimated
+// one. In case the estimation fails, the minimum fee rate is returned.
+func (ts *TransactionService) getFeeRate(
+	ctx context.Context, millisatsPerByte uint64,
+) uint64 {
+	if millisatsPerByte > 0 {
+		return millisatsPerByte
+	}
+
+	feeRate, err := ts.EstimateFeeRate(ctx, 0)
+	if err != nil {
+		ts.warn(err, "failed to estimate fee rate, using the minimum one")
+		return MinMillisatsPerByte
+	}
+	return feeRate
+}
+
+// getReservedUtxos returns the utxos of the given account locked by the given
+// lease, if any.
+func (ts *TransactionService) getReservedUtxos(
+	ctx context.Context, accountName, leaseID string,
+) ([]*domain.Utxo, error) {
+	if leaseID == "" {
+		return nil, nil
+	}
+
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosForLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(utxos) <= 0 {
+		return nil, ErrLeaseNotFound
+	}
+	for _, u := range utxos {
+		if u.AccountName != accountName {
+			return nil, fmt.Errorf(
+				"lease %s doesn't belong to account %s", leaseID, accountName,
+			)
+		}
+	}
+	return utxos, nil
+}
+
+// newChangeOutputs returns the outputs sending the given change amounts to
+// fresh internal addresses of the given account. In dry-run mode, the
+// addresses are only peeked, therefore they're not persisted for the account.
+func (ts *TransactionService) newChangeOutputs(
+	ctx context.Context, account *domain.Account,
+	changeByAsset map[string]uint64, dryRun bool,
+) ([]wallet.Output, error) {
+	changeOutputs := make([]wallet.Output, 0, len(changeByAsset))
+	if len(changeByAsset) <= 0 {
+		return changeOutputs, nil
+	}
+
+	addressesInfo, err := ts.nextInternalAddresses(
+		ctx, account, uint64(len(changeByAsset)), dryRun,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	i := 0
+	for asset, amount := range changeByAsset {
+		changeOutputs = append(
+			changeOutputs, newOutput(account, addressesInfo[i], asset, amount),
+		)
+		i++
+	}
+	return changeOutputs, nil
+}
+
+// newInternalOutput returns an output with the given asset and amount sent to
+// a fresh internal address of the given account.
+func (ts *TransactionService) newInternalOutput(
+	ctx context.Context, account *domain.Account, asset string, amount uint64,
+) (wallet.Output, error) {
+	addressesInfo, err := ts.nextInternalAddresses(ctx, account, 1, false)
+	if err != nil {
+		return wallet.Output{}, err
+	}
+	return newOutput(account, addressesInfo[0], asset, amount), nil
+}
+
+// newFragmentOutputs returns the outputs sending the given amounts of asset to
+// fresh internal addresses of the given account. In dry-run mode, the
+// addresses are only peeked, therefore they're not persisted for the account.
+func (ts *TransactionService) newFragmentOutputs(
+	ctx context.Context, account *domain.Account, asset string,
+	amounts []uint64, dryRun bool,
+) (Outputs, error) {
+	addressesInfo, err := ts.nextInternalAddresses(
+		ctx, account, uint64(len(amounts)), dryRun,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(Outputs, 0, len(amounts))
+	for i, amount := range amounts {
+		outputs = append(
+			outputs, Output(newOutput(account, addressesInfo[i], asset, amount)),
+		)
+	}
+	return outputs, nil
+}
+
+// nextInternalAddresses derives the given number of internal addresses for
+// the account. In dry-run mode, the addresses are only peeked, therefore
+// they're not persisted for the account.
+func (ts *TransactionService) nextInternalAddresses(
+	ctx context.Context, account *domain.Account, numOfAddresses uint64,
+	dryRun bool,
+) ([]domain.AddressInfo, error) {
+	walletRepo := ts.repoManager.WalletRepository()
+	if !dryRun {
+		return walletRepo.DeriveNextInternalAddressesForAccount(
+			ctx, account.Namespace, numOfAddresses,
+		)
+	}
+
+	w, err := walletRepo.GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return w.PeekNextInternalAddressesForAccount(
+		account.Namespace, numOfAddresses,
+	)
+}
+
+func newOutput(
+	account *domain.Account, addressInfo domain.AddressInfo,
+	asset string, amount uint64,
+) wallet.Output {
+	script, _ := hex.DecodeString(addressInfo.Script)
+	var blindingKey []byte
+	if !account.Unconf {
+		addr, _ := address.FromConfidential(addressInfo.Address)
+		blindingKey = addr.BlindingKey
+	}
+	return wallet.Output{
+		Asset:       asset,
+		Amount:      amount,
+		Script:      script,
+		BlindingKey: blindingKey,
+	}
+}
+
+// craftTransaction creates, blinds and signs a transaction with the given
+// wallet inputs and outputs, and locktime, then locks the utxos with the given keys and
+// returns the final tx in hex format. Unless ignoreFeeGuard is true, the tx
+// is refused if its effective fee rate exceeds the ceiling of the fee guard.
+func (ts *TransactionService) craftTransaction(
+	ctx context.Context, w *singlesig.Wallet, account *domain.Account,
+	inputs []wallet.Input, outs []wallet.Output, lockTime uint32,
+	keys []domain.UtxoKey, ignoreFeeGuard bool,
+) (string, error) {
+	inputsByIndex := make(map[uint32]wallet.Input)
+	for i, in := range inputs {
+		inputsByIndex[uint32(i)] = in
+	}
+
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:   inputs,
+		Outputs:  outs,
+		LockTime: lockTime,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	pset, _ := psetv2.NewPsetFromBase64(ptx)
+	summary, err := ts.summarizePset(ctx, w, pset)
+	if err != nil {
+		return "", err
+	}
+	if !ignoreFeeGuard {
+		if err := ts.checkFeeGuard(summary); err != nil {
+			return "", err
+		}
+	}
+	unlockAccounts := ts.lockSpendingAccounts(summary)
+	defer unlockAccounts()
+	commitSpending, err := ts.checkSpendingPolicies(ctx, summary)
+	if err != nil {
+		return "", err
+	}
+
+	blindedPtx, err := wallet.BlindPsetWithOwnedInputs(
+		wallet.BlindPsetWithOwnedInputsArgs{
+			PsetBase64:         ptx,
+			OwnedInputsByIndex: inputsByIndex,
+			LastBlinder:        true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	signedPtx, err := w.SignPset(singlesig.SignPsetArgs{
+		PsetBase64:        blindedPtx,
+		DerivationPathMap: account.DerivationPathByScript,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(wallet.FinalizeAndExtractTransactionArgs{
+		PsetBase64: signedPtx,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(keys) > 0 {
+		if _, err := ts.lockUtxos(ctx, account.Namespace, keys); err != nil {
+			return "", err
+		}
+	}
+	commitSpending()
+
+	return txHex, nil
+}
+
+// storeTransfer persists the receivers of the given tx crafted by a transfer
+// so that it can be later replaced. Failing to do this doesn't invalidate the
+// tx, therefore the error is just logged.
+func (ts *TransactionService) storeTransfer(
+	ctx context.Context, accountName, txHex string, plan *transferPlan,
+) {
+	tx, err := transaction.NewTxFromHex(txHex)
+	if err != nil {
+		ts.warn(err, "failed to parse transfer tx")
+		return
+	}
+	txid := tx.TxHash().String()
+	if _, err := ts.repoManager.TransferRepository().AddTransfer(
+		ctx, &domain.Transfer{
+			TxID:        txid,
+			AccountName: accountName,
+			Receivers:   plan.receivers(),
+		},
+	); err != nil {
+		ts.warn(err, "failed to store transfer %s, it won't be replaceable", txid)
+	}
+}
+
+// getQueuedPayments returns the queued payments of the given account, or of
+// every account if not specified.
+func (ts *TransactionService) getQueuedPayments(
+	ctx context.Context, accountName string,
+) ([]*domain.Payment, error) {
+	payments, err := ts.repoManager.PaymentRepository().GetQueuedPayments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if accountName == "" {
+		return payments, nil
+	}
+
+	accountPayments := make([]*domain.Payment, 0, len(payments))
+	for _, p := range payments {
+		if p.AccountName == accountName {
+			accountPayments = append(accountPayments, p)
+		}
+	}
+	return accountPayments, nil
+}
+
+// flushPayments sends the queued payments of the given account, or of every
+// account if not specified, with one batched transaction per account.
+// The payments are bound to the batched tx before it's broadcasted so that
+// they're never sent twice, and are removed from the queue once done, or at
+// a later round once the tx is known to the wallet.
+// If the transfer fails, those that can't be sent even on their own are
+// marked as failed and the others are retried in a new batch. The payments
+// stay in queue if the wallet is locked or if the new transfer fails too, so
+// that they are retried at the next round.
+func (ts *TransactionService) flushPayments(
+	ctx context.Context, accountName string,
+) {
+	ts.paymentLock.Lock()
+	defer ts.paymentLock.Unlock()
+
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil || w.IsLocked() {
+		return
+	}
+
+	payments, err := ts.getQueuedPayments(ctx, accountName)
+	if err != nil {
+		ts.warn(err, "failed to get queued payments")
+		return
+	}
+
+	accounts := make([]string, 0)
+	paymentsByAccount := make(map[string][]*domain.Payment)
+	sendingPaymentsByTxid := make(map[string][]string)
+	for _, p := range payments {
+		if p.IsFailed() {
+			continue
+		}
+		if p.IsSending() {
+			sendingPaymentsByTxid[p.TxID] = append(
+				sendingPaymentsByTxid[p.TxID], p.ID,
+			)
+			continue
+		}
+		if _, ok := paymentsByAccount[p.AccountName]; !ok {
+			accounts = append(accounts, p.AccountName)
+		}
+		paymentsByAccount[p.AccountName] = append(
+			paymentsByAccount[p.AccountName], p,
+		)
+	}
+
+	// Remove from queue the payments left in sending state by a previous
+	// round, if their tx has been broadcasted.
+	for txid, ids := range sendingPaymentsByTxid {
+		if tx, _ := ts.repoManager.TransactionRepository().GetTransaction(
+			ctx, txid,
+		); tx == nil {
+			continue
+		}
+		ts.removeSentPayments(ctx, ids, txid)
+	}
+
+	for _, account := range accounts {
+		accountPayments := paymentsByAccount[account]
+		txHex, err := ts.transferPayments(ctx, account, accountPayments)
+		if err != nil {
+			ts.warn(
+				err, "error while batching %d payment(s) of account %s",
+				len(accountPayments), account,
+			)
+
+			sendablePayments := ts.rejectFailingPayments(
+				ctx, account, accountPayments,
+			)
+			if len(sendablePayments) <= 0 ||
+				len(sendablePayments) == len(accountPayments) {
+				continue
+			}
+			accountPayments = sendablePayments
+			if txHex, err = ts.transferPayments(
+				ctx, account, accountPayments,
+			); err != nil {
+				ts.warn(
+					err, "error while batching %d payment(s) of account %s",
+					len(accountPayments), account,
+				)
+				continue
+			}
+		}
+
+		ids := make([]string, 0, len(accountPayments))
+		for _, p := range accountPayments {
+			ids = append(ids, p.ID)
+		}
+		tx, _ := transaction.NewTxFromHex(txHex)
+		txid := tx.TxHash().String()
+
+		paymentRepo := ts.repoManager.PaymentRepository()
+		if _, err := paymentRepo.MarkPaymentsSending(ctx, ids, txid); err != nil {
+			ts.warn(err, "failed to bind queued payments to tx %s", txid)
+			continue
+		}
+
+		if _, err := ts.BroadcastTransaction(ctx, txHex); err != nil {
+			ts.warn(err, "error while broadcasting batched payments tx")
+			if _, err := paymentRepo.MarkPaymentsSending(ctx, ids, ""); err != nil {
+				ts.warn(
+					err, "failed to unbind queued payments from tx %s, they must be "+
+						"canceled once the tx is known to be not broadcasted", txid,
+				)
+			}
+			continue
+		}
+
+		ts.removeSentPayments(ctx, ids, txid)
+	}
+}
+
+// transferPayments crafts a tx sending the given payments of the given
+// account.
+func (ts *TransactionService) transferPayments(
+	ctx context.Context, accountName string, payments []*domain.Payment,
+) (string, error) {
+	outputs := make(Outputs, 0, len(payments))
+	for _, p := range payments {
+		outputs = append(outputs, paymentOutput(p))
+	}
+	return ts.Transfer(
+		ctx, accountName, outputs, ts.batching.MillisatsPerByte, nil,
+		TransferOptions{},
+	)
+}
+
+// rejectFailingPayments marks as failed the given payments of the given
+// account that can't be sent even on their own, and returns the others.
+// If none can be sent, the failure is likely due to the account not having
+// spendable funds at the moment, therefore none is marked as failed.
+func (ts *TransactionService) rejectFailingPayments(
+	ctx context.Context, accountName string, payments []*domain.Payment,
+) []*domain.Payment {
+	sendablePayments := make([]*domain.Payment, 0, len(payments))
+	errorsById := make(map[string]error)
+	for _, p := range payments {
+		if _, err := ts.PreviewTransfer(
+			ctx, accountName, Outputs{paymentOutput(p)},
+			ts.batching.MillisatsPerByte, nil, TransferOptions{}, false,
+		); err != nil {
+			errorsById[p.ID] = err
+			continue
+		}
+		sendablePayments = append(sendablePayments, p)
+	}
+	if len(sendablePayments) <= 0 {
+		return nil
+	}
+
+	for id, transferErr := range errorsById {
+		if _, err := ts.repoManager.PaymentRepository().MarkPaymentsFailed(
+			ctx, []string{id}, transferErr.Error(),
+		); err != nil {
+			ts.warn(err, "failed to mark payment %s as failed", id)
+			continue
+		}
+		ts.log("payment %s of account %s failed: %s", id, accountName, transferErr)
+	}
+	return sendablePayments
+}
+
+// removeSentPayments removes from the queue the given payments sent with the
+// given tx.
+func (ts *TransactionService) removeSentPayments(
+	ctx context.Context, ids []string, txid string,
+) {
+	count, err := ts.repoManager.PaymentRepository().FlushPayments(
+		ctx, ids, txid,
+	)
 	if err != nil {
-		return nil, err
+		ts.warn(
+			err, "failed to remove payments sent with tx %s from queue, "+
+				"they'll be removed at the next round", txid,
+		)
+		return
 	}
-	mnemonic, err := w.GetMnemonic()
+	ts.log("sent %d queued payment(s) in tx %s", count, txid)
+}
+
+// paymentOutput returns the output of the given payment.
+func paymentOutput(p *domain.Payment) Output {
+	return Output{
+		Asset:       p.Asset,
+		Amount:      p.Amount,
+		Script:      p.Script,
+		BlindingKey: p.BlindingKey,
+	}
+}
+
+// getOwnedOutputs returns the spendable utxos created by the given tx, sorted
+// by output index. In case they belong to different accounts, only those of
+// the account owning the first one are returned.
+func (ts *TransactionService) getOwnedOutputs(
+	ctx context.Context, txid string,
+) ([]*domain.Utxo, error) {
+	utxos, err := ts.repoManager.UtxoRepository().GetAllUtxos(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return singlesig.NewWalletFromMnemonic(singlesig.NewWalletFromMnemonicArgs{
-		RootPath: w.RootPath,
-		Mnemonic: mnemonic,
+	ownedUtxos := make([]*domain.Utxo, 0)
+	for _, u := range utxos {
+		if u.TxID != txid || u.IsSpent() || u.IsLocked() || u.IsFrozen() {
+			continue
+		}
+		ownedUtxos = append(ownedUtxos, u)
+	}
+	if len(ownedUtxos) <= 0 {
+		return nil, nil
+	}
+	sort.SliceStable(ownedUtxos, func(i, j int) bool {
+		return ownedUtxos[i].VOut < ownedUtxos[j].VOut
 	})
+
+	accountName := ownedUtxos[0].AccountName
+	accountUtxos := make([]*domain.Utxo, 0, len(ownedUtxos))
+	for _, u := range ownedUtxos {
+		if u.AccountName == accountName {
+			accountUtxos = append(accountUtxos, u)
+		}
+	}
+	return accountUtxos, nil
 }
 
-func (ts *TransactionService) getAccount(
-	ctx context.Context, accountName string,
-) (*domain.Account, error) {
-	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+// storeSwap locks the given utxos with a brand new lease and stores the given
+// swap with the same id. The utxos are released if they can't all be locked.
+// The utxos are spent by the given pset starting from the given input index.
+func (ts *TransactionService) storeSwap(
+	ctx context.Context, swap domain.Swap, ptx string, utxos []*domain.Utxo,
+	firstInputIndex uint32,
+) (*SwapOffer, error) {
+	keys := Utxos(utxos).Keys()
+	lease, err := ts.lockUtxos(ctx, swap.AccountName, keys)
 	if err != nil {
 		return nil, err
 	}
-	return w.GetAccount(accountName)
+	if len(lease.Utxos) != len(keys) {
+		if _, err := ts.UnlockUtxos(ctx, lease.ID, nil); err != nil {
+			ts.warn(err, "failed to release utxos locked by lease %s", lease.ID)
+		}
+		return nil, domain.ErrUtxoAlreadyLocked
+	}
+
+	swap.ID = lease.ID
+	if _, err := ts.repoManager.SwapRepository().AddSwap(ctx, &swap); err != nil {
+		return nil, err
+	}
+	ts.log(
+		"stored swap %s for account %s: %d of asset %s for %d of asset %s",
+		swap.ID, swap.AccountName, swap.GiveAmount, swap.GiveAsset,
+		swap.WantAmount, swap.WantAsset,
+	)
+
+	unblindedInputs := make([]UnblindedInput, 0, len(utxos))
+	for i, u := range utxos {
+		unblindedInputs = append(
+			unblindedInputs, newUnblindedInput(u, firstInputIndex+uint32(i)),
+		)
+	}
+
+	return &SwapOffer{
+		Swap:            swap,
+		Pset:            ptx,
+		UnblindedInputs: unblindedInputs,
+		ExpiryTimestamp: lease.ExpiryTimestamp,
+	}, nil
 }
 
 func (ts *TransactionService) getWalletInputs(
 	ctx context.Context, ins Inputs, wantsLocked bool,
 ) ([]wallet.Input, error) {
 	keys := make([]domain.UtxoKey, 0, len(ins))
+	sequences := make(map[domain.UtxoKey]*uint32)
 	for _, in := range ins {
 		keys = append(keys, in.toUtxoKey())
+		sequences[in.toUtxoKey()] = in.Sequence
 	}
 	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
 	if err != nil {
@@ -933,29 +3939,584 @@ func (ts *TransactionService) getWalletInputs(
 		if wantsLocked && !u.IsLocked() {
 			return nil, ErrForbiddenUnlockedInputs
 		}
+		if wantsLocked && u.IsFrozen() {
+			return nil, domain.ErrUtxoFrozen
+		}
+
+		account, _ := w.GetAccount(u.AccountName)
+		script := hex.EncodeToString(u.Script)
+		derivationPath := account.DerivationPathByScript[script]
+
+		inputs = append(inputs, wallet.Input{
+			TxID:            u.TxID,
+			TxIndex:         u.VOut,
+			Value:           u.Value,
+			Asset:           u.Asset,
+			Script:          u.Script,
+			ValueBlinder:    u.ValueBlinder,
+			AssetBlinder:    u.AssetBlinder,
+			ValueCommitment: u.ValueCommitment,
+			AssetCommitment: u.AssetCommitment,
+			Nonce:           u.Nonce,
+			RangeProof:      u.RangeProof,
+			SurjectionProof: u.SurjectionProof,
+			DerivationPath:  derivationPath,
+			Sequence:        sequences[u.Key()],
+		})
+	}
+
+	return inputs, nil
+}
+
+// decodePset is the implementation of DecodePset for an already parsed
+// partial transaction.
+func (ts *TransactionService) decodePset(
+	ctx context.Context, w *singlesig.Wallet, ptx *psetv2.Pset,
+) (*DecodedPset, error) {
+	accountByScript, err := ts.getAccountsByScript(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	netAmountByAccount := make(map[string]map[string]int64)
+	addNetAmount := func(accountName, asset string, amount int64) {
+		if accountName == "" || asset == "" {
+			return
+		}
+		if _, ok := netAmountByAccount[accountName]; !ok {
+			netAmountByAccount[accountName] = make(map[string]int64)
+		}
+		netAmountByAccount[accountName][asset] += amount
+	}
+
+	keys := make([]domain.UtxoKey, 0, len(ptx.Inputs))
+	for _, in := range ptx.Inputs {
+		keys = append(keys, domain.UtxoKey{
+			TxID: elementsutil.TxIDFromBytes(in.PreviousTxid),
+			VOut: in.PreviousTxIndex,
+		})
+	}
+	utxos, err := ts.repoManager.UtxoRepository().GetUtxosByKey(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	utxosByKey := make(map[string]*domain.Utxo)
+	for _, u := range utxos {
+		utxosByKey[u.Key().Hash()] = u
+	}
+
+	inputs := make([]DecodedInput, 0, len(ptx.Inputs))
+	for i := range ptx.Inputs {
+		in := &ptx.Inputs[i]
+		decodedIn := DecodedInput{
+			TxID:        keys[i].TxID,
+			VOut:        keys[i].VOut,
+			SighashType: uint32(in.SigHashType),
+			Signed: len(in.PartialSigs) > 0 || len(in.TapKeySig) > 0 ||
+				len(in.TapScriptSig) > 0,
+			Finalized: len(in.FinalScriptWitness) > 0 ||
+				len(in.FinalScriptSig) > 0,
+		}
+		prevout := in.GetUtxo()
+		if u, ok := utxosByKey[keys[i].Hash()]; ok {
+			decodedIn.AccountName = u.AccountName
+			decodedIn.Asset, decodedIn.Amount = u.Asset, u.Value
+			decodedIn.Confidential = u.IsConfidential()
+		} else if prevout != nil {
+			decodedIn.AccountName = accountByScript[hex.EncodeToString(prevout.Script)]
+			decodedIn.Confidential = prevout.IsConfidential()
+			if decodedIn.AccountName != "" {
+				decodedIn.Asset, decodedIn.Amount = revealOwnedOutput(
+					w, &transaction.TxOutput{
+						Asset:      prevout.Asset,
+						Value:      prevout.Value,
+						Script:     prevout.Script,
+						Nonce:      prevout.Nonce,
+						RangeProof: in.UtxoRangeProof,
+					},
+				)
+			} else {
+				decodedIn.Asset, decodedIn.Amount, _ = getSwapInputAmount(in)
+			}
+		}
+		addNetAmount(
+			decodedIn.AccountName, decodedIn.Asset, -int64(decodedIn.Amount),
+		)
+		inputs = append(inputs, decodedIn)
+	}
+
+	var feeAmount uint64
+	outputs := make([]DecodedOutput, 0, len(ptx.Outputs))
+	for _, out := range ptx.Outputs {
+		script := hex.EncodeToString(out.Script)
+		decodedOut := DecodedOutput{
+			Script:       script,
+			AccountName:  accountByScript[script],
+			Confidential: out.NeedsBlinding(),
+			Blinded:      out.IsFullyBlinded(),
+		}
+		if len(out.Script) <= 0 {
+			feeAmount += out.Value
+		}
+
+		switch {
+		case decodedOut.Blinded && decodedOut.AccountName != "":
+			decodedOut.Asset, decodedOut.Amount = revealOwnedOutput(
+				w, &transaction.TxOutput{
+					Asset:           out.AssetCommitment,
+					Value:           out.ValueCommitment,
+					Script:          out.Script,
+					Nonce:           out.EcdhPubkey,
+					RangeProof:      out.ValueRangeproof,
+					SurjectionProof: out.AssetSurjectionProof,
+				},
+			)
+		case decodedOut.Blinded:
+			if len(out.Asset) > 0 && confidential.VerifyBlindValueProof(
+				out.Value, out.ValueCommitment, out.AssetCommitment,
+				out.BlindValueProof,
+			) && confidential.VerifyBlindAssetProof(
+				out.Asset, out.AssetCommitment, out.BlindAssetProof,
+			) {
+				decodedOut.Asset = elementsutil.TxIDFromBytes(out.Asset)
+				decodedOut.Amount = out.Value
+			}
+		case len(out.Asset) > 0:
+			decodedOut.Asset = elementsutil.TxIDFromBytes(out.Asset)
+			decodedOut.Amount = out.Value
+		}
+		addNetAmount(
+			decodedOut.AccountName, decodedOut.Asset, int64(decodedOut.Amount),
+		)
+		outputs = append(outputs, decodedOut)
+	}
+
+	return &DecodedPset{
+		Inputs:             inputs,
+		Outputs:            outputs,
+		NetAmountByAccount: netAmountByAccount,
+		FeeAmount:          feeAmount,
+		BlindingComplete:   !ptx.NeedsBlinding(),
+	}, nil
+}
+
+// getAccountsByScript returns the name of the account owning each of the
+// scripts of the wallet.
+func (ts *TransactionService) getAccountsByScript(
+	ctx context.Context,
+) (map[string]string, error) {
+	w, err := ts.repoManager.WalletRepository().GetWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountByScript := make(map[string]string)
+	for _, account := range w.Accounts {
+		for script := range account.DerivationPathByScript {
+			accountByScript[script] = account.Namespace
+		}
+	}
+	return accountByScript, nil
+}
+
+// estimateTxSize returns the estimated virtual size of a tx with the given
+// inputs and outputs, excluding the fee one, discounted if the service is
+// configured so.
+func (ts *TransactionService) estimateTxSize(
+	inputs []wallet.Input, outputs []wallet.Output,
+) uint64 {
+	if ts.discountCT {
+		return wallet.EstimateDiscountedTxSize(inputs, outputs)
+	}
+	return wallet.EstimateTxSize(inputs, outputs)
+}
+
+// summarizePset returns the spending summary of the given partial
+// transaction.
+func (ts *TransactionService) summarizePset(
+	ctx context.Context, w *singlesig.Wallet, ptx *psetv2.Pset,
+) (*spendingSummary, error) {
+	decoded, err := ts.decodePset(ctx, w, ptx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]wallet.Input, 0, len(ptx.Inputs))
+	for _, in := range ptx.Inputs {
+		var script []byte
+		if prevout := in.GetUtxo(); prevout != nil {
+			script = prevout.Script
+		}
+		inputs = append(inputs, wallet.Input{Script: script})
+	}
+	outputs := make([]wallet.Output, 0, len(ptx.Outputs))
+	destinations := make([]string, 0)
+	for i, out := range ptx.Outputs {
+		if len(out.Script) <= 0 {
+			continue
+		}
+		outputs = append(outputs, wallet.Output{
+			Script:      out.Script,
+			BlindingKey: out.BlindingPubkey,
+		})
+		if decoded.Outputs[i].AccountName == "" {
+			destinations = append(destinations, decoded.Outputs[i].Script)
+		}
+	}
+
+	return &spendingSummary{
+		netAmountByAccount: decoded.NetAmountByAccount,
+		destinations:       destinations,
+		feeAmount:          decoded.FeeAmount,
+		txSize:             ts.estimateTxSize(inputs, outputs),
+	}, nil
+}
+
+// summarizeTransaction returns the spending summary of the given raw
+// transaction, whose inputs owned by the wallet are the given ones.
+func (ts *TransactionService) summarizeTransaction(
+	ctx context.Context, w *singlesig.Wallet, tx *transaction.Transaction,
+	ownedInputs map[uint32]wallet.Input,
+) (*spendingSummary, error) {
+	accountByScript, err := ts.getAccountsByScript(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &spendingSummary{
+		netAmountByAccount: make(map[string]map[string]int64),
+		destinations:       make([]string, 0),
+		feeAmount:          getTxFeeAmount(tx),
+		txid:               tx.TxHash().String(),
+	}
+
+	inputs := make([]wallet.Input, 0, len(tx.Inputs))
+	for i := range tx.Inputs {
+		in := ownedInputs[uint32(i)]
+		inputs = append(inputs, wallet.Input{Script: in.Script})
+		summary.addNetAmount(
+			accountByScript[hex.EncodeToString(in.Script)], in.Asset,
+			-int64(in.Value),
+		)
+	}
+	outputs := make([]wallet.Output, 0, len(tx.Outputs))
+	for _, out := range tx.Outputs {
+		if len(out.Script) <= 0 {
+			continue
+		}
+		var blindingKey []byte
+		if out.IsConfidential() {
+			blindingKey = out.Nonce
+		}
+		outputs = append(outputs, wallet.Output{
+			Script:      out.Script,
+			BlindingKey: blindingKey,
+		})
+
+		script := hex.EncodeToString(out.Script)
+		accountName, ok := accountByScript[script]
+		if !ok {
+			summary.destinations = append(summary.destinations, script)
+			continue
+		}
+		asset, amount := revealOwnedOutput(w, out)
+		summary.addNetAmount(accountName, asset, int64(amount))
+	}
+	summary.txSize = ts.estimateTxSize(inputs, outputs)
+
+	return summary, nil
+}
+
+// checkFeeGuard makes sure that the effective fee rate of the transaction
+// with the given summary doesn't exceed the ceiling of the fee guard.
+func (ts *TransactionService) checkFeeGuard(summary *spendingSummary) error {
+	if ts.maxFeeRate <= 0 {
+		return nil
+	}
+	if feeRate := summary.feeRate(); feeRate > ts.maxFeeRate {
+		return fmt.Errorf(
+			"%w: fee amount %d for an estimated size of %d bytes is %d "+
+				"mSats/byte, above the ceiling of %d",
+			ErrFeeGuardExceeded, summary.feeAmount, summary.txSize, feeRate,
+			ts.maxFeeRate,
+		)
+	}
+	return nil
+}
+
+// lockSpendingAccounts locks the accounts involved in the transaction with
+// the given summary, so that checking their spending policies, signing the
+// transaction and recording their spendings can't interleave with concurrent
+// transactions of the same accounts, which would all be checked against the
+// same daily spendings otherwise. The returned function releases the locks.
+func (ts *TransactionService) lockSpendingAccounts(
+	summary *spendingSummary,
+) func() {
+	accounts := make([]string, 0, len(summary.netAmountByAccount))
+	for accountName := range summary.netAmountByAccount {
+		accounts = append(accounts, accountName)
+	}
+	// Locks are always acquired in the same order to prevent deadlocks.
+	sort.Strings(accounts)
+
+	ts.spendingLocksLock.Lock()
+	locks := make([]*sync.Mutex, 0, len(accounts))
+	for _, accountName := range accounts {
+		lock, ok := ts.spendingLocks[accountName]
+		if !ok {
+			lock = &sync.Mutex{}
+			ts.spendingLocks[accountName] = lock
+		}
+		locks = append(locks, lock)
+	}
+	ts.spendingLocksLock.Unlock()
+
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for i := len(locks) - 1; i >= 0; i-- {
+			locks[i].Unlock()
+		}
+	}
+}
+
+// checkSpendingPolicies makes sure that the transaction with the given
+// summary complies with the spending policies of the accounts whose funds it
+// spends, and records every violation in the audit log of the account.
+// The returned function must be called once the transaction is signed so
+// that its amounts count towards the daily limits of the accounts.
+func (ts *TransactionService) checkSpendingPolicies(
+	ctx context.Context, summary *spendingSummary,
+) (func(), error) {
+	accounts := make([]string, 0, len(summary.netAmountByAccount))
+	for accountName := range summary.netAmountByAccount {
+		accounts = append(accounts, accountName)
+	}
+	sort.Strings(accounts)
+
+	commits := make([]func(), 0)
+	for _, accountName := range accounts {
+		spentAmounts := make(map[string]uint64)
+		for asset, amount := range summary.netAmountByAccount[accountName] {
+			if amount < 0 {
+				spentAmounts[asset] = uint64(-amount)
+			}
+		}
+		if len(spentAmounts) <= 0 {
+			continue
+		}
+
+		policy, err := ts.repoManager.SpendingPolicyRepository().GetPolicy(
+			ctx, accountName,
+		)
+		if err != nil {
+			if errors.Is(err, domain.ErrSpendingPolicyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		commit, err := ts.checkSpendingPolicy(ctx, policy, spentAmounts, summary)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	return func() {
+		for _, commit := range commits {
+			commit()
+		}
+	}, nil
+}
+
+// checkSpendingPolicy checks the given amounts spent by an account, along
+// with the rest of the summary of the transaction, against the account's
+// policy. If the amounts require approval, the spending stays pending until
+// approved by enough approvers and the transaction is refused in the
+// meantime.
+func (ts *TransactionService) checkSpendingPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+	spentAmounts map[string]uint64, summary *spendingSummary,
+) (func(), error) {
+	policyRepo := ts.repoManager.SpendingPolicyRepository()
+	spendings, err := ts.getWindowSpendings(ctx, policy.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := spendingDigest(policy.AccountName, spentAmounts, summary)
+	var pendingSpending *domain.Spending
+	dailyAmounts := make(map[string]uint64)
+	for _, s := range spendings {
+		if s.IsSigned() {
+			for asset, amount := range s.Amounts {
+				dailyAmounts[asset] += amount
+			}
+			continue
+		}
+		if s.Digest == digest {
+			pendingSpending = s
+		}
+	}
+
+	violations := make([]domain.PolicyViolation, 0)
+	addViolation := func(
+		rule domain.PolicyRule, format string, a ...interface{},
+	) {
+		violations = append(violations, domain.PolicyViolation{
+			Rule:   rule,
+			Reason: fmt.Sprintf(format, a...),
+		})
+	}
+
+	needsApproval := false
+	for _, limit := range policy.AssetLimits {
+		amount, ok := spentAmounts[limit.Asset]
+		if !ok {
+			continue
+		}
+		if limit.MaxAmountPerTx > 0 && amount > limit.MaxAmountPerTx {
+			addViolation(
+				domain.PolicyRuleMaxAmountPerTx,
+				"amount %d of asset %s exceeds the limit of %d per transaction",
+				amount, limit.Asset, limit.MaxAmountPerTx,
+			)
+		}
+		if limit.MaxAmountPerDay > 0 &&
+			dailyAmounts[limit.Asset]+amount > limit.MaxAmountPerDay {
+			addViolation(
+				domain.PolicyRuleMaxAmountPerDay,
+				"amount %d of asset %s exceeds the limit of %d per day, %d "+
+					"already spent", amount, limit.Asset, limit.MaxAmountPerDay,
+				dailyAmounts[limit.Asset],
+			)
+		}
+		if limit.ApprovalThreshold > 0 && amount > limit.ApprovalThreshold {
+			needsApproval = true
+		}
+	}
+	for _, script := range summary.destinations {
+		if containsString(policy.DeniedScripts, script) {
+			addViolation(
+				domain.PolicyRuleDeniedDestination,
+				"destination %s is denied", script,
+			)
+			continue
+		}
+		if len(policy.AllowedScripts) > 0 &&
+			!containsString(policy.AllowedScripts, script) {
+			addViolation(
+				domain.PolicyRuleDestinationNotAllowed,
+				"destination %s is not allowed", script,
+			)
+		}
+	}
+	if policy.MaxFeeAmount > 0 && summary.feeAmount > policy.MaxFeeAmount {
+		addViolation(
+			domain.PolicyRuleMaxFeeAmount,
+			"fee amount %d exceeds the limit of %d",
+			summary.feeAmount, policy.MaxFeeAmount,
+		)
+	}
+	if policy.MaxFeeRate > 0 {
+		if feeRate := summary.feeRate(); feeRate > policy.MaxFeeRate {
+			addViolation(
+				domain.PolicyRuleMaxFeeRate,
+				"fee rate of %d mSats/byte exceeds the limit of %d",
+				feeRate, policy.MaxFeeRate,
+			)
+		}
+	}
+
+	// Ask for approval only for spendings that don't break any other rule.
+	now := time.Now().Unix()
+	if len(violations) <= 0 && needsApproval {
+		if pendingSpending == nil {
+			id, err := newSpendingID()
+			if err != nil {
+				return nil, err
+			}
+			pendingSpending = &domain.Spending{
+				ID:          id,
+				AccountName: policy.AccountName,
+				Digest:      digest,
+				Amounts:     spentAmounts,
+				CreatedAt:   now,
+			}
+			if _, err := policyRepo.AddSpending(ctx, pendingSpending); err != nil {
+				return nil, err
+			}
+		}
+		if len(pendingSpending.Approvers) < int(policy.RequiredApprovals) {
+			addViolation(
+				domain.PolicyRuleApprovalRequired,
+				"spending %s requires %d approval(s), got %d",
+				pendingSpending.ID, policy.RequiredApprovals,
+				len(pendingSpending.Approvers),
+			)
+		}
+	}
+
+	if len(violations) > 0 {
+		reasons := make([]string, 0, len(violations))
+		for i := range violations {
+			violation := &violations[i]
+			id, err := newViolationID()
+			if err != nil {
+				return nil, err
+			}
+			violation.ID = id
+			violation.AccountName = policy.AccountName
+			violation.Timestamp = now
+			if err := policyRepo.AddViolation(ctx, violation); err != nil {
+				return nil, err
+			}
+			ts.log(
+				"account %s violated spending policy rule %s: %s",
+				policy.AccountName, violation.Rule, violation.Reason,
+			)
+			reasons = append(reasons, violation.Reason)
+		}
 
-		account, _ := w.GetAccount(u.AccountName)
-		script := hex.EncodeToString(u.Script)
-		derivationPath := account.DerivationPathByScript[script]
-
-		inputs = append(inputs, wallet.Input{
-			TxID:            u.TxID,
-			TxIndex:         u.VOut,
-			Value:           u.Value,
-			Asset:           u.Asset,
-			Script:          u.Script,
-			ValueBlinder:    u.ValueBlinder,
-			AssetBlinder:    u.AssetBlinder,
-			ValueCommitment: u.ValueCommitment,
-			AssetCommitment: u.AssetCommitment,
-			Nonce:           u.Nonce,
-			RangeProof:      u.RangeProof,
-			SurjectionProof: u.SurjectionProof,
-			DerivationPath:  derivationPath,
-		})
+		err := ErrSpendingPolicyViolation
+		if violations[0].Rule == domain.PolicyRuleApprovalRequired {
+			err = ErrSpendingApprovalRequired
+		}
+		return nil, fmt.Errorf("%w: %s", err, strings.Join(reasons, ", "))
 	}
 
-	return inputs, nil
+	return func() {
+		if pendingSpending != nil {
+			if err := policyRepo.UpdateSpending(
+				ctx, pendingSpending.ID,
+				func(s *domain.Spending) (*domain.Spending, error) {
+					s.Sign()
+					return s, nil
+				},
+			); err != nil {
+				ts.warn(err, "failed to mark spending %s as signed", pendingSpending.ID)
+			}
+			return
+		}
+
+		id, err := newSpendingID()
+		if err != nil {
+			ts.warn(err, "failed to record spending of account %s", policy.AccountName)
+			return
+		}
+		if _, err := policyRepo.AddSpending(ctx, &domain.Spending{
+			ID:          id,
+			AccountName: policy.AccountName,
+			Digest:      digest,
+			Amounts:     spentAmounts,
+			CreatedAt:   now,
+			SignedAt:    now,
+		}); err != nil {
+			ts.warn(err, "failed to record spending of account %s", policy.AccountName)
+		}
+	}, nil
 }
 
 func (ts *TransactionService) findLockedInputs(
@@ -1055,6 +4616,210 @@ func (ts *TransactionService) getExternalInputs(
 	return externalInputs, nil
 }
 
+func newLeaseID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate lease id: %s", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func newPaymentID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate payment id: %s", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func newSpendingID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate spending id: %s", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func newViolationID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate policy violation id: %s", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// spendingWindowStart returns the start of the rolling day within which the
+// signed spendings count towards the daily limits and the pending ones can be
+// approved.
+func spendingWindowStart() int64 {
+	return time.Now().Add(-spendingWindow).Unix()
+}
+
+// verifyApproval makes sure the given signature is the approver's DER
+// encoded ECDSA signature of the given spending digest.
+func verifyApproval(digest, approver, signature string) error {
+	hash, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("invalid spending digest: %s", err)
+	}
+	buf, err := hex.DecodeString(approver)
+	if err != nil {
+		return fmt.Errorf("invalid approver format, must be hex")
+	}
+	pubkey, err := btcec.ParsePubKey(buf)
+	if err != nil {
+		return fmt.Errorf("invalid approver public key: %s", err)
+	}
+	buf, err = hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid approval signature format, must be hex")
+	}
+	sig, err := ecdsa.ParseDERSignature(buf)
+	if err != nil {
+		return fmt.Errorf("invalid approval signature: %s", err)
+	}
+	if !sig.Verify(hash, pubkey) {
+		return ErrInvalidApprovalSignature
+	}
+	return nil
+}
+
+// spendingDigest returns the digest identifying a spending across several
+// signing attempts, committing to the account, the spent amounts, the
+// destinations and the fee amount of the tx with the given summary, and to
+// its unsigned txid if known, so that an approval can't be replayed for
+// another tx.
+func spendingDigest(
+	accountName string, amounts map[string]uint64, summary *spendingSummary,
+) string {
+	assets := make([]string, 0, len(amounts))
+	for asset := range amounts {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	scripts := append([]string{}, summary.destinations...)
+	sort.Strings(scripts)
+
+	h := sha256.New()
+	h.Write([]byte(accountName))
+	for _, asset := range assets {
+		h.Write([]byte(fmt.Sprintf("%s:%d", asset, amounts[asset])))
+	}
+	for _, script := range scripts {
+		h.Write([]byte(script))
+	}
+	h.Write([]byte(fmt.Sprintf("fee:%d", summary.feeAmount)))
+	if summary.txid != "" {
+		h.Write([]byte(fmt.Sprintf("txid:%s", summary.txid)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// unsignedTxid returns the txid of the unsigned tx of the given pset.
+func unsignedTxid(ptx *psetv2.Pset) (string, error) {
+	tx, err := ptx.UnsignedTx()
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+// validateSpendingPolicy makes sure the given policy is consistent.
+func validateSpendingPolicy(policy domain.SpendingPolicy) error {
+	if policy.AccountName == "" {
+		return fmt.Errorf("missing account name")
+	}
+	assets := make(map[string]struct{})
+	for _, limit := range policy.AssetLimits {
+		if err := validateAsset(limit.Asset); err != nil {
+			return err
+		}
+		if _, ok := assets[limit.Asset]; ok {
+			return fmt.Errorf("duplicated limits for asset %s", limit.Asset)
+		}
+		assets[limit.Asset] = struct{}{}
+		if limit.ApprovalThreshold > 0 && policy.RequiredApprovals == 0 {
+			return ErrMissingRequiredApprovals
+		}
+	}
+	if int(policy.RequiredApprovals) > len(policy.Approvers) {
+		return ErrNotEnoughApprovers
+	}
+	approvers := make(map[string]struct{})
+	for _, approver := range policy.Approvers {
+		buf, err := hex.DecodeString(approver)
+		if err != nil {
+			return fmt.Errorf("invalid approver format, must be hex")
+		}
+		if len(buf) != btcec.PubKeyBytesLenCompressed {
+			return fmt.Errorf("approver %s must be a compressed public key", approver)
+		}
+		if _, err := btcec.ParsePubKey(buf); err != nil {
+			return fmt.Errorf("invalid approver public key %s: %s", approver, err)
+		}
+		if _, ok := approvers[approver]; ok {
+			return fmt.Errorf("duplicated approver %s", approver)
+		}
+		approvers[approver] = struct{}{}
+	}
+	for _, script := range policy.DeniedScripts {
+		if err := validateDestination(script); err != nil {
+			return err
+		}
+	}
+	for _, script := range policy.AllowedScripts {
+		if err := validateDestination(script); err != nil {
+			return err
+		}
+		if containsString(policy.DeniedScripts, script) {
+			return fmt.Errorf(
+				"destination script %s is both allowed and denied", script,
+			)
+		}
+	}
+	return nil
+}
+
+func validateDestination(script string) error {
+	buf, err := hex.DecodeString(script)
+	if err != nil || len(buf) <= 0 {
+		return fmt.Errorf("invalid destination script %s", script)
+	}
+	return validateScript(buf)
+}
+
+func containsString(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// getTxFeeAmount returns the amount of the fee outputs of the given tx.
+func getTxFeeAmount(tx *transaction.Transaction) uint64 {
+	feeAmount := uint64(0)
+	for _, out := range tx.Outputs {
+		if len(out.Script) <= 0 {
+			value, _ := elementsutil.ValueFromBytes(out.Value)
+			feeAmount += value
+		}
+	}
+	return feeAmount
+}
+
+// signalsReplaceability returns whether the given tx opts in to be replaced
+// (BIP-125), that's if any of its inputs has a low enough sequence.
+func signalsReplaceability(tx *transaction.Transaction) bool {
+	for _, in := range tx.Inputs {
+		if in.Sequence <= wallet.RBFSequence {
+			return true
+		}
+	}
+	return false
+}
+
 func utxoKeysFromRawTx(txHex string) ([]domain.UtxoKey, error) {
 	tx, err := transaction.NewTxFromHex(txHex)
 	if err != nil {
@@ -1138,6 +4903,47 @@ func roundUpAmount(amount uint64) uint64 {
 	return uint64(roundedAmount)
 }
 
+// selectTransferUtxos selects the utxos of the given asset to cover the
+// target amount with the given coin selector or, if some utxos are chosen by
+// the caller, returns those of the given asset. If these are not enough and
+// it's allowed, the coin selector is used to cover the remaining amount with
+// the other utxos.
+func selectTransferUtxos(
+	coinSelector ports.CoinSelector, utxos, chosenUtxos []*domain.Utxo,
+	allowExtraInputs bool, targetAmount uint64, targetAsset string,
+) ([]*domain.Utxo, uint64, error) {
+	if len(chosenUtxos) <= 0 {
+		return coinSelector.SelectUtxos(utxos, targetAmount, targetAsset)
+	}
+
+	selectedUtxos := make([]*domain.Utxo, 0)
+	amount := uint64(0)
+	for _, u := range chosenUtxos {
+		if u.Asset == targetAsset {
+			selectedUtxos = append(selectedUtxos, u)
+			amount += u.Value
+		}
+	}
+	if amount >= targetAmount {
+		return selectedUtxos, amount - targetAmount, nil
+	}
+	if !allowExtraInputs {
+		return nil, 0, fmt.Errorf(
+			"%w: chosen inputs don't cover target amount %d of asset %s",
+			ErrExtraInputsNotAllowed, targetAmount, targetAsset,
+		)
+	}
+
+	remainingUtxos := getRemainingUtxos(utxos, chosenUtxos)
+	extraUtxos, change, err := coinSelector.SelectUtxos(
+		remainingUtxos, targetAmount-amount, targetAsset,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return append(selectedUtxos, extraUtxos...), change, nil
+}
+
 func getRemainingUtxos(utxos, selectedUtxos []*domain.Utxo) []*domain.Utxo {
 	remainingUtxos := make([]*domain.Utxo, 0)
 	for _, u := range utxos {
@@ -1155,3 +4961,375 @@ func getRemainingUtxos(utxos, selectedUtxos []*domain.Utxo) []*domain.Utxo {
 	}
 	return remainingUtxos
 }
+
+// fragmentBalance returns the amounts of the fragments the given balance is
+// split into. See FragmentUtxos for details.
+func fragmentBalance(
+	balance uint64, numFragments uint32, fragmentAmount uint64,
+) ([]uint64, error) {
+	if fragmentAmount > 0 && numFragments == 0 {
+		num := balance / fragmentAmount
+		if num == 0 {
+			return nil, ErrBalanceTooLowToFragment
+		}
+		if num > uint64(MaxFragments) {
+			num = uint64(MaxFragments)
+		}
+		numFragments = uint32(num)
+	}
+	if numFragments == 0 || numFragments > MaxFragments {
+		return nil, ErrInvalidNumOfFragments
+	}
+
+	amounts := make([]uint64, 0, numFragments)
+	if fragmentAmount > 0 {
+		if fragmentAmount > balance/uint64(numFragments) {
+			return nil, ErrBalanceTooLowToFragment
+		}
+		for i := uint32(0); i < numFragments; i++ {
+			amounts = append(amounts, fragmentAmount)
+		}
+		return amounts, nil
+	}
+
+	remaining := balance
+	for i := uint32(0); i < numFragments-1; i++ {
+		amount := remaining / 2
+		amounts = append(amounts, amount)
+		remaining -= amount
+	}
+	amounts = append(amounts, remaining)
+	for _, amount := range amounts {
+		if amount == 0 {
+			return nil, ErrBalanceTooLowToFragment
+		}
+	}
+	return amounts, nil
+}
+
+// parseSwapOffer returns the terms of the swap offered with the given partial
+// transaction, from the point of view of the party that created it.
+// The amounts of confidential inputs are revealed with blind proofs that are
+// verified against the commitments of the prevouts.
+func parseSwapOffer(ptx *psetv2.Pset) (*domain.Swap, error) {
+	if len(ptx.Inputs) <= 0 || len(ptx.Outputs) <= 0 {
+		return nil, ErrInvalidSwapOffer
+	}
+
+	amountByAsset := make(map[string]int64)
+	for i := range ptx.Inputs {
+		asset, amount, err := getSwapInputAmount(&ptx.Inputs[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid swap offer input %d: %s", i, err)
+		}
+		amountByAsset[asset] += int64(amount)
+	}
+	for _, out := range ptx.Outputs {
+		if out.IsPartiallyBlinded() {
+			return nil, fmt.Errorf("swap offer outputs must not be blinded")
+		}
+		amountByAsset[elementsutil.TxIDFromBytes(out.Asset)] -= int64(out.Value)
+	}
+
+	swap := &domain.Swap{}
+	for asset, amount := range amountByAsset {
+		if amount > 0 {
+			if swap.GiveAsset != "" {
+				return nil, ErrInvalidSwapOffer
+			}
+			swap.GiveAsset, swap.GiveAmount = asset, uint64(amount)
+		}
+		if amount < 0 {
+			if swap.WantAsset != "" {
+				return nil, ErrInvalidSwapOffer
+			}
+			swap.WantAsset, swap.WantAmount = asset, uint64(-amount)
+		}
+	}
+	if swap.GiveAsset == "" || swap.WantAsset == "" {
+		return nil, ErrInvalidSwapOffer
+	}
+	return swap, nil
+}
+
+// getSwapInputAmount returns the asset and amount of the given input, either
+// explicit in the prevout or revealed with blind proofs.
+func getSwapInputAmount(in *psetv2.Input) (string, uint64, error) {
+	prevout := in.GetUtxo()
+	if prevout == nil {
+		return "", 0, fmt.Errorf("missing prevout")
+	}
+	if !prevout.IsConfidential() {
+		value, err := elementsutil.ValueFromBytes(prevout.Value)
+		if err != nil {
+			return "", 0, err
+		}
+		return elementsutil.AssetHashFromBytes(prevout.Asset), value, nil
+	}
+
+	if in.ExplicitValue == 0 || len(in.ExplicitAsset) <= 0 {
+		return "", 0, fmt.Errorf("missing explicit asset and amount")
+	}
+	if !confidential.VerifyBlindValueProof(
+		in.ExplicitValue, prevout.Value, prevout.Asset, in.ValueProof,
+	) {
+		return "", 0, fmt.Errorf("invalid blind value proof")
+	}
+	if !confidential.VerifyBlindAssetProof(
+		in.ExplicitAsset, prevout.Asset, in.AssetProof,
+	) {
+		return "", 0, fmt.Errorf("invalid blind asset proof")
+	}
+	return elementsutil.TxIDFromBytes(in.ExplicitAsset), in.ExplicitValue, nil
+}
+
+// partialTxInsAndOuts returns the inputs and outputs of the given partial
+// transaction with just the info required to estimate the tx fees.
+func partialTxInsAndOuts(ptx *psetv2.Pset) ([]wallet.Input, []wallet.Output) {
+	inputs := make([]wallet.Input, 0, len(ptx.Inputs))
+	for i := range ptx.Inputs {
+		inputs = append(inputs, wallet.Input{
+			Script: ptx.Inputs[i].GetUtxo().Script,
+		})
+	}
+	outputs := make([]wallet.Output, 0, len(ptx.Outputs))
+	for _, out := range ptx.Outputs {
+		outputs = append(outputs, wallet.Output{
+			Script:      out.Script,
+			BlindingKey: out.BlindingPubkey,
+		})
+	}
+	return inputs, outputs
+}
+
+// revealSwapInputs returns the inputs of the given partial transaction
+// revealed by the counterparty of a swap, making sure they match the prevouts.
+func revealInputs(
+	ptx *psetv2.Pset, ins []UnblindedInput,
+) (map[uint32]wallet.Input, error) {
+	inputs := make(map[uint32]wallet.Input)
+	for _, in := range ins {
+		if int(in.Index) >= len(ptx.Inputs) {
+			return nil, fmt.Errorf("unblinded input index %d out of range", in.Index)
+		}
+		psetIn := &ptx.Inputs[in.Index]
+		asset, amount, err := getSwapInputAmount(psetIn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input %d: %s", in.Index, err)
+		}
+		if asset != in.Asset || amount != in.Amount {
+			return nil, fmt.Errorf(
+				"unblinded input %d doesn't match its prevout", in.Index,
+			)
+		}
+
+		prevout := psetIn.GetUtxo()
+		// Blinders are serialized as transaction ids.
+		assetBlinder, _ := elementsutil.TxIDToBytes(in.AssetBlinder)
+		valueBlinder, _ := elementsutil.TxIDToBytes(in.AmountBlinder)
+		var valueCommitment, assetCommitment, nonce []byte
+		if prevout.IsConfidential() {
+			assetBytes, _ := elementsutil.TxIDToBytes(asset)
+			assetCommitment, _ = confidential.AssetCommitment(
+				assetBytes, assetBlinder,
+			)
+			valueCommitment, _ = confidential.ValueCommitment(
+				amount, assetCommitment, valueBlinder,
+			)
+			if !bytes.Equal(assetCommitment, prevout.Asset) ||
+				!bytes.Equal(valueCommitment, prevout.Value) {
+				return nil, fmt.Errorf(
+					"unblinded input %d doesn't match its prevout", in.Index,
+				)
+			}
+			nonce = prevout.Nonce
+		}
+		inputs[in.Index] = wallet.Input{
+			TxID:            elementsutil.TxIDFromBytes(psetIn.PreviousTxid),
+			TxIndex:         psetIn.PreviousTxIndex,
+			Value:           amount,
+			Asset:           asset,
+			Script:          prevout.Script,
+			ValueBlinder:    valueBlinder,
+			AssetBlinder:    assetBlinder,
+			ValueCommitment: valueCommitment,
+			AssetCommitment: assetCommitment,
+			Nonce:           nonce,
+			RangeProof:      psetIn.UtxoRangeProof,
+		}
+	}
+	return inputs, nil
+}
+
+// newUnblindedInput reveals the given utxo spent by the input at the given
+// index. Unconfidential utxos are revealed with zero blinders.
+func newUnblindedInput(u *domain.Utxo, index uint32) UnblindedInput {
+	valueBlinder, assetBlinder := u.ValueBlinder, u.AssetBlinder
+	if len(assetBlinder) <= 0 {
+		valueBlinder, assetBlinder = make([]byte, 32), make([]byte, 32)
+	}
+	// Blinders are serialized as transaction ids.
+	return UnblindedInput{
+		Index:         index,
+		Amount:        u.Value,
+		Asset:         u.Asset,
+		AmountBlinder: elementsutil.TxIDFromBytes(valueBlinder),
+		AssetBlinder:  elementsutil.TxIDFromBytes(assetBlinder),
+	}
+}
+
+// mergeFragments verifies the given fragments and merges them into a partial
+// transaction where the input and output of each fragment share the same
+// index. The revealed inputs and the asset blinders of the blinded outputs
+// are returned by index. The latter are also accounted in the scalars of the
+// partial transaction so that the last blinder can balance them.
+func mergeFragments(fragments []Fragment) (
+	*psetv2.Pset, map[uint32]wallet.Input, map[uint32][]byte, error,
+) {
+	var ptx *psetv2.Pset
+	inputsByIndex := make(map[uint32]wallet.Input)
+	outAssetBlinders := make(map[uint32][]byte)
+	for i, f := range fragments {
+		fragment, input, assetBlinder, valueBlinder, err := parseFragment(f)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid fragment %d: %s", i, err)
+		}
+
+		if ptx == nil {
+			ptx = fragment
+			ptx.Global.Scalars = make([][]byte, 0)
+		} else {
+			if fragment.Locktime() != ptx.Locktime() {
+				return nil, nil, nil, fmt.Errorf(
+					"invalid fragment %d: locktime mismatch", i,
+				)
+			}
+			in := fragment.Inputs[0]
+			for _, prevIn := range ptx.Inputs {
+				if bytes.Equal(prevIn.PreviousTxid, in.PreviousTxid) &&
+					prevIn.PreviousTxIndex == in.PreviousTxIndex {
+					return nil, nil, nil, fmt.Errorf(
+						"invalid fragment %d: duplicated input", i,
+					)
+				}
+			}
+			ptx.Inputs = append(ptx.Inputs, in)
+			ptx.Outputs = append(ptx.Outputs, fragment.Outputs[0])
+			ptx.Global.InputCount++
+			ptx.Global.OutputCount++
+		}
+
+		inputsByIndex[uint32(i)] = input
+		if len(assetBlinder) > 0 {
+			out := fragment.Outputs[0]
+			scalar, err := confidential.ComputeAndAddToScalarOffset(
+				nil, out.Value, assetBlinder, valueBlinder,
+			)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf(
+					"invalid fragment %d: %s", i, err,
+				)
+			}
+			ptx.Global.Scalars = append(ptx.Global.Scalars, scalar)
+			outAssetBlinders[uint32(i)] = assetBlinder
+		}
+	}
+	return ptx, inputsByIndex, outAssetBlinders, nil
+}
+
+// parseFragment verifies the given fragment and returns its partial
+// transaction, the revealed input and, if blinded, the output blinders.
+func parseFragment(f Fragment) (
+	*psetv2.Pset, wallet.Input, []byte, []byte, error,
+) {
+	ptx, err := psetv2.NewPsetFromBase64(f.Pset)
+	if err != nil {
+		return nil, wallet.Input{}, nil, nil, fmt.Errorf(
+			"invalid partial transaction: %s", err,
+		)
+	}
+	if len(ptx.Inputs) != 1 || len(ptx.Outputs) != 1 {
+		return nil, wallet.Input{}, nil, nil, ErrInvalidFragment
+	}
+	in := ptx.Inputs[0]
+	if in.SigHashType != fragmentSighashType || len(in.PartialSigs) != 1 {
+		return nil, wallet.Input{}, nil, nil, ErrInvalidFragment
+	}
+	sig := in.PartialSigs[0].Signature
+	if txscript.SigHashType(sig[len(sig)-1]) != fragmentSighashType {
+		return nil, wallet.Input{}, nil, nil, ErrInvalidFragment
+	}
+	if valid, err := ptx.ValidateInputSignatures(0); err != nil || !valid {
+		return nil, wallet.Input{}, nil, nil, fmt.Errorf("invalid input signature")
+	}
+
+	unblindedInput := f.UnblindedInput
+	unblindedInput.Index = 0
+	inputs, err := revealInputs(ptx, []UnblindedInput{unblindedInput})
+	if err != nil {
+		return nil, wallet.Input{}, nil, nil, err
+	}
+
+	out := ptx.Outputs[0]
+	if !out.IsFullyBlinded() {
+		return ptx, inputs[0], nil, nil, nil
+	}
+
+	// Blinders are serialized as transaction ids.
+	assetBlinder, err := elementsutil.TxIDToBytes(f.OutputAssetBlinder)
+	if err != nil || len(assetBlinder) != 32 {
+		return nil, wallet.Input{}, nil, nil, fmt.Errorf(
+			"invalid output asset blinder",
+		)
+	}
+	valueBlinder, err := elementsutil.TxIDToBytes(f.OutputAmountBlinder)
+	if err != nil || len(valueBlinder) != 32 {
+		return nil, wallet.Input{}, nil, nil, fmt.Errorf(
+			"invalid output amount blinder",
+		)
+	}
+	assetCommitment, _ := confidential.AssetCommitment(out.Asset, assetBlinder)
+	valueCommitment, _ := confidential.ValueCommitment(
+		out.Value, assetCommitment, valueBlinder,
+	)
+	if !bytes.Equal(assetCommitment, out.AssetCommitment) ||
+		!bytes.Equal(valueCommitment, out.ValueCommitment) ||
+		!confidential.VerifyRangeProof(
+			out.ValueCommitment, out.AssetCommitment, out.Script,
+			out.ValueRangeproof,
+		) {
+		return nil, wallet.Input{}, nil, nil, fmt.Errorf(
+			"output doesn't match its revealed blinders",
+		)
+	}
+	return ptx, inputs[0], assetBlinder, valueBlinder, nil
+}
+
+// revealOwnedOutput returns the asset and amount of the given output owned by
+// the wallet, unblinding it with its blinding key if confidential. Empty
+// values are returned if it can't be unblinded.
+func revealOwnedOutput(
+	w *singlesig.Wallet, out *transaction.TxOutput,
+) (string, uint64) {
+	if !out.IsConfidential() {
+		value, err := elementsutil.ValueFromBytes(out.Value)
+		if err != nil {
+			return "", 0
+		}
+		return elementsutil.AssetHashFromBytes(out.Asset), value
+	}
+
+	blindingKey, _, err := w.DeriveBlindingKeyPair(
+		singlesig.DeriveBlindingKeyPairArgs{Script: out.Script},
+	)
+	if err != nil {
+		return "", 0
+	}
+	revealed, err := confidential.UnblindOutputWithKey(
+		out, blindingKey.Serialize(),
+	)
+	if err != nil {
+		return "", 0
+	}
+	return elementsutil.TxIDFromBytes(revealed.Asset), revealed.Value
+}
@@ -1,13 +1,29 @@
 package application_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-bip32"
 	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/confidential"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/taproot"
+	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/internal/core/application"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
@@ -34,7 +50,89 @@ var (
 func TestTransactionService(t *testing.T) {
 	testInternalTransaction(t)
 
+	testTransferOtherAsset(t)
+
+	testTransferPreview(t)
+
+	testFeeBumping(t)
+
+	testTxAcceleration(t)
+
+	testFundsMovement(t)
+
+	testSwap(t)
+	testFragments(t)
+
+	testFeeRateEstimation(t)
+
 	testExternalTransaction(t)
+
+	testPsetDecoding(t)
+
+	testUtxoLeases(t)
+
+	testUtxoFragmentation(t)
+
+	testUtxoConsolidation(t)
+
+	testPaymentBatching(t)
+
+	testSpendingPolicies(t)
+	testConcurrentSpendings(t)
+
+	testFeeGuard(t)
+}
+
+func testUtxoLeases(t *testing.T) {
+	t.Run("lease_utxos", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		selectedUtxos, _, lease, err := svc.SelectUtxos(
+			ctx, accountName, regtest.AssetID, 1000000, coinSelectionStrategy, nil,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		require.NotEmpty(t, lease.AccountName)
+		require.ElementsMatch(t, selectedUtxos.Keys(), lease.Utxos)
+
+		leases, err := svc.ListLeases(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, leases, 1)
+		require.Equal(t, lease.ID, leases[0].ID)
+
+		extendedLease, err := svc.ExtendLease(ctx, lease.ID, 2*utxoExpiryDuration)
+		require.NoError(t, err)
+		require.Greater(t, extendedLease.ExpiryTimestamp, lease.ExpiryTimestamp)
+
+		_, err = svc.ExtendLease(ctx, randomHex(16), 0)
+		require.ErrorIs(t, err, application.ErrLeaseNotFound)
+
+		_, err = svc.UnlockUtxos(ctx, "", nil)
+		require.ErrorIs(t, err, application.ErrMissingLeaseID)
+
+		ins := make(application.Inputs, 0, len(selectedUtxos))
+		for _, u := range selectedUtxos {
+			ins = append(ins, application.Input{TxID: u.TxID, VOut: u.VOut})
+		}
+		_, err = svc.UnlockUtxos(ctx, randomHex(16), ins)
+		require.ErrorIs(t, err, domain.ErrUtxoLeaseMismatch)
+
+		count, err := svc.UnlockUtxos(ctx, lease.ID, nil)
+		require.NoError(t, err)
+		require.Equal(t, len(selectedUtxos), count)
+
+		leases, err = svc.ListLeases(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, leases)
+	})
 }
 
 func testExternalTransaction(t *testing.T) {
@@ -47,15 +145,18 @@ func testExternalTransaction(t *testing.T) {
 		require.NotNil(t, repoManager)
 
 		svc := application.NewTransactionService(
-			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
 		)
 
-		selectedUtxos, change, expirationDate, err := svc.SelectUtxos(
-			ctx, accountName, regtest.AssetID, 1000000, coinSelectionStrategy,
+		selectedUtxos, change, lease, err := svc.SelectUtxos(
+			ctx, accountName, regtest.AssetID, 1000000, coinSelectionStrategy, nil,
 		)
 		require.NoError(t, err)
 		require.NotEmpty(t, selectedUtxos)
-		require.NotEmpty(t, expirationDate)
+		require.NotNil(t, lease)
+		require.NotEmpty(t, lease.ID)
+		require.NotEmpty(t, lease.ExpiryTimestamp)
 
 		inputs := make(application.Inputs, 0, len(selectedUtxos))
 		for _, u := range selectedUtxos {
@@ -96,7 +197,7 @@ func testExternalTransaction(t *testing.T) {
 			Amount: feeAmount,
 		})
 
-		newPset, err := svc.CreatePset(ctx, inputs, outputs)
+		newPset, err := svc.CreatePset(ctx, inputs, outputs, 0)
 		require.NoError(t, err)
 		require.NotEmpty(t, newPset)
 
@@ -104,7 +205,7 @@ func testExternalTransaction(t *testing.T) {
 		require.NoError(t, err)
 		require.NotEmpty(t, blindedPset)
 
-		signedPset, err := svc.SignPset(ctx, blindedPset, 0)
+		signedPset, err := svc.SignPset(ctx, blindedPset, 0, false)
 		require.NoError(t, err)
 
 		txHex, _, err := wallet.FinalizeAndExtractTransaction(wallet.FinalizeAndExtractTransactionArgs{
@@ -119,68 +220,2392 @@ func testExternalTransaction(t *testing.T) {
 	})
 }
 
+func testPsetDecoding(t *testing.T) {
+	t.Run("decode_pset", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		// The sender pays the receiver, another account of the wallet, and an
+		// external address.
+		var sender, receiver *domain.Account
+		err = repoManager.WalletRepository().UpdateWallet(
+			ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+				sender, err = w.CreateAccount("sender", 0, false)
+				if err != nil {
+					return nil, err
+				}
+				receiver, err = w.CreateAccount("receiver", 0, false)
+				return w, err
+			},
+		)
+		require.NoError(t, err)
+
+		senderAddrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, sender.Namespace, 2)
+		require.NoError(t, err)
+		receiverAccountAddrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, receiver.Namespace, 1)
+		require.NoError(t, err)
+		utxo := randomConfidentialUtxo(
+			sender.Namespace, senderAddrInfo[0].Address, regtest.AssetID, 1000000,
+		)
+		_, err = repoManager.UtxoRepository().AddUtxos(
+			ctx, []*domain.Utxo{utxo},
+		)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		receiverAccountAddr, _ := address.FromConfidential(
+			receiverAccountAddrInfo[0].Address,
+		)
+		changeAddr, _ := address.FromConfidential(senderAddrInfo[1].Address)
+		inputs := application.Inputs{{TxID: utxo.TxID, VOut: utxo.VOut}}
+		_, err = svc.LockUtxos(ctx, sender.Namespace, inputs)
+		require.NoError(t, err)
+
+		outs := application.Outputs{
+			{
+				Asset:       regtest.AssetID,
+				Amount:      300000,
+				Script:      receiverAccountAddr.Script,
+				BlindingKey: receiverAccountAddr.BlindingKey,
+			},
+			{
+				Asset:       regtest.AssetID,
+				Amount:      200000,
+				Script:      receiverAddrInfo.Script,
+				BlindingKey: receiverAddrInfo.BlindingKey,
+			},
+			{
+				Asset:       regtest.AssetID,
+				Amount:      499500,
+				Script:      changeAddr.Script,
+				BlindingKey: changeAddr.BlindingKey,
+			},
+			{
+				Asset:  regtest.AssetID,
+				Amount: 500,
+			},
+		}
+
+		newPset, err := svc.CreatePset(ctx, inputs, outs, 0)
+		require.NoError(t, err)
+
+		decodedPset, err := svc.DecodePset(ctx, newPset)
+		require.NoError(t, err)
+		require.NotNil(t, decodedPset)
+		require.False(t, decodedPset.BlindingComplete)
+		require.Len(t, decodedPset.Inputs, 1)
+		require.Len(t, decodedPset.Outputs, len(outs))
+		require.False(t, decodedPset.Inputs[0].Signed)
+
+		blindedPset, err := svc.BlindPset(ctx, newPset, nil, true)
+		require.NoError(t, err)
+		signedPset, err := svc.SignPset(ctx, blindedPset, 0, false)
+		require.NoError(t, err)
+
+		decodedPset, err = svc.DecodePset(ctx, signedPset)
+		require.NoError(t, err)
+		require.NotNil(t, decodedPset)
+		require.True(t, decodedPset.BlindingComplete)
+		require.Equal(t, 500, int(decodedPset.FeeAmount))
+
+		in := decodedPset.Inputs[0]
+		require.Equal(t, utxo.TxID, in.TxID)
+		require.Equal(t, sender.Namespace, in.AccountName)
+		require.Equal(t, regtest.AssetID, in.Asset)
+		require.Equal(t, 1000000, int(in.Amount))
+		require.True(t, in.Confidential)
+		require.True(t, in.Signed)
+		require.False(t, in.Finalized)
+
+		expectedAccounts := []string{
+			receiver.Namespace, "", sender.Namespace, "",
+		}
+		for i, out := range decodedPset.Outputs {
+			require.Equal(t, expectedAccounts[i], out.AccountName)
+			if out.AccountName != "" {
+				require.True(t, out.Blinded)
+				require.Equal(t, regtest.AssetID, out.Asset)
+				require.Equal(t, int(outs[i].Amount), int(out.Amount))
+			}
+		}
+		require.False(t, decodedPset.Outputs[3].Confidential)
+
+		require.Equal(t, map[string]map[string]int64{
+			sender.Namespace:   {regtest.AssetID: -500500},
+			receiver.Namespace: {regtest.AssetID: 300000},
+		}, decodedPset.NetAmountByAccount)
+	})
+}
+
 func testInternalTransaction(t *testing.T) {
 	t.Run("craft_transaction_internally", func(t *testing.T) {
 		mockedBcScanner := newMockedBcScanner()
 		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		mockedBcScanner.On("EstimateFeeRate", mock.Anything).Return(uint64(110), nil)
 		repoManager, err := newRepoManagerForTxService()
 		require.NoError(t, err)
 		require.NotNil(t, repoManager)
 
 		svc := application.NewTransactionService(
-			repoManager, mockedBcScanner, regtest, utxoExpiryDuration, dustAmount,
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
 		)
 
-		txid, err := svc.Transfer(ctx, accountName, outputs, 0)
+		txid, err := svc.Transfer(
+			ctx, accountName, outputs, 0, nil, application.TransferOptions{},
+		)
 		require.NoError(t, err)
 		require.NotEmpty(t, txid)
 	})
+
+	t.Run("craft_transaction_with_chosen_inputs", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		utxoRepo := repoManager.UtxoRepository()
+		utxos, err := utxoRepo.GetSpendableUtxosForAccount(ctx, accountNamespace)
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+
+		chosenUtxo, otherUtxo := utxos[0], utxos[1]
+		ins := application.Inputs{{TxID: chosenUtxo.TxID, VOut: chosenUtxo.VOut}}
+
+		// Chosen utxos must cover the target amount if extra ones are not allowed.
+		bigOutputs := []application.Output{outputs[0]}
+		bigOutputs[0].Amount = 150000000
+		txHex, err := svc.Transfer(
+			ctx, accountName, bigOutputs, 100, nil,
+			application.TransferOptions{Inputs: ins},
+		)
+		require.ErrorIs(t, err, application.ErrExtraInputsNotAllowed)
+		require.Empty(t, txHex)
+
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		// Only the chosen utxo is spent.
+		utxos, err = utxoRepo.GetUtxosByKey(
+			ctx, []domain.UtxoKey{chosenUtxo.Key(), otherUtxo.Key()},
+		)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.Equal(t, u.Key() == chosenUtxo.Key(), u.IsLocked())
+		}
+
+		// Chosen utxos can't be locked.
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins},
+		)
+		require.ErrorIs(t, err, domain.ErrUtxoAlreadyLocked)
+		require.Empty(t, txHex)
+
+		// Chosen utxos must be owned by the account.
+		ins = application.Inputs{{TxID: randomHex(32), VOut: 0}}
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins, AllowExtraInputs: true},
+		)
+		require.Error(t, err)
+		require.Empty(t, txHex)
+	})
+
+	t.Run("craft_transaction_with_timelocks", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		utxos, err := repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+			ctx, accountNamespace,
+		)
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+
+		lockTime := uint32(1000)
+		relativeLock := uint32(10)
+		invalidSequence := uint32(1 << 23)
+		ins := application.Inputs{
+			{
+				TxID:     utxos[0].TxID,
+				VOut:     utxos[0].VOut,
+				Sequence: &invalidSequence,
+			},
+		}
+
+		// Relative timelocks must be expressed with the allowed bits only.
+		txHex, err := svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins, LockTime: lockTime},
+		)
+		require.ErrorContains(t, err, wallet.ErrInputInvalidSequence.Error())
+		require.Empty(t, txHex)
+
+		// An explicit zero sequence is refused rather than made final.
+		zeroSequence := uint32(0)
+		ins[0].Sequence = &zeroSequence
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins, LockTime: lockTime},
+		)
+		require.ErrorContains(t, err, wallet.ErrInputZeroSequence.Error())
+		require.Empty(t, txHex)
+
+		// The locktime and sequences are preserved through blinding and signing.
+		ins[0].Sequence = &relativeLock
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins, LockTime: lockTime},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Equal(t, lockTime, tx.Locktime)
+		require.Len(t, tx.Inputs, 1)
+		require.Equal(t, relativeLock, tx.Inputs[0].Sequence)
+	})
+
+	t.Run("craft_transaction_subtracting_fee", func(t *testing.T) {
+		unconfOutput := application.Output{
+			Asset:  regtest.AssetID,
+			Script: receiverAddrInfo.Script,
+		}
+		tests := []struct {
+			name            string
+			amount          uint64
+			sendAll         bool
+			expectedAmount  uint64
+			expectedNumOuts int
+		}{
+			{
+				name:            "subtract_fee",
+				amount:          50000000,
+				expectedAmount:  50000000,
+				expectedNumOuts: 3, // receiver + change + fee
+			},
+			{
+				name:            "send_all",
+				sendAll:         true,
+				expectedAmount:  200000000,
+				expectedNumOuts: 2, // receiver + fee
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockedBcScanner := newMockedBcScanner()
+				repoManager, err := newRepoManagerForTxService()
+				require.NoError(t, err)
+				require.NotNil(t, repoManager)
+
+				svc := application.NewTransactionService(
+					repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+					dustAmount, 0, false,
+				)
+
+				output := unconfOutput
+				output.Amount = tt.amount
+				txHex, err := svc.Transfer(
+					ctx, accountName, []application.Output{output}, 100, nil,
+					application.TransferOptions{
+						SendAll:     tt.sendAll,
+						SubtractFee: !tt.sendAll,
+					},
+				)
+				require.NoError(t, err)
+				require.NotEmpty(t, txHex)
+
+				tx, err := transaction.NewTxFromHex(txHex)
+				require.NoError(t, err)
+				require.Len(t, tx.Outputs, tt.expectedNumOuts)
+
+				receiverAmount, err := elementsutil.ValueFromBytes(tx.Outputs[0].Value)
+				require.NoError(t, err)
+				feeOut := tx.Outputs[len(tx.Outputs)-1]
+				require.Empty(t, feeOut.Script)
+				feeAmount, err := elementsutil.ValueFromBytes(feeOut.Value)
+				require.NoError(t, err)
+				require.NotZero(t, feeAmount)
+				require.Equal(t, tt.expectedAmount, receiverAmount+feeAmount)
+			})
+		}
+	})
+
+	t.Run("craft_transaction_sending_all_other_asset", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		asset := randomHex(32)
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		utxos := []*domain.Utxo{
+			randomUtxo(accountNamespace, addrInfo[0].Address),
+			randomUtxo(accountNamespace, addrInfo[0].Address),
+		}
+		for _, u := range utxos {
+			u.Asset = asset
+			u.Value = 1000
+		}
+		_, err = repoManager.UtxoRepository().AddUtxos(ctx, utxos)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		output := application.Output{
+			Asset:  asset,
+			Script: receiverAddrInfo.Script,
+		}
+		txHex, err := svc.Transfer(
+			ctx, accountName, []application.Output{output}, 100, nil,
+			application.TransferOptions{SendAll: true},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		// The asset is swept in full while the fees are paid with lbtc, whose
+		// remaining amount goes to a change output.
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Len(t, tx.Inputs, 3)
+		require.Len(t, tx.Outputs, 3) // receiver + lbtc change + fee
+
+		receiverAmount, err := elementsutil.ValueFromBytes(tx.Outputs[0].Value)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2000), receiverAmount)
+		require.NotEmpty(t, tx.Outputs[1].Script)
+		feeOut := tx.Outputs[2]
+		require.Empty(t, feeOut.Script)
+		feeAmount, err := elementsutil.ValueFromBytes(feeOut.Value)
+		require.NoError(t, err)
+		require.NotZero(t, feeAmount)
+	})
 }
 
-func newRepoManagerForTxService() (ports.RepoManager, error) {
-	rm, err := dbbadger.NewRepoManager("", nil)
-	if err != nil {
-		return nil, err
-	}
+func testFeeRateEstimation(t *testing.T) {
+	t.Run("estimate_fee_rate", func(t *testing.T) {
+		tests := []struct {
+			name               string
+			confTarget         uint32
+			estimatedFeeRate   uint64
+			expectedConfTarget uint32
+			expectedFeeRate    uint64
+		}{
+			{
+				name:               "with_conf_target",
+				confTarget:         6,
+				estimatedFeeRate:   250,
+				expectedConfTarget: 6,
+				expectedFeeRate:    250,
+			},
+			{
+				name:               "default_conf_target",
+				estimatedFeeRate:   250,
+				expectedConfTarget: application.DefaultConfTarget,
+				expectedFeeRate:    250,
+			},
+			{
+				name:               "below_min_fee_rate",
+				confTarget:         6,
+				estimatedFeeRate:   0,
+				expectedConfTarget: 6,
+				expectedFeeRate:    application.MinMillisatsPerByte,
+			},
+		}
 
-	wallet, err := domain.NewWallet(
-		mnemonic, password, rootPath, regtest.Name, birthdayBlockHeight, nil,
-	)
-	if err != nil {
-		return nil, err
-	}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockedBcScanner := newMockedBcScanner()
+				mockedBcScanner.On("EstimateFeeRate", tt.expectedConfTarget).
+					Return(tt.estimatedFeeRate, nil)
+				repoManager, err := newRepoManagerForTxService()
+				require.NoError(t, err)
+				require.NotNil(t, repoManager)
 
-	if err := rm.WalletRepository().CreateWallet(ctx, wallet); err != nil {
-		return nil, err
-	}
+				svc := application.NewTransactionService(
+					repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+					dustAmount, 0, false,
+				)
 
-	if err := rm.WalletRepository().UpdateWallet(
-		ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
-			w.Unlock(password)
-			w.CreateAccount(accountName, 0, false)
-			return w, nil
-		},
-	); err != nil {
-		return nil, err
-	}
+				feeRate, err := svc.EstimateFeeRate(ctx, tt.confTarget)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedFeeRate, feeRate)
+			})
+		}
+	})
+}
 
-	addrInfo, err := rm.WalletRepository().DeriveNextExternalAddressesForAccount(ctx, accountName, 2)
-	if err != nil {
-		return nil, err
-	}
+func testTransferOtherAsset(t *testing.T) {
+	t.Run("transfer_other_asset", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
 
-	addresses := application.AddressesInfo(addrInfo).Addresses()
-	utxos := make([]*domain.Utxo, 0, len(addresses))
-	for _, addr := range addresses {
-		utxo := randomUtxo(accountNamespace, addr)
-		utxo.Value = 100000000
-		utxo.Asset = regtest.AssetID
-		utxos = append(utxos, utxo)
-	}
+		asset := randomHex(32)
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		utxo := randomUtxo(accountNamespace, addrInfo[0].Address)
+		utxo.Value = 1000
+		utxo.Asset = asset
+		_, err = repoManager.UtxoRepository().AddUtxos(ctx, []*domain.Utxo{utxo})
+		require.NoError(t, err)
 
-	if _, err := rm.UtxoRepository().AddUtxos(ctx, utxos); err != nil {
-		return nil, err
-	}
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
 
-	return rm, nil
+		assetOutputs := []application.Output{
+			{
+				Asset:       asset,
+				Amount:      utxo.Value,
+				Script:      receiverAddrInfo.Script,
+				BlindingKey: receiverAddrInfo.BlindingKey,
+			},
+		}
+
+		// Fees are paid with lbtc utxos, even if no lbtc is sent.
+		txHex, err := svc.Transfer(ctx, accountName, assetOutputs, 100, nil, application.TransferOptions{})
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Len(t, tx.Inputs, 2)
+
+		// Without lbtc to pay for fees, the transfer must fail.
+		lbtcUtxos, err := repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+			ctx, accountNamespace,
+		)
+		require.NoError(t, err)
+		_, err = repoManager.UtxoRepository().SpendUtxos(
+			ctx, application.Utxos(lbtcUtxos).Keys(), randomHex(32),
+		)
+		require.NoError(t, err)
+
+		utxo = randomUtxo(accountNamespace, addrInfo[0].Address)
+		utxo.Value = 1000
+		utxo.Asset = asset
+		_, err = repoManager.UtxoRepository().AddUtxos(ctx, []*domain.Utxo{utxo})
+		require.NoError(t, err)
+
+		txHex, err = svc.Transfer(ctx, accountName, assetOutputs, 100, nil, application.TransferOptions{})
+		require.Error(t, err)
+		require.Empty(t, txHex)
+	})
+}
+
+func testTransferPreview(t *testing.T) {
+	t.Run("preview_transfer", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		preview, err := svc.PreviewTransfer(
+			ctx, accountName, outputs, 100, nil, application.TransferOptions{}, false,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, preview)
+		require.NotEmpty(t, preview.Pset)
+		require.NotEmpty(t, preview.Inputs)
+		require.NotEmpty(t, preview.ChangeOutputs)
+		require.NotZero(t, preview.FeeAmount)
+		require.Nil(t, preview.Reservation)
+
+		// Neither utxos are locked nor change addresses are derived.
+		utxos, err := repoManager.UtxoRepository().GetSpendableUtxosForAccount(
+			ctx, accountNamespace,
+		)
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+
+		addressesInfo, err := repoManager.WalletRepository().
+			DeriveNextInternalAddressesForAccount(ctx, accountNamespace, 1)
+		require.NoError(t, err)
+		require.Equal(
+			t, hex.EncodeToString(preview.ChangeOutputs[0].Script),
+			addressesInfo[0].Script,
+		)
+	})
+
+	t.Run("preview_transfer_with_discounted_fees", func(t *testing.T) {
+		feeAmounts := make([]uint64, 0, 2)
+		for _, discountCT := range []bool{false, true} {
+			mockedBcScanner := newMockedBcScanner()
+			repoManager, err := newRepoManagerForTxService()
+			require.NoError(t, err)
+			require.NotNil(t, repoManager)
+
+			svc := application.NewTransactionService(
+				repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+				dustAmount, 0, discountCT,
+			)
+
+			preview, err := svc.PreviewTransfer(
+				ctx, accountName, outputs, 100, nil, application.TransferOptions{},
+				false,
+			)
+			require.NoError(t, err)
+			require.NotNil(t, preview)
+			feeAmounts = append(feeAmounts, preview.FeeAmount)
+		}
+		require.Less(t, feeAmounts[1], feeAmounts[0])
+	})
+
+	t.Run("preview_transfer_with_reservation", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		preview, err := svc.PreviewTransfer(
+			ctx, accountName, outputs, 100, nil, application.TransferOptions{}, true,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, preview)
+		require.NotNil(t, preview.Reservation)
+		require.Len(t, preview.Reservation.Utxos, len(preview.Inputs))
+
+		// The reserved utxos can be spent only by referencing the reservation.
+		ins := make(application.Inputs, 0, len(preview.Inputs))
+		for _, u := range preview.Inputs {
+			ins = append(ins, application.Input{TxID: u.TxID, VOut: u.VOut})
+		}
+		txHex, err := svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{Inputs: ins},
+		)
+		require.ErrorIs(t, err, domain.ErrUtxoAlreadyLocked)
+		require.Empty(t, txHex)
+
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{LeaseID: preview.Reservation.ID},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		txHex, err = svc.Transfer(
+			ctx, accountName, outputs, 100, nil,
+			application.TransferOptions{LeaseID: randomHex(32)},
+		)
+		require.ErrorIs(t, err, application.ErrLeaseNotFound)
+		require.Empty(t, txHex)
+	})
+}
+
+func testFeeBumping(t *testing.T) {
+	t.Run("bump_fee", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		mockedBcScanner.On("GetTransactions", mock.Anything).
+			Return(nil, fmt.Errorf("transaction not found"))
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		utxoRepo := repoManager.UtxoRepository()
+		txRepo := repoManager.TransactionRepository()
+		utxos, err := utxoRepo.GetSpendableUtxosForAccount(ctx, accountNamespace)
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+
+		// The chosen utxo leaves a change too small to pay for higher fees.
+		chosenUtxo, otherUtxo := utxos[0], utxos[1]
+		ins := application.Inputs{{TxID: chosenUtxo.TxID, VOut: chosenUtxo.VOut}}
+		bigOutputs := []application.Output{outputs[0]}
+		bigOutputs[0].Amount = chosenUtxo.Value - 2000
+		txHex, err := svc.Transfer(
+			ctx, accountName, bigOutputs, 100, nil,
+			application.TransferOptions{Inputs: ins},
+		)
+		require.NoError(t, err)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		for _, in := range tx.Inputs {
+			require.Equal(t, wallet.RBFSequence, in.Sequence)
+		}
+		txid := tx.TxHash().String()
+		feeAmount := feeAmountFromTx(tx)
+
+		// Unknown txs can't be replaced.
+		newTxid, err := svc.BumpFee(ctx, randomHex(32), 1000)
+		require.Error(t, err)
+		require.Empty(t, newTxid)
+
+		_, err = txRepo.AddTransaction(ctx, &domain.Transaction{
+			TxID:     txid,
+			TxHex:    txHex,
+			Accounts: map[string]struct{}{accountNamespace: {}},
+		})
+		require.NoError(t, err)
+		_, err = utxoRepo.SpendUtxos(ctx, []domain.UtxoKey{chosenUtxo.Key()}, txid)
+		require.NoError(t, err)
+
+		// The fee rate must be higher than the original one.
+		newTxid, err = svc.BumpFee(ctx, txid, 100)
+		require.ErrorIs(t, err, application.ErrFeeRateTooLow)
+		require.Empty(t, newTxid)
+
+		// The higher fees must also pay for the relay of the replacement.
+		newTxid, err = svc.BumpFee(ctx, txid, 150)
+		require.ErrorIs(t, err, application.ErrFeeRateTooLow)
+		require.Empty(t, newTxid)
+
+		newTxid, err = svc.BumpFee(ctx, txid, 10000)
+		require.NoError(t, err)
+		require.NotEmpty(t, newTxid)
+
+		calls := mockedBcScanner.Calls
+		newTxHex := calls[len(calls)-1].Arguments.String(0)
+		newTx, err := transaction.NewTxFromHex(newTxHex)
+		require.NoError(t, err)
+		require.Greater(t, feeAmountFromTx(newTx), feeAmount)
+
+		// The replacement spends the original input plus an extra one.
+		newKeys := make([]domain.UtxoKey, 0, len(newTx.Inputs))
+		for _, in := range newTx.Inputs {
+			require.Equal(t, wallet.RBFSequence, in.Sequence)
+			newKeys = append(newKeys, domain.UtxoKey{
+				TxID: elementsutil.TxIDFromBytes(in.Hash),
+				VOut: in.Index,
+			})
+		}
+		require.ElementsMatch(
+			t, []domain.UtxoKey{chosenUtxo.Key(), otherUtxo.Key()}, newKeys,
+		)
+
+		// The replaced tx can't be replaced again.
+		newTxid, err = svc.BumpFee(ctx, txid, 20000)
+		require.ErrorIs(t, err, application.ErrTxNotReplaceable)
+		require.Empty(t, newTxid)
+
+		// Confirmed txs can't be replaced.
+		newTxid = newTx.TxHash().String()
+		_, err = txRepo.AddTransaction(ctx, &domain.Transaction{
+			TxID:     newTxid,
+			TxHex:    newTxHex,
+			Accounts: map[string]struct{}{accountNamespace: {}},
+		})
+		require.NoError(t, err)
+		_, err = txRepo.ConfirmTransaction(
+			ctx, newTxid, randomHex(32), 100, time.Now().Unix(),
+		)
+		require.NoError(t, err)
+
+		newTxid, err = svc.BumpFee(ctx, newTxid, 20000)
+		require.ErrorIs(t, err, application.ErrTxAlreadyConfirmed)
+		require.Empty(t, newTxid)
+	})
+}
+
+func testTxAcceleration(t *testing.T) {
+	t.Run("accelerate", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		mockedBcScanner.On("GetTransactions", mock.Anything).
+			Return(nil, fmt.Errorf("transaction not found"))
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		utxoRepo := repoManager.UtxoRepository()
+		txRepo := repoManager.TransactionRepository()
+		utxos, err := utxoRepo.GetSpendableUtxosForAccount(ctx, accountNamespace)
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+
+		chosenUtxo, otherUtxo := utxos[0], utxos[1]
+		ins := application.Inputs{{TxID: chosenUtxo.TxID, VOut: chosenUtxo.VOut}}
+		parentOutputs := []application.Output{outputs[0]}
+		parentOutputs[0].Amount = chosenUtxo.Value - 2000
+		parentTxHex, err := svc.Transfer(
+			ctx, accountName, parentOutputs, 100, nil,
+			application.TransferOptions{Inputs: ins},
+		)
+		require.NoError(t, err)
+
+		parentTx, err := transaction.NewTxFromHex(parentTxHex)
+		require.NoError(t, err)
+		parentTxid := parentTx.TxHash().String()
+		_, err = txRepo.AddTransaction(ctx, &domain.Transaction{
+			TxID:     parentTxid,
+			TxHex:    parentTxHex,
+			Accounts: map[string]struct{}{accountNamespace: {}},
+		})
+		require.NoError(t, err)
+		_, err = utxoRepo.SpendUtxos(
+			ctx, []domain.UtxoKey{chosenUtxo.Key()}, parentTxid,
+		)
+		require.NoError(t, err)
+
+		// Txs without outputs owned by the wallet can't be accelerated.
+		childTxid, err := svc.Accelerate(ctx, parentTxid, 10000)
+		require.ErrorIs(t, err, application.ErrTxWithoutOwnedOutputs)
+		require.Empty(t, childTxid)
+
+		// The owned output is too small to pay for the package alone.
+		ownedUtxo := randomUtxo(accountNamespace, receiverAddress)
+		ownedUtxo.TxID = parentTxid
+		ownedUtxo.VOut = 1
+		ownedUtxo.Value = 1000
+		ownedUtxo.Asset = regtest.AssetID
+		ownedUtxo.Script = chosenUtxo.Script
+		ownedUtxo.ConfirmedStatus = domain.UtxoStatus{}
+		_, err = utxoRepo.AddUtxos(ctx, []*domain.Utxo{ownedUtxo})
+		require.NoError(t, err)
+
+		// The fee rate must be higher than the parent's one.
+		childTxid, err = svc.Accelerate(ctx, parentTxid, 100)
+		require.ErrorIs(t, err, application.ErrFeeRateTooLow)
+		require.Empty(t, childTxid)
+
+		childTxid, err = svc.Accelerate(ctx, parentTxid, 10000)
+		require.NoError(t, err)
+		require.NotEmpty(t, childTxid)
+
+		calls := mockedBcScanner.Calls
+		childTx, err := transaction.NewTxFromHex(
+			calls[len(calls)-1].Arguments.String(0),
+		)
+		require.NoError(t, err)
+
+		// The child pays for the missing fees of the parent too.
+		parentFeeAmount := feeAmountFromTx(parentTx)
+		packageSize := uint64(parentTx.VirtualSize() + childTx.VirtualSize())
+		require.GreaterOrEqual(
+			t, parentFeeAmount+feeAmountFromTx(childTx), packageSize*10,
+		)
+
+		// The child spends the owned output plus an extra one.
+		childKeys := make([]domain.UtxoKey, 0, len(childTx.Inputs))
+		for _, in := range childTx.Inputs {
+			childKeys = append(childKeys, domain.UtxoKey{
+				TxID: elementsutil.TxIDFromBytes(in.Hash),
+				VOut: in.Index,
+			})
+		}
+		require.ElementsMatch(
+			t, []domain.UtxoKey{ownedUtxo.Key(), otherUtxo.Key()}, childKeys,
+		)
+
+		// Confirmed txs can't be accelerated.
+		_, err = txRepo.ConfirmTransaction(
+			ctx, parentTxid, randomHex(32), 100, time.Now().Unix(),
+		)
+		require.NoError(t, err)
+
+		childTxid, err = svc.Accelerate(ctx, parentTxid, 20000)
+		require.ErrorIs(t, err, application.ErrTxAlreadyConfirmed)
+		require.Empty(t, childTxid)
+	})
+}
+
+func testFundsMovement(t *testing.T) {
+	t.Run("move_funds", func(t *testing.T) {
+		txid := randomHex(32)
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(txid, nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		var target *domain.Account
+		err = repoManager.WalletRepository().UpdateWallet(
+			ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+				target, err = w.CreateAccount("test2", 0, true)
+				return w, err
+			},
+		)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		_, err = svc.MoveFunds(
+			ctx, accountName, accountNamespace, regtest.AssetID, 1000000, 100,
+		)
+		require.ErrorIs(t, err, application.ErrSameAccount)
+
+		// A failing transfer must not consume any address of the target account.
+		_, err = svc.MoveFunds(
+			ctx, accountName, "test2", regtest.AssetID, math.MaxInt64, 100,
+		)
+		require.Error(t, err)
+		w, err := repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		target, err = w.GetAccount("test2")
+		require.NoError(t, err)
+		require.Zero(t, target.NextExternalIndex)
+
+		gotTxid, err := svc.MoveFunds(
+			ctx, accountName, "test2", regtest.AssetID, 1000000, 100,
+		)
+		require.NoError(t, err)
+		require.Equal(t, txid, gotTxid)
+
+		txHex := mockedBcScanner.Calls[len(mockedBcScanner.Calls)-1].
+			Arguments.String(0)
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+
+		w, err = repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		target, err = w.GetAccount("test2")
+		require.NoError(t, err)
+		require.Equal(t, 1, int(target.NextExternalIndex))
+
+		// The output of the unconfidential target account must not be blinded.
+		var targetOut *transaction.TxOutput
+		for _, out := range tx.Outputs {
+			script := hex.EncodeToString(out.Script)
+			if _, ok := target.DerivationPathByScript[script]; ok {
+				targetOut = out
+			}
+		}
+		require.NotNil(t, targetOut)
+		require.False(t, targetOut.IsConfidential())
+		value, err := elementsutil.ValueFromBytes(targetOut.Value)
+		require.NoError(t, err)
+		require.Equal(t, 1000000, int(value))
+
+		storedTx, err := repoManager.TransactionRepository().GetTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.Contains(t, storedTx.Accounts, accountNamespace)
+		require.Contains(t, storedTx.Accounts, target.Namespace)
+		require.True(t, storedTx.Internal)
+
+		// Simulate the scanner spending and receiving the utxos of the tx to
+		// make sure it shows as internal in the history of both accounts.
+		leases, err := svc.ListLeases(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, leases, 1)
+		_, err = repoManager.UtxoRepository().SpendUtxos(
+			ctx, leases[0].Utxos, txid,
+		)
+		require.NoError(t, err)
+		receivedUtxo := randomUtxo(target.Namespace, "")
+		receivedUtxo.TxID = txid
+		receivedUtxo.Value = value
+		receivedUtxo.Asset = regtest.AssetID
+		_, err = repoManager.UtxoRepository().AddUtxos(
+			ctx, []*domain.Utxo{receivedUtxo},
+		)
+		require.NoError(t, err)
+
+		accountSvc := application.NewAccountService(
+			repoManager, mockedBcScanner, regtest, 0,
+		)
+		history, err := exportHistory(accountSvc, accountName)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.True(t, history[0].Internal)
+		require.Equal(t, "test2", history[0].Counterparty)
+		require.Negative(t, history[0].Amount)
+
+		history, err = exportHistory(accountSvc, "test2")
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.True(t, history[0].Internal)
+		require.Equal(t, accountName, history[0].Counterparty)
+		require.Equal(t, int64(value), history[0].Amount)
+	})
+}
+
+func testSwap(t *testing.T) {
+	t.Run("swap", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		// The maker is a confidential account giving some asset in exchange for
+		// lbtc of the taker, an unconfidential account.
+		var maker, taker *domain.Account
+		err = repoManager.WalletRepository().UpdateWallet(
+			ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+				maker, err = w.CreateAccount("maker", 0, false)
+				if err != nil {
+					return nil, err
+				}
+				taker, err = w.CreateAccount("taker", 0, true)
+				return w, err
+			},
+		)
+		require.NoError(t, err)
+
+		asset := randomHex(32)
+		makerAddrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, maker.Namespace, 1)
+		require.NoError(t, err)
+		makerUtxo := randomConfidentialUtxo(
+			maker.Namespace, makerAddrInfo[0].Address, asset, 100000,
+		)
+		takerAddrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, taker.Namespace, 1)
+		require.NoError(t, err)
+		takerUtxo := randomUtxo(taker.Namespace, takerAddrInfo[0].Address)
+		takerUtxo.Value = 100000000
+		takerUtxo.Asset = regtest.AssetID
+		takerUtxo.ValueCommitment = nil
+		takerUtxo.AssetCommitment = nil
+		takerUtxo.ValueBlinder = nil
+		takerUtxo.AssetBlinder = nil
+		takerUtxo.Nonce = nil
+		_, err = repoManager.UtxoRepository().AddUtxos(
+			ctx, []*domain.Utxo{makerUtxo, takerUtxo},
+		)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		offer, err := svc.CreateSwapOffer(
+			ctx, "maker", asset, 50000, asset, 1000000,
+		)
+		require.ErrorIs(t, err, application.ErrInvalidSwapTerms)
+		require.Nil(t, offer)
+
+		offer, err = svc.CreateSwapOffer(
+			ctx, "maker", asset, 50000, regtest.AssetID, 1000000,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, offer)
+		require.NotEmpty(t, offer.ID)
+		require.NotEmpty(t, offer.Pset)
+		require.Equal(t, maker.Namespace, offer.AccountName)
+		require.Len(t, offer.UnblindedInputs, 1)
+
+		leases, err := svc.ListLeases(ctx, "maker")
+		require.NoError(t, err)
+		require.Len(t, leases, 1)
+		require.Equal(t, offer.ID, leases[0].ID)
+
+		accepted, err := svc.AcceptSwap(ctx, "taker", offer.Pset, 100)
+		require.NoError(t, err)
+		require.NotNil(t, accepted)
+		require.Equal(t, regtest.AssetID, accepted.GiveAsset)
+		require.Equal(t, 1000000, int(accepted.GiveAmount))
+		require.Equal(t, asset, accepted.WantAsset)
+		require.Equal(t, 50000, int(accepted.WantAmount))
+		require.NotZero(t, accepted.FeeAmount)
+		require.Len(t, accepted.UnblindedInputs, 1)
+		require.Equal(t, 1, int(accepted.UnblindedInputs[0].Index))
+
+		// The offer must be accepted before being completed, and the unblinded
+		// inputs of the counterparty are required to blind the tx.
+		_, _, err = svc.CompleteSwap(ctx, offer.ID, offer.Pset, nil, false)
+		require.ErrorIs(t, err, application.ErrSwapNotAccepted)
+		_, _, err = svc.CompleteSwap(ctx, accepted.ID, accepted.Pset, nil, false)
+		require.ErrorIs(t, err, application.ErrMissingSwapUnblindedInputs)
+		_, _, err = svc.CompleteSwap(
+			ctx, offer.ID, accepted.Pset[:100], nil, false,
+		)
+		require.Error(t, err)
+
+		makerPset, txHex, err := svc.CompleteSwap(
+			ctx, offer.ID, accepted.Pset, accepted.UnblindedInputs, false,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, makerPset)
+		require.Empty(t, txHex)
+
+		// Lowering the amount received by the taker must be detected.
+		ptx, err := psetv2.NewPsetFromBase64(makerPset)
+		require.NoError(t, err)
+		for i, out := range ptx.Outputs {
+			if elementsutil.TxIDFromBytes(out.Asset) == asset && !out.NeedsBlinding() {
+				ptx.Outputs[i].Value--
+			}
+		}
+		tamperedPset, err := ptx.ToBase64()
+		require.NoError(t, err)
+		_, _, err = svc.CompleteSwap(ctx, accepted.ID, tamperedPset, nil, false)
+		require.ErrorIs(t, err, application.ErrSwapAmountsMismatch)
+
+		signedPset, txHex, err := svc.CompleteSwap(
+			ctx, accepted.ID, makerPset, nil, false,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPset)
+		require.NotEmpty(t, txHex)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Len(t, tx.Inputs, 2)
+
+		// Swaps are removed once completed.
+		_, _, err = svc.CompleteSwap(ctx, offer.ID, makerPset, nil, false)
+		require.Error(t, err)
+	})
+}
+
+func testFragments(t *testing.T) {
+	t.Run("fragments", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		// The maker creates a fragment for each of its utxos of different assets
+		// in exchange for lbtc of the taker. Both accounts are confidential.
+		var maker, taker *domain.Account
+		err = repoManager.WalletRepository().UpdateWallet(
+			ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+				maker, err = w.CreateAccount("maker", 0, false)
+				if err != nil {
+					return nil, err
+				}
+				taker, err = w.CreateAccount("taker", 0, false)
+				if err != nil {
+					return nil, err
+				}
+				_, err = w.CreateAccount("unconf-taker", 0, true)
+				return w, err
+			},
+		)
+		require.NoError(t, err)
+
+		makerAddrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, maker.Namespace, 2)
+		require.NoError(t, err)
+		makerUtxos := []*domain.Utxo{
+			randomConfidentialUtxo(
+				maker.Namespace, makerAddrInfo[0].Address, randomHex(32), 100000,
+			),
+			randomConfidentialUtxo(
+				maker.Namespace, makerAddrInfo[1].Address, randomHex(32), 50000,
+			),
+		}
+		takerAddrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, taker.Namespace, 1)
+		require.NoError(t, err)
+		takerUtxo := randomUtxo(taker.Namespace, takerAddrInfo[0].Address)
+		takerUtxo.Value = 100000000
+		takerUtxo.Asset = regtest.AssetID
+		takerUtxo.ValueCommitment = nil
+		takerUtxo.AssetCommitment = nil
+		takerUtxo.ValueBlinder = nil
+		takerUtxo.AssetBlinder = nil
+		takerUtxo.Nonce = nil
+		_, err = repoManager.UtxoRepository().AddUtxos(
+			ctx, append(makerUtxos, takerUtxo),
+		)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		makerInputs := []application.Input{
+			{TxID: makerUtxos[0].TxID, VOut: makerUtxos[0].VOut},
+			{TxID: makerUtxos[1].TxID, VOut: makerUtxos[1].VOut},
+		}
+		fragment, err := svc.CreateFragment(
+			ctx, "maker", makerInputs[0], makerUtxos[0].Asset, 1000000, true,
+		)
+		require.ErrorIs(t, err, application.ErrInvalidFragmentTerms)
+		require.Nil(t, fragment)
+
+		blindedFragment, err := svc.CreateFragment(
+			ctx, "maker", makerInputs[0], regtest.AssetID, 1000000, true,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, blindedFragment)
+		require.NotEmpty(t, blindedFragment.Pset)
+		require.NotEmpty(t, blindedFragment.LeaseID)
+		require.NotEmpty(t, blindedFragment.OutputAssetBlinder)
+		require.NotEmpty(t, blindedFragment.OutputAmountBlinder)
+		require.Equal(t, 100000, int(blindedFragment.UnblindedInput.Amount))
+
+		fragment, err = svc.CreateFragment(
+			ctx, "maker", makerInputs[1], regtest.AssetID, 500000, false,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, fragment)
+		require.Empty(t, fragment.OutputAssetBlinder)
+		require.Empty(t, fragment.OutputAmountBlinder)
+
+		// The utxos of the fragments are locked.
+		_, err = svc.CreateFragment(
+			ctx, "maker", makerInputs[1], regtest.AssetID, 500000, false,
+		)
+		require.ErrorIs(t, err, domain.ErrUtxoAlreadyLocked)
+
+		ptx, err := psetv2.NewPsetFromBase64(blindedFragment.Pset)
+		require.NoError(t, err)
+		require.True(t, ptx.Outputs[0].IsFullyBlinded())
+
+		fragments := []application.Fragment{*blindedFragment, *fragment}
+
+		// Confidential fragments can't be filled by unconfidential accounts.
+		txHex, err := svc.FillFragments(ctx, "unconf-taker", fragments, 100, false)
+		require.ErrorIs(t, err, application.ErrFragmentsNeedBlinding)
+		require.Empty(t, txHex)
+
+		// Tampering with the signed output or with the revealed blinders of a
+		// fragment must be detected.
+		ptx.Outputs[0].Value--
+		tamperedPset, err := ptx.ToBase64()
+		require.NoError(t, err)
+		tamperedFragment := *blindedFragment
+		tamperedFragment.Pset = tamperedPset
+		_, err = svc.FillFragments(
+			ctx, "taker", []application.Fragment{tamperedFragment}, 100, false,
+		)
+		require.Error(t, err)
+
+		tamperedFragment = *blindedFragment
+		tamperedFragment.OutputAmountBlinder = randomHex(32)
+		_, err = svc.FillFragments(
+			ctx, "taker", []application.Fragment{tamperedFragment}, 100, false,
+		)
+		require.Error(t, err)
+
+		txHex, err = svc.FillFragments(ctx, "taker", fragments, 100, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		tx, err := transaction.NewTxFromHex(txHex)
+		require.NoError(t, err)
+		require.Len(t, tx.Inputs, 3)
+		for i, f := range fragments {
+			fragmentPtx, err := psetv2.NewPsetFromBase64(f.Pset)
+			require.NoError(t, err)
+			fragmentIn := fragmentPtx.Inputs[0]
+			require.Equal(t, fragmentIn.PreviousTxid, tx.Inputs[i].Hash)
+			require.Equal(t, fragmentIn.PreviousTxIndex, tx.Inputs[i].Index)
+			require.Equal(t, fragmentPtx.Outputs[0].Script, tx.Outputs[i].Script)
+		}
+		require.True(t, tx.Outputs[0].IsConfidential())
+		require.False(t, tx.Outputs[1].IsConfidential())
+		// All outputs of the taker but the fee one are blinded.
+		for _, out := range tx.Outputs[len(fragments) : len(tx.Outputs)-1] {
+			require.True(t, out.IsConfidential())
+		}
+	})
+}
+
+func testUtxoFragmentation(t *testing.T) {
+	t.Run("fragment_utxos", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			numFragments   uint32
+			fragmentAmount uint64
+		}{
+			{
+				name:           "fixed_amount",
+				numFragments:   5,
+				fragmentAmount: 10000000,
+			},
+			{
+				name:           "max_fixed_amount",
+				fragmentAmount: 50000000,
+			},
+			{
+				name:         "geometric",
+				numFragments: 4,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockedBcScanner := newMockedBcScanner()
+				repoManager, err := newRepoManagerForTxService()
+				require.NoError(t, err)
+				require.NotNil(t, repoManager)
+
+				svc := application.NewTransactionService(
+					repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+					dustAmount, 0, false,
+				)
+
+				txHex, err := svc.FragmentUtxos(
+					ctx, accountName, regtest.AssetID, tt.numFragments,
+					tt.fragmentAmount, 100, nil,
+				)
+				require.NoError(t, err)
+				require.NotEmpty(t, txHex)
+			})
+		}
+	})
+
+	t.Run("fragment_utxos_invalid", func(t *testing.T) {
+		tests := []struct {
+			name           string
+			numFragments   uint32
+			fragmentAmount uint64
+			expectedErr    error
+		}{
+			{
+				name:        "missing_num_fragments",
+				expectedErr: application.ErrInvalidNumOfFragments,
+			},
+			{
+				name:         "too_many_fragments",
+				numFragments: application.MaxFragments + 1,
+				expectedErr:  application.ErrInvalidNumOfFragments,
+			},
+			{
+				name:           "fragment_amount_too_high",
+				numFragments:   3,
+				fragmentAmount: 100000000,
+				expectedErr:    application.ErrBalanceTooLowToFragment,
+			},
+		}
+
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				txHex, err := svc.FragmentUtxos(
+					ctx, accountName, regtest.AssetID, tt.numFragments,
+					tt.fragmentAmount, 100, nil,
+				)
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Empty(t, txHex)
+			})
+		}
+	})
+
+	t.Run("fragment_utxos_without_fee_funds", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		// Spend all lbtc utxos and add one of another asset to fragment.
+		utxoRepo := repoManager.UtxoRepository()
+		lbtcUtxos, err := utxoRepo.GetSpendableUtxosForAccount(ctx, accountNamespace)
+		require.NoError(t, err)
+		_, err = utxoRepo.SpendUtxos(
+			ctx, application.Utxos(lbtcUtxos).Keys(), randomHex(32),
+		)
+		require.NoError(t, err)
+
+		asset := randomHex(32)
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		utxo := randomUtxo(accountNamespace, addrInfo[0].Address)
+		utxo.Asset = asset
+		_, err = utxoRepo.AddUtxos(ctx, []*domain.Utxo{utxo})
+		require.NoError(t, err)
+
+		w, err := repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		nextAddrInfo, err := w.PeekNextInternalAddressesForAccount(accountName, 1)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		txHex, err := svc.FragmentUtxos(ctx, accountName, asset, 2, 0, 100, nil)
+		require.Error(t, err)
+		require.Empty(t, txHex)
+
+		// No internal address must have been derived for the failed transfer.
+		w, err = repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		gotNextAddrInfo, err := w.PeekNextInternalAddressesForAccount(accountName, 1)
+		require.NoError(t, err)
+		require.Equal(t, nextAddrInfo, gotNextAddrInfo)
+	})
+}
+
+func testUtxoConsolidation(t *testing.T) {
+	t.Run("consolidate_utxos", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		txHex, err := svc.Consolidate(
+			ctx, accountName, regtest.AssetID, 20, 0, 100, 100,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		// Consolidated utxos are locked and can't be consolidated again.
+		txHex, err = svc.Consolidate(
+			ctx, accountName, regtest.AssetID, 20, 0, 100, 100,
+		)
+		require.ErrorIs(t, err, application.ErrNothingToConsolidate)
+		require.Empty(t, txHex)
+	})
+
+	t.Run("consolidate_utxos_invalid", func(t *testing.T) {
+		tests := []struct {
+			name                string
+			maxInputs           uint32
+			maxUtxoValue        uint64
+			millisatsPerByte    uint64
+			maxMillisatsPerByte uint64
+			expectedErr         error
+		}{
+			{
+				name:             "invalid_max_inputs",
+				maxInputs:        1,
+				millisatsPerByte: 100,
+				expectedErr:      application.ErrInvalidMaxInputs,
+			},
+			{
+				name:                "fee_rate_above_ceiling",
+				maxInputs:           20,
+				millisatsPerByte:    200,
+				maxMillisatsPerByte: 100,
+				expectedErr:         application.ErrFeeRateAboveCeiling,
+			},
+			{
+				name:             "no_small_utxos",
+				maxInputs:        20,
+				maxUtxoValue:     1000,
+				millisatsPerByte: 100,
+				expectedErr:      application.ErrNothingToConsolidate,
+			},
+		}
+
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				txHex, err := svc.Consolidate(
+					ctx, accountName, regtest.AssetID, tt.maxInputs, tt.maxUtxoValue,
+					tt.millisatsPerByte, tt.maxMillisatsPerByte,
+				)
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Empty(t, txHex)
+			})
+		}
+	})
+
+	t.Run("consolidate_utxos_without_fee_funds", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		// Spend all lbtc utxos and add a couple of another asset to consolidate.
+		utxoRepo := repoManager.UtxoRepository()
+		lbtcUtxos, err := utxoRepo.GetSpendableUtxosForAccount(ctx, accountNamespace)
+		require.NoError(t, err)
+		_, err = utxoRepo.SpendUtxos(
+			ctx, application.Utxos(lbtcUtxos).Keys(), randomHex(32),
+		)
+		require.NoError(t, err)
+
+		asset := randomHex(32)
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		utxos := []*domain.Utxo{
+			randomUtxo(accountNamespace, addrInfo[0].Address),
+			randomUtxo(accountNamespace, addrInfo[0].Address),
+		}
+		for _, u := range utxos {
+			u.Asset = asset
+		}
+		_, err = utxoRepo.AddUtxos(ctx, utxos)
+		require.NoError(t, err)
+
+		w, err := repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		nextAddrInfo, err := w.PeekNextInternalAddressesForAccount(accountName, 1)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		txHex, err := svc.Consolidate(ctx, accountName, asset, 20, 0, 100, 100)
+		require.Error(t, err)
+		require.Empty(t, txHex)
+
+		// No internal address must have been derived for the refused
+		// consolidation.
+		w, err = repoManager.WalletRepository().GetWallet(ctx)
+		require.NoError(t, err)
+		gotNextAddrInfo, err := w.PeekNextInternalAddressesForAccount(accountName, 1)
+		require.NoError(t, err)
+		require.Equal(t, nextAddrInfo, gotNextAddrInfo)
+	})
+}
+
+func newRepoManagerForTxService() (ports.RepoManager, error) {
+	rm, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := domain.NewWallet(
+		mnemonic, password, rootPath, regtest.Name, birthdayBlockHeight, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rm.WalletRepository().CreateWallet(ctx, wallet); err != nil {
+		return nil, err
+	}
+
+	if err := rm.WalletRepository().UpdateWallet(
+		ctx, func(w *domain.Wallet) (*domain.Wallet, error) {
+			w.Unlock(password)
+			w.CreateAccount(accountName, 0, false)
+			return w, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	addrInfo, err := rm.WalletRepository().DeriveNextExternalAddressesForAccount(ctx, accountName, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := application.AddressesInfo(addrInfo).Addresses()
+	utxos := make([]*domain.Utxo, 0, len(addresses))
+	for _, addr := range addresses {
+		utxo := randomUtxo(accountNamespace, addr)
+		utxo.Value = 100000000
+		utxo.Asset = regtest.AssetID
+		utxos = append(utxos, utxo)
+	}
+
+	if _, err := rm.UtxoRepository().AddUtxos(ctx, utxos); err != nil {
+		return nil, err
+	}
+
+	return rm, nil
+}
+
+func testPaymentBatching(t *testing.T) {
+	t.Run("batch_payments", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).
+			Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		// Payments can't be queued if batching is not enabled.
+		id, err := svc.EnqueuePayment(ctx, accountName, outputs[0])
+		require.ErrorIs(t, err, application.ErrPaymentBatchingDisabled)
+		require.Empty(t, id)
+
+		svc.ScheduleBatching(application.BatchingConfig{
+			Interval:         time.Hour,
+			MaxPayments:      2,
+			MillisatsPerByte: 100,
+		})
+
+		id, err = svc.EnqueuePayment(ctx, accountName, outputs[0])
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+
+		payments, err := svc.ListQueuedPayments(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		require.Equal(t, id, payments[0].ID)
+
+		err = svc.CancelPayment(ctx, id)
+		require.NoError(t, err)
+
+		err = svc.CancelPayment(ctx, id)
+		require.ErrorIs(t, err, application.ErrPaymentNotFound)
+
+		payments, err = svc.ListQueuedPayments(ctx, "")
+		require.NoError(t, err)
+		require.Empty(t, payments)
+
+		// Reaching the max number of queued payments flushes the queue.
+		chEvents := repoManager.PaymentRepository().GetEventChannel()
+		ids := make([]string, 0, 2)
+		for i := 0; i < 2; i++ {
+			id, err := svc.EnqueuePayment(ctx, accountName, outputs[0])
+			require.NoError(t, err)
+			require.NotEmpty(t, id)
+			ids = append(ids, id)
+		}
+
+		var event domain.PaymentEvent
+		select {
+		case event = <-chEvents:
+			require.Equal(t, domain.PaymentsFlushed, event.EventType)
+			flushedIds := make([]string, 0, len(event.Payments))
+			for _, p := range event.Payments {
+				flushedIds = append(flushedIds, p.ID)
+			}
+			require.ElementsMatch(t, ids, flushedIds)
+		case <-time.After(10 * time.Second):
+			t.Fatal("expected queued payments to be flushed")
+		}
+
+		payments, err = svc.ListQueuedPayments(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, payments)
+
+		// The batched tx has an output for every payment.
+		calls := mockedBcScanner.Calls
+		tx, err := transaction.NewTxFromHex(calls[len(calls)-1].Arguments.String(0))
+		require.NoError(t, err)
+		require.Equal(t, tx.TxHash().String(), event.TxID)
+		count := 0
+		for _, out := range tx.Outputs {
+			if bytes.Equal(out.Script, outputs[0].Script) {
+				count++
+			}
+		}
+		require.Equal(t, 2, count)
+
+		// A payment that can't be sent is marked as failed without preventing
+		// the others to be sent.
+		failingOutput := outputs[0]
+		failingOutput.Amount = 10000000000
+		id, err = svc.EnqueuePayment(ctx, accountName, outputs[0])
+		require.NoError(t, err)
+		failedId, err := svc.EnqueuePayment(ctx, accountName, failingOutput)
+		require.NoError(t, err)
+
+		select {
+		case event := <-chEvents:
+			require.Len(t, event.Payments, 1)
+			require.Equal(t, id, event.Payments[0].ID)
+		case <-time.After(10 * time.Second):
+			t.Fatal("expected queued payments to be flushed")
+		}
+
+		payments, err = svc.ListQueuedPayments(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		require.Equal(t, failedId, payments[0].ID)
+		require.True(t, payments[0].IsFailed())
+
+		err = svc.CancelPayment(ctx, failedId)
+		require.NoError(t, err)
+
+		// A payment bound to a tx can't be canceled, and is removed from the
+		// queue once the tx is known.
+		id, err = svc.EnqueuePayment(ctx, accountName, outputs[0])
+		require.NoError(t, err)
+		txid := randomHex(32)
+		_, err = repoManager.PaymentRepository().MarkPaymentsSending(
+			ctx, []string{id}, txid,
+		)
+		require.NoError(t, err)
+
+		err = svc.CancelPayment(ctx, id)
+		require.ErrorIs(t, err, application.ErrPaymentBeingSent)
+
+		_, err = repoManager.TransactionRepository().AddTransaction(
+			ctx, &domain.Transaction{
+				TxID:     txid,
+				Accounts: map[string]struct{}{accountNamespace: {}},
+			},
+		)
+		require.NoError(t, err)
+
+		// There are no more spendable funds to send these payments, they're
+		// expected to stay in queue.
+		ids = make([]string, 0, 2)
+		for i := 0; i < 2; i++ {
+			id, err := svc.EnqueuePayment(ctx, accountName, outputs[0])
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+
+		select {
+		case event := <-chEvents:
+			require.Equal(t, txid, event.TxID)
+			require.Len(t, event.Payments, 1)
+			require.Equal(t, id, event.Payments[0].ID)
+		case <-time.After(10 * time.Second):
+			t.Fatal("expected sent payment to be flushed")
+		}
+
+		payments, err = svc.ListQueuedPayments(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, payments, 2)
+		for _, p := range payments {
+			require.Contains(t, ids, p.ID)
+			require.False(t, p.IsSending())
+			require.False(t, p.IsFailed())
+		}
+	})
+}
+
+func testSpendingPolicies(t *testing.T) {
+	t.Run("spending_policies", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		mockedBcScanner.On("BroadcastTransaction", mock.Anything).Return(randomHex(32), nil)
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		chEvents := repoManager.SpendingPolicyRepository().GetEventChannel()
+		requireViolation := func(t *testing.T, rule domain.PolicyRule) {
+			select {
+			case event := <-chEvents:
+				require.Equal(t, domain.PolicyViolated, event.EventType)
+				require.Equal(t, accountNamespace, event.Violation.AccountName)
+				require.Equal(t, rule, event.Violation.Rule)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected %s violation event", rule)
+			}
+		}
+		amount := uint64(1000000)
+		destination := hex.EncodeToString(receiverAddrInfo.Script)
+		transfer := func() (string, error) {
+			outs := []application.Output{
+				{
+					Asset:       regtest.AssetID,
+					Amount:      amount,
+					Script:      receiverAddrInfo.Script,
+					BlindingKey: receiverAddrInfo.BlindingKey,
+				},
+			}
+			return svc.Transfer(
+				ctx, accountName, outs, 1000, nil, application.TransferOptions{},
+			)
+		}
+
+		approverKeys := make([]*btcec.PrivateKey, 0, 4)
+		approvers := make([]string, 0, 4)
+		for i := 0; i < 4; i++ {
+			key, _ := btcec.NewPrivateKey()
+			approverKeys = append(approverKeys, key)
+			approvers = append(
+				approvers, hex.EncodeToString(key.PubKey().SerializeCompressed()),
+			)
+		}
+		approve := func(
+			s *domain.Spending, key *btcec.PrivateKey,
+		) (*domain.Spending, error) {
+			digest, _ := hex.DecodeString(s.Digest)
+			sig := ecdsa.Sign(key, digest).Serialize()
+			return svc.ApproveSpending(
+				ctx, s.ID, hex.EncodeToString(key.PubKey().SerializeCompressed()),
+				hex.EncodeToString(sig),
+			)
+		}
+
+		err = svc.SetSpendingPolicy(ctx, domain.SpendingPolicy{
+			AccountName: accountName,
+			AssetLimits: []domain.AssetSpendingLimit{
+				{Asset: regtest.AssetID, ApprovalThreshold: amount},
+			},
+		}, password)
+		require.ErrorIs(t, err, application.ErrMissingRequiredApprovals)
+
+		err = svc.SetSpendingPolicy(ctx, domain.SpendingPolicy{
+			AccountName: accountName,
+			AssetLimits: []domain.AssetSpendingLimit{
+				{Asset: regtest.AssetID, ApprovalThreshold: amount},
+			},
+			RequiredApprovals: 2,
+			Approvers:         approvers[:1],
+		}, password)
+		require.ErrorIs(t, err, application.ErrNotEnoughApprovers)
+
+		// Only who knows the wallet password can change the policy.
+		err = svc.SetSpendingPolicy(ctx, domain.SpendingPolicy{
+			AccountName: accountName,
+		}, "wrong password")
+		require.ErrorIs(t, err, domain.ErrWalletInvalidPassword)
+
+		policy, err := svc.GetSpendingPolicy(ctx, accountName)
+		require.ErrorIs(t, err, domain.ErrSpendingPolicyNotFound)
+		require.Nil(t, policy)
+
+		tests := []struct {
+			name   string
+			policy domain.SpendingPolicy
+			rule   domain.PolicyRule
+		}{
+			{
+				name: "max_amount_per_tx",
+				policy: domain.SpendingPolicy{
+					AssetLimits: []domain.AssetSpendingLimit{
+						{Asset: regtest.AssetID, MaxAmountPerTx: amount},
+					},
+				},
+				rule: domain.PolicyRuleMaxAmountPerTx,
+			},
+			{
+				name:   "denied_destination",
+				policy: domain.SpendingPolicy{DeniedScripts: []string{destination}},
+				rule:   domain.PolicyRuleDeniedDestination,
+			},
+			{
+				name: "destination_not_allowed",
+				policy: domain.SpendingPolicy{
+					AllowedScripts: []string{"0014" + randomHex(20)},
+				},
+				rule: domain.PolicyRuleDestinationNotAllowed,
+			},
+			{
+				name:   "max_fee_amount",
+				policy: domain.SpendingPolicy{MaxFeeAmount: 1},
+				rule:   domain.PolicyRuleMaxFeeAmount,
+			},
+			{
+				name:   "max_fee_rate",
+				policy: domain.SpendingPolicy{MaxFeeRate: 500},
+				rule:   domain.PolicyRuleMaxFeeRate,
+			},
+		}
+
+		for _, tt := range tests {
+			tt.policy.AccountName = accountName
+			err := svc.SetSpendingPolicy(ctx, tt.policy, password)
+			require.NoError(t, err, tt.name)
+
+			txid, err := transfer()
+			require.ErrorIs(t, err, application.ErrSpendingPolicyViolation, tt.name)
+			require.Empty(t, txid, tt.name)
+			requireViolation(t, tt.rule)
+		}
+
+		// The amounts signed within the last day count towards the daily limit,
+		// no matter when the spendings were created.
+		now := time.Now()
+		policyRepo := repoManager.SpendingPolicyRepository()
+		_, err = policyRepo.AddSpending(ctx, &domain.Spending{
+			ID:          randomHex(16),
+			AccountName: accountNamespace,
+			Digest:      randomHex(32),
+			Amounts:     map[string]uint64{regtest.AssetID: amount},
+			CreatedAt:   now.Add(-30 * time.Hour).Unix(),
+			SignedAt:    now.Add(-1 * time.Hour).Unix(),
+		})
+		require.NoError(t, err)
+		_, err = policyRepo.AddSpending(ctx, &domain.Spending{
+			ID:          randomHex(16),
+			AccountName: accountNamespace,
+			Digest:      randomHex(32),
+			Amounts:     map[string]uint64{regtest.AssetID: amount},
+			CreatedAt:   now.Add(-30 * time.Hour).Unix(),
+			SignedAt:    now.Add(-25 * time.Hour).Unix(),
+		})
+		require.NoError(t, err)
+
+		err = svc.SetSpendingPolicy(ctx, domain.SpendingPolicy{
+			AccountName: accountName,
+			AssetLimits: []domain.AssetSpendingLimit{
+				{Asset: regtest.AssetID, MaxAmountPerDay: amount * 5 / 2},
+			},
+		}, password)
+		require.NoError(t, err)
+
+		txid, err := transfer()
+		require.NoError(t, err)
+		require.NotEmpty(t, txid)
+
+		txid, err = transfer()
+		require.ErrorIs(t, err, application.ErrSpendingPolicyViolation)
+		require.Empty(t, txid)
+		requireViolation(t, domain.PolicyRuleMaxAmountPerDay)
+
+		// Spending above the threshold requires the given number of approvals
+		// from the approvers of the policy.
+		err = svc.SetSpendingPolicy(ctx, domain.SpendingPolicy{
+			AccountName: accountName,
+			AssetLimits: []domain.AssetSpendingLimit{
+				{Asset: regtest.AssetID, ApprovalThreshold: amount / 2},
+			},
+			RequiredApprovals: 2,
+			Approvers:         approvers[:3],
+		}, password)
+		require.NoError(t, err)
+
+		txid, err = transfer()
+		require.ErrorIs(t, err, application.ErrSpendingApprovalRequired)
+		require.Empty(t, txid)
+		requireViolation(t, domain.PolicyRuleApprovalRequired)
+
+		spendings, err := svc.ListSpendings(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, spendings, 3)
+		var pendingSpending *domain.Spending
+		for _, s := range spendings {
+			if !s.IsSigned() {
+				pendingSpending = s
+			}
+		}
+		require.NotNil(t, pendingSpending)
+
+		_, err = approve(pendingSpending, approverKeys[3])
+		require.ErrorIs(t, err, application.ErrUnknownApprover)
+
+		otherSpending := *pendingSpending
+		otherSpending.Digest = randomHex(32)
+		_, err = approve(&otherSpending, approverKeys[0])
+		require.ErrorIs(t, err, application.ErrInvalidApprovalSignature)
+
+		spending, err := approve(pendingSpending, approverKeys[0])
+		require.NoError(t, err)
+		require.Equal(t, approvers[:1], spending.Approvers)
+
+		_, err = approve(pendingSpending, approverKeys[0])
+		require.ErrorIs(t, err, domain.ErrSpendingAlreadyApproved)
+
+		txid, err = transfer()
+		require.ErrorIs(t, err, application.ErrSpendingApprovalRequired)
+		require.Empty(t, txid)
+		requireViolation(t, domain.PolicyRuleApprovalRequired)
+
+		_, err = approve(pendingSpending, approverKeys[1])
+		require.NoError(t, err)
+
+		txid, err = transfer()
+		require.NoError(t, err)
+		require.NotEmpty(t, txid)
+
+		spendings, err = svc.ListSpendings(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, spendings, 3)
+		for _, s := range spendings {
+			require.True(t, s.IsSigned())
+		}
+
+		_, err = approve(pendingSpending, approverKeys[2])
+		require.ErrorIs(t, err, domain.ErrSpendingAlreadySigned)
+
+		violations, err := svc.ListPolicyViolations(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, violations, len(tests)+3)
+
+		// The approvals of a spending are bound to the unsigned tx given by the
+		// caller, therefore they can't be replayed for another one spending the
+		// same amounts.
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		fundingUtxo := randomUtxo(accountNamespace, addrInfo[0].Address)
+		fundingUtxo.Value = 100000000
+		fundingUtxo.Asset = regtest.AssetID
+		_, err = repoManager.UtxoRepository().AddUtxos(
+			ctx, []*domain.Utxo{fundingUtxo},
+		)
+		require.NoError(t, err)
+
+		selectedUtxos, change, _, err := svc.SelectUtxos(
+			ctx, accountName, regtest.AssetID, amount, coinSelectionStrategy, nil,
+		)
+		require.NoError(t, err)
+		inputs := make(application.Inputs, 0, len(selectedUtxos))
+		for _, u := range selectedUtxos {
+			inputs = append(inputs, application.Input{TxID: u.TxID, VOut: u.VOut})
+		}
+		addrInfo, err = repoManager.WalletRepository().
+			DeriveNextInternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		changeScript, _ := hex.DecodeString(addrInfo[0].Script)
+		newPset := func(t *testing.T) string {
+			feeAmount := uint64(500)
+			ptx, err := svc.CreatePset(ctx, inputs, []application.Output{
+				{
+					Asset:       regtest.AssetID,
+					Amount:      amount,
+					Script:      receiverAddrInfo.Script,
+					BlindingKey: receiverAddrInfo.BlindingKey,
+				},
+				{
+					Asset:  regtest.AssetID,
+					Amount: change - feeAmount,
+					Script: changeScript,
+				},
+				{
+					Asset:  regtest.AssetID,
+					Amount: feeAmount,
+				},
+			}, 0)
+			require.NoError(t, err)
+			blindedPtx, err := svc.BlindPset(ctx, ptx, nil, true)
+			require.NoError(t, err)
+			return blindedPtx
+		}
+
+		approvedPtx := newPset(t)
+		signedPtx, err := svc.SignPset(ctx, approvedPtx, 0, false)
+		require.ErrorIs(t, err, application.ErrSpendingApprovalRequired)
+		require.Empty(t, signedPtx)
+		requireViolation(t, domain.PolicyRuleApprovalRequired)
+
+		spendings, err = svc.ListSpendings(ctx, accountName)
+		require.NoError(t, err)
+		pendingSpending = nil
+		for _, s := range spendings {
+			if !s.IsSigned() {
+				pendingSpending = s
+			}
+		}
+		require.NotNil(t, pendingSpending)
+		_, err = approve(pendingSpending, approverKeys[0])
+		require.NoError(t, err)
+		_, err = approve(pendingSpending, approverKeys[1])
+		require.NoError(t, err)
+
+		signedPtx, err = svc.SignPset(ctx, newPset(t), 0, false)
+		require.ErrorIs(t, err, application.ErrSpendingApprovalRequired)
+		require.Empty(t, signedPtx)
+		requireViolation(t, domain.PolicyRuleApprovalRequired)
+
+		signedPtx, err = svc.SignPset(ctx, approvedPtx, 0, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPtx)
+
+		err = svc.DeleteSpendingPolicy(ctx, accountName, "wrong password")
+		require.ErrorIs(t, err, domain.ErrWalletInvalidPassword)
+
+		err = svc.DeleteSpendingPolicy(ctx, accountName, password)
+		require.NoError(t, err)
+
+		err = svc.DeleteSpendingPolicy(ctx, accountName, password)
+		require.ErrorIs(t, err, domain.ErrSpendingPolicyNotFound)
+	})
+}
+
+func testConcurrentSpendings(t *testing.T) {
+	t.Run("concurrent_spendings", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		// Fund the account with enough utxos for every transfer.
+		numOfTransfers := 6
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextExternalAddressesForAccount(
+				ctx, accountName, uint64(numOfTransfers),
+			)
+		require.NoError(t, err)
+		utxos := make([]*domain.Utxo, 0, len(addrInfo))
+		for _, info := range addrInfo {
+			utxo := randomUtxo(accountNamespace, info.Address)
+			utxo.Value = 100000000
+			utxo.Asset = regtest.AssetID
+			utxos = append(utxos, utxo)
+		}
+		_, err = repoManager.UtxoRepository().AddUtxos(ctx, utxos)
+		require.NoError(t, err)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+
+		// The daily limit is enough for 2 transfers only, fees included.
+		amount := uint64(1000000)
+		maxAmountPerDay := 2*amount + amount/2
+		err = svc.SetSpendingPolicy(ctx, domain.SpendingPolicy{
+			AccountName: accountName,
+			AssetLimits: []domain.AssetSpendingLimit{
+				{Asset: regtest.AssetID, MaxAmountPerDay: maxAmountPerDay},
+			},
+		}, password)
+		require.NoError(t, err)
+
+		outs := []application.Output{
+			{
+				Asset:       regtest.AssetID,
+				Amount:      amount,
+				Script:      receiverAddrInfo.Script,
+				BlindingKey: receiverAddrInfo.BlindingKey,
+			},
+		}
+		// Every transfer spends its own reserved utxos, so that they can only be
+		// refused because of the spending policy.
+		leaseIDs := make([]string, 0, numOfTransfers)
+		for i := 0; i < numOfTransfers; i++ {
+			preview, err := svc.PreviewTransfer(
+				ctx, accountName, outs, 100, nil, application.TransferOptions{}, true,
+			)
+			require.NoError(t, err)
+			leaseIDs = append(leaseIDs, preview.Reservation.ID)
+		}
+
+		wg := &sync.WaitGroup{}
+		errs := make([]error, numOfTransfers)
+		for i, leaseID := range leaseIDs {
+			wg.Add(1)
+			go func(i int, leaseID string) {
+				defer wg.Done()
+				_, errs[i] = svc.Transfer(
+					ctx, accountName, outs, 100, nil,
+					application.TransferOptions{LeaseID: leaseID},
+				)
+			}(i, leaseID)
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			require.ErrorIs(t, err, application.ErrSpendingPolicyViolation)
+		}
+		require.Equal(t, 2, succeeded)
+
+		// Whatever the order of the transfers, the daily limit is never exceeded.
+		spendings, err := svc.ListSpendings(ctx, accountName)
+		require.NoError(t, err)
+		var spentAmount uint64
+		for _, s := range spendings {
+			if s.IsSigned() {
+				spentAmount += s.Amounts[regtest.AssetID]
+			}
+		}
+		require.LessOrEqual(t, spentAmount, maxAmountPerDay)
+	})
+}
+
+func testFeeGuard(t *testing.T) {
+	t.Run("fee_guard", func(t *testing.T) {
+		mockedBcScanner := newMockedBcScanner()
+		repoManager, err := newRepoManagerForTxService()
+		require.NoError(t, err)
+		require.NotNil(t, repoManager)
+
+		svc := application.NewTransactionService(
+			repoManager, mockedBcScanner, regtest, utxoExpiryDuration,
+			dustAmount, 0, false,
+		)
+		svc.SetFeeGuard(100000)
+
+		selectedUtxos, change, _, err := svc.SelectUtxos(
+			ctx, accountName, regtest.AssetID, 1000000, coinSelectionStrategy, nil,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, selectedUtxos)
+
+		inputs := make(application.Inputs, 0, len(selectedUtxos))
+		for _, u := range selectedUtxos {
+			inputs = append(inputs, application.Input{TxID: u.TxID, VOut: u.VOut})
+		}
+		addrInfo, err := repoManager.WalletRepository().
+			DeriveNextInternalAddressesForAccount(ctx, accountName, 1)
+		require.NoError(t, err)
+		changeScript, _ := hex.DecodeString(addrInfo[0].Script)
+
+		newPset := func(t *testing.T, feeAmount uint64) string {
+			outs := []application.Output{
+				{
+					Asset:       regtest.AssetID,
+					Amount:      1000000,
+					Script:      receiverAddrInfo.Script,
+					BlindingKey: receiverAddrInfo.BlindingKey,
+				},
+				{
+					Asset:  regtest.AssetID,
+					Amount: change - feeAmount,
+					Script: changeScript,
+				},
+				{
+					Asset:  regtest.AssetID,
+					Amount: feeAmount,
+				},
+			}
+			ptx, err := svc.CreatePset(ctx, inputs, outs, 0)
+			require.NoError(t, err)
+			blindedPtx, err := svc.BlindPset(ctx, ptx, nil, true)
+			require.NoError(t, err)
+			return blindedPtx
+		}
+		toTxHex := func(t *testing.T, ptx string) string {
+			p, err := psetv2.NewPsetFromBase64(ptx)
+			require.NoError(t, err)
+			tx, err := p.UnsignedTx()
+			require.NoError(t, err)
+			txHex, err := tx.ToHex()
+			require.NoError(t, err)
+			return txHex
+		}
+
+		feeAmount, err := svc.EstimateFees(ctx, inputs, outputs[:1], 0)
+		require.NoError(t, err)
+		ptx := newPset(t, feeAmount)
+
+		signedPtx, err := svc.SignPset(ctx, ptx, 0, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPtx)
+
+		signedTx, err := svc.SignTransaction(ctx, toTxHex(t, ptx), 0, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedTx)
+
+		// A fee of 0.1 LBTC is way above the ceiling of 100 sats/byte.
+		ptx = newPset(t, 10000000)
+
+		signedPtx, err = svc.SignPset(ctx, ptx, 0, false)
+		require.ErrorIs(t, err, application.ErrFeeGuardExceeded)
+		require.Empty(t, signedPtx)
+
+		signedTx, err = svc.SignTransaction(ctx, toTxHex(t, ptx), 0, false)
+		require.ErrorIs(t, err, application.ErrFeeGuardExceeded)
+		require.Empty(t, signedTx)
+
+		signedPtx, err = svc.SignPset(ctx, ptx, 0, true)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPtx)
+
+		signedTx, err = svc.SignTransaction(ctx, toTxHex(t, ptx), 0, true)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedTx)
+
+		// Taproot inputs signed with the schnorr key are checked as well.
+		tapPtx := newTaprootPset(t, repoManager, 10000000)
+
+		signedPtx, err = svc.SignPsetWithSchnorrKey(ctx, tapPtx, 0, false)
+		require.ErrorIs(t, err, application.ErrFeeGuardExceeded)
+		require.Empty(t, signedPtx)
+
+		signedPtx, err = svc.SignPsetWithSchnorrKey(ctx, tapPtx, 0, true)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPtx)
+		p, err := psetv2.NewPsetFromBase64(signedPtx)
+		require.NoError(t, err)
+		require.Len(t, p.Inputs[0].TapScriptSig, 1)
+
+		tapPtx = newTaprootPset(t, repoManager, 500)
+
+		signedPtx, err = svc.SignPsetWithSchnorrKey(ctx, tapPtx, 0, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPtx)
+
+		// The transactions crafted by the service are checked as well.
+		transferOuts := []application.Output{
+			{
+				Asset:       regtest.AssetID,
+				Amount:      10000,
+				Script:      receiverAddrInfo.Script,
+				BlindingKey: receiverAddrInfo.BlindingKey,
+			},
+		}
+		txHex, err := svc.Transfer(
+			ctx, accountName, transferOuts, 200000, nil,
+			application.TransferOptions{},
+		)
+		require.ErrorIs(t, err, application.ErrFeeGuardExceeded)
+		require.Empty(t, txHex)
+
+		txHex, err = svc.Transfer(
+			ctx, accountName, transferOuts, 200000, nil,
+			application.TransferOptions{OverrideFeeGuard: true},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, txHex)
+
+		// The fee guard is disabled with a zero ceiling.
+		svc.SetFeeGuard(0)
+
+		signedPtx, err = svc.SignPset(ctx, ptx, 0, false)
+		require.NoError(t, err)
+		require.NotEmpty(t, signedPtx)
+	})
+}
+
+// newTaprootPset returns a partial transaction spending a taproot utxo, whose
+// only leaf is locked by a key of the test account, with the given fee.
+func newTaprootPset(
+	t *testing.T, repoManager ports.RepoManager, feeAmount uint64,
+) string {
+	w, err := repoManager.WalletRepository().GetWallet(ctx)
+	require.NoError(t, err)
+	account, err := w.GetAccount(accountName)
+	require.NoError(t, err)
+
+	accountKey, err := bip32.B58Deserialize(account.Xpub)
+	require.NoError(t, err)
+	serializedKey, err := accountKey.Serialize()
+	require.NoError(t, err)
+	key, err := accountKey.NewChildKey(0)
+	require.NoError(t, err)
+	key, err = key.NewChildKey(0)
+	require.NoError(t, err)
+	pubkey, err := btcec.ParsePubKey(key.Key)
+	require.NoError(t, err)
+
+	leafScript, err := txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(pubkey)).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	require.NoError(t, err)
+	leaf := taproot.NewBaseTapElementsLeaf(leafScript)
+	tree := taproot.AssembleTaprootScriptTree(leaf)
+	internalKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	taprootPay, err := payment.FromTaprootScriptTree(
+		internalKey.PubKey(), tree, regtest, nil,
+	)
+	require.NoError(t, err)
+
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs: []wallet.Input{{
+			TxID:    randomHex(32),
+			TxIndex: randomVout(),
+			Value:   100000000,
+			Asset:   regtest.AssetID,
+			Script:  taprootPay.Script,
+		}},
+		Outputs: []wallet.Output{
+			{
+				Asset:  regtest.AssetID,
+				Amount: 100000000 - feeAmount,
+				Script: receiverAddrInfo.Script,
+			},
+			{
+				Asset:  regtest.AssetID,
+				Amount: feeAmount,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	pset, err := psetv2.NewPsetFromBase64(ptx)
+	require.NoError(t, err)
+	pset.Global.Xpubs = []psetv2.Xpub{{
+		ExtendedKey:       serializedKey[:len(serializedKey)-4],
+		MasterFingerprint: binary.LittleEndian.Uint32(accountKey.FingerPrint),
+		DerivationPath:    []uint32{account.Index + bip32.FirstHardenedChild},
+	}}
+	updater, err := psetv2.NewUpdater(pset)
+	require.NoError(t, err)
+	leafHash := leaf.TapHash()
+	err = updater.AddInTapBip32Derivation(0, psetv2.TapDerivationPathWithPubKey{
+		DerivationPathWithPubKey: psetv2.DerivationPathWithPubKey{
+			PubKey:               pubkey.SerializeCompressed(),
+			MasterKeyFingerprint: binary.LittleEndian.Uint32(accountKey.FingerPrint),
+			Bip32Path:            []uint32{0, 0},
+		},
+		LeafHashes: [][]byte{leafHash[:]},
+	})
+	require.NoError(t, err)
+
+	ptx, err = pset.ToBase64()
+	require.NoError(t, err)
+	return ptx
+}
+
+func feeAmountFromTx(tx *transaction.Transaction) uint64 {
+	for _, out := range tx.Outputs {
+		if len(out.Script) <= 0 {
+			value, _ := elementsutil.ValueFromBytes(out.Value)
+			return value
+		}
+	}
+	return 0
+}
+
+func randomConfidentialUtxo(
+	accountName, addr, asset string, value uint64,
+) *domain.Utxo {
+	utxo := randomUtxo(accountName, addr)
+	assetBytes, _ := elementsutil.TxIDToBytes(asset)
+	assetCommitment, _ := confidential.AssetCommitment(
+		assetBytes, utxo.AssetBlinder,
+	)
+	valueCommitment, _ := confidential.ValueCommitment(
+		value, assetCommitment, utxo.ValueBlinder,
+	)
+	utxo.Asset = asset
+	utxo.Value = value
+	utxo.AssetCommitment = assetCommitment
+	utxo.ValueCommitment = valueCommitment
+	return utxo
 }
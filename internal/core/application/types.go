@@ -5,28 +5,66 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/ocean/internal/core/domain"
 	"github.com/vulpemventures/ocean/internal/core/ports"
+	bnb_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/branch-bound"
+	fragment_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/fragment"
 	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
 	wallet "github.com/vulpemventures/ocean/pkg/wallet"
 )
 
 const (
 	CoinSelectionStrategySmallestSubset = iota
+	CoinSelectionStrategyBranchAndBound
+	CoinSelectionStrategyFragment
 )
 
 var (
 	coinSelectorByType = map[int]CoinSelectorFactory{
-		CoinSelectionStrategySmallestSubset: ss_selector.NewSmallestSubsetCoinSelector,
+		CoinSelectionStrategySmallestSubset: func(string, uint64, bool) ports.CoinSelector {
+			return ss_selector.NewSmallestSubsetCoinSelector()
+		},
+		CoinSelectionStrategyBranchAndBound: bnb_selector.NewBranchAndBoundCoinSelector,
+		CoinSelectionStrategyFragment: func(string, uint64, bool) ports.CoinSelector {
+			return fragment_selector.NewFragmentCoinSelector()
+		},
 	}
 
 	DefaultCoinSelector = ss_selector.NewSmallestSubsetCoinSelector()
 	MinMillisatsPerByte = uint64(100)
+	MaxFragments        = uint32(100)
+	// DefaultConfTarget is the number of blocks within which a tx is expected
+	// to confirm when estimating the fee rate.
+	DefaultConfTarget = uint32(2)
 )
 
+// ConsolidationConfig holds the options of the background job that
+// periodically consolidates the small utxos of an account.
+// See TransactionService.Consolidate for the meaning of the options.
+type ConsolidationConfig struct {
+	Interval            time.Duration
+	AccountName         string
+	Asset               string
+	MaxInputs           uint32
+	MaxUtxoValue        uint64
+	MillisatsPerByte    uint64
+	MaxMillisatsPerByte uint64
+}
+
+// BatchingConfig holds the options of the background job that periodically
+// sends the queued payments of every account with a single batched tx.
+// The queue of an account is also flushed as soon as it reaches MaxPayments,
+// if set. MillisatsPerByte is estimated if not set.
+type BatchingConfig struct {
+	Interval         time.Duration
+	MaxPayments      uint32
+	MillisatsPerByte uint64
+}
+
 type WalletStatus struct {
 	IsInitialized bool
 	IsUnlocked    bool
@@ -57,10 +95,124 @@ type BuildInfo struct {
 type UtxoInfo struct {
 	Spendable Utxos
 	Locked    Utxos
+	Frozen    Utxos
 }
 
 type TransactionInfo domain.Transaction
 
+// HistoryEntry is a row of an account's history. There's one entry for
+// every asset moved by a transaction involving the account. Amount is the net
+// amount received (positive) or sent (negative) by the account, while Fee is
+// set only for the entry of the fee asset when the account funded the tx.
+// Counterparty is known only for outgoing transactions, unless the tx is an
+// internal transfer between accounts of the wallet, in which case it's the
+// label of the other account and Internal is true.
+type HistoryEntry struct {
+	Timestamp    int64
+	TxID         string
+	BlockHeight  uint64
+	Asset        string
+	Amount       int64
+	Fee          uint64
+	Label        string
+	Counterparty string
+	Internal     bool
+}
+
+// Lease groups the utxos locked together by a single lock operation. Its ID
+// is required to release the utxos or to extend the lock before it expires.
+type Lease struct {
+	ID              string
+	AccountName     string
+	Utxos           UtxoKeys
+	LockTimestamp   int64
+	ExpiryTimestamp int64
+}
+
+func newLease(utxos []*domain.Utxo) *Lease {
+	if len(utxos) <= 0 {
+		return nil
+	}
+
+	lease := &Lease{
+		ID:              utxos[0].LeaseID,
+		AccountName:     utxos[0].AccountName,
+		Utxos:           make(UtxoKeys, 0, len(utxos)),
+		LockTimestamp:   utxos[0].LockTimestamp,
+		ExpiryTimestamp: utxos[0].LockExpiryTimestamp,
+	}
+	for _, u := range utxos {
+		lease.Utxos = append(lease.Utxos, u.Key())
+		if u.LockExpiryTimestamp > lease.ExpiryTimestamp {
+			lease.ExpiryTimestamp = u.LockExpiryTimestamp
+		}
+	}
+	return lease
+}
+
+// SwapOffer is one side of an atomic swap together with the partial
+// transaction and the unblinded inputs of the account to pass to the
+// counterparty, and the expiration of the lease locking them.
+type SwapOffer struct {
+	domain.Swap
+	Pset            string
+	UnblindedInputs []UnblindedInput
+	ExpiryTimestamp int64
+}
+
+// Fragment is a partial transaction spending a single utxo in exchange for
+// the output at the same index, signed with SIGHASH_SINGLE|ANYONECANPAY so
+// that anyone can fill the order by merging it into a transaction.
+// The input is revealed and, if the output is blinded, so are its blinders to
+// let whoever completes the transaction blind the other outputs.
+type Fragment struct {
+	Pset                string
+	UnblindedInput      UnblindedInput
+	OutputAssetBlinder  string
+	OutputAmountBlinder string
+	LeaseID             string
+	ExpiryTimestamp     int64
+}
+
+// DecodedPset tells what a partial transaction does to the wallet: its inputs
+// and outputs, revealed and attributed to the owner accounts where possible,
+// the net change of the balance of each account by asset, the network fees
+// and whether all the outputs to blind are blinded.
+type DecodedPset struct {
+	Inputs             []DecodedInput
+	Outputs            []DecodedOutput
+	NetAmountByAccount map[string]map[string]int64
+	FeeAmount          uint64
+	BlindingComplete   bool
+}
+
+// DecodedInput is an input of a decoded partial transaction. The account name
+// is empty if the input isn't owned by the wallet, and the asset and amount
+// are empty if they can't be revealed.
+type DecodedInput struct {
+	TxID         string
+	VOut         uint32
+	AccountName  string
+	Asset        string
+	Amount       uint64
+	Confidential bool
+	SighashType  uint32
+	Signed       bool
+	Finalized    bool
+}
+
+// DecodedOutput is an output of a decoded partial transaction. The account
+// name is empty if the output isn't owned by the wallet, and the asset and
+// amount are empty if they can't be revealed.
+type DecodedOutput struct {
+	Script       string
+	AccountName  string
+	Asset        string
+	Amount       uint64
+	Confidential bool
+	Blinded      bool
+}
+
 type BlockInfo struct {
 	Hash      []byte
 	Height    uint32
@@ -83,6 +235,38 @@ func (info AddressesInfo) Addresses() []string {
 
 type BalanceInfo map[string]*domain.Balance
 
+// newBalanceInfo returns the balance of the given utxos, where only the
+// spendable ones with at least minConfirmations are counted as confirmed.
+// Spent utxos are skipped.
+func newBalanceInfo(
+	utxos []*domain.Utxo, tipHeight uint64, minConfirmations uint32,
+) BalanceInfo {
+	balance := make(BalanceInfo)
+	for _, u := range utxos {
+		if u.IsSpent() {
+			continue
+		}
+		if _, ok := balance[u.Asset]; !ok {
+			balance[u.Asset] = &domain.Balance{}
+		}
+		b := balance[u.Asset]
+		if u.IsFrozen() {
+			b.Frozen += u.Value
+			continue
+		}
+		if u.IsLocked() {
+			b.Locked += u.Value
+			continue
+		}
+		if u.Confirmations(tipHeight) >= uint64(minConfirmations) {
+			b.Confirmed += u.Value
+		} else {
+			b.Unconfirmed += u.Value
+		}
+	}
+	return balance
+}
+
 type Utxos []*domain.Utxo
 
 func (u Utxos) Keys() []domain.UtxoKey {
@@ -93,6 +277,28 @@ func (u Utxos) Keys() []domain.UtxoKey {
 	return keys
 }
 
+func (u Utxos) toWalletInputs() []wallet.Input {
+	inputs := make([]wallet.Input, 0, len(u))
+	for _, utxo := range u {
+		// The txs crafted by the wallet can be replaced to bump their fees.
+		sequence := wallet.RBFSequence
+		inputs = append(inputs, wallet.Input{
+			TxID:            utxo.TxID,
+			TxIndex:         utxo.VOut,
+			Value:           utxo.Value,
+			Asset:           utxo.Asset,
+			Script:          utxo.Script,
+			ValueBlinder:    utxo.ValueBlinder,
+			AssetBlinder:    utxo.AssetBlinder,
+			ValueCommitment: utxo.ValueCommitment,
+			AssetCommitment: utxo.AssetCommitment,
+			Nonce:           utxo.Nonce,
+			Sequence:        &sequence,
+		})
+	}
+	return inputs
+}
+
 func (u Utxos) Info() []domain.UtxoInfo {
 	info := make([]domain.UtxoInfo, 0, len(u))
 	for _, utxo := range u {
@@ -101,6 +307,20 @@ func (u Utxos) Info() []domain.UtxoInfo {
 	return info
 }
 
+// WithMinConfirmations returns the subset of utxos that have at least the
+// given number of confirmations.
+func (u Utxos) WithMinConfirmations(
+	tipHeight uint64, minConfirmations uint32,
+) Utxos {
+	utxos := make(Utxos, 0, len(u))
+	for _, utxo := range u {
+		if utxo.Confirmations(tipHeight) >= uint64(minConfirmations) {
+			utxos = append(utxos, utxo)
+		}
+	}
+	return utxos
+}
+
 type UtxosInfo []domain.UtxoInfo
 
 func (u UtxosInfo) Keys() []domain.UtxoKey {
@@ -127,6 +347,9 @@ type Input struct {
 	Script        string
 	ScriptSigSize int
 	WitnessSize   int
+	// Sequence is the optional nSequence of the input, for example to set a
+	// relative timelock.
+	Sequence *uint32
 }
 
 func (i Input) toUtxoKey() domain.UtxoKey {
@@ -185,7 +408,9 @@ func (i Inputs) Keys() []domain.UtxoKey {
 
 type Outputs []Output
 
-type CoinSelectorFactory func() ports.CoinSelector
+type CoinSelectorFactory func(
+	feeAsset string, millisatsPerByte uint64, discountCT bool,
+) ports.CoinSelector
 
 func (o Outputs) totalAmountByAsset() map[string]uint64 {
 	totAmount := make(map[string]uint64)
@@ -203,6 +428,146 @@ func (o Outputs) toWalletOutputs() []wallet.Output {
 	return outs
 }
 
+// TransferOptions are the optional settings of a transfer.
+// Inputs are the utxos to spend; if AllowExtraInputs is true, other ones can
+// be selected in case they're not enough.
+// LeaseID identifies the utxos reserved by a previous dry-run, all spent by
+// the transfer in addition to the given inputs.
+// LockTime is the optional nLockTime of the tx, either a block height or a
+// timestamp.
+// If OverrideFeeGuard is true, the tx is signed even if its effective fee rate
+// exceeds the ceiling of the fee guard.
+type TransferOptions struct {
+	Inputs           Inputs
+	AllowExtraInputs bool
+	SendAll          bool
+	SubtractFee      bool
+	LeaseID          string
+	LockTime         uint32
+	OverrideFeeGuard bool
+
+	// replaced is set when the transfer replaces an unconfirmed tx.
+	replaced *replacedTx
+}
+
+// replacedTx holds the utxos spent by an unconfirmed tx that a transfer
+// replaces. These are all spent by the transfer, while those created by the
+// replaced tx are never selected.
+type replacedTx struct {
+	txid  string
+	utxos []*domain.Utxo
+}
+
+// TransferPreview describes the transaction that a transfer would craft.
+// Pset is unsigned and unblinded, while the change outputs are sent to
+// addresses not yet derived by the account. Reservation is set only if the
+// selected utxos have been locked to be spent by a later transfer.
+type TransferPreview struct {
+	Pset          string
+	Inputs        Utxos
+	ChangeOutputs Outputs
+	FeeAmount     uint64
+	Reservation   *Lease
+}
+
+// transferPlan holds what's required to craft a transfer transaction. Outs
+// include the change and fee outputs.
+type transferPlan struct {
+	utxos         []*domain.Utxo
+	inputs        []wallet.Input
+	outs          []wallet.Output
+	changeOutputs []wallet.Output
+	feeAmount     uint64
+	lockTime      uint32
+}
+
+// setTimelocks sets the locktime of the plan and overrides the sequence of
+// its inputs with those given for the chosen ones, if any.
+func (p *transferPlan) setTimelocks(ins Inputs, lockTime uint32) {
+	p.lockTime = lockTime
+	for _, in := range ins {
+		if in.Sequence == nil {
+			continue
+		}
+		for i, walletIn := range p.inputs {
+			if walletIn.TxID == in.TxID && walletIn.TxIndex == in.VOut {
+				p.inputs[i].Sequence = in.Sequence
+			}
+		}
+	}
+}
+
+func (p *transferPlan) toPreview() *TransferPreview {
+	changeOutputs := make(Outputs, 0, len(p.changeOutputs))
+	for _, out := range p.changeOutputs {
+		changeOutputs = append(changeOutputs, Output(out))
+	}
+	return &TransferPreview{
+		Inputs:        p.utxos,
+		ChangeOutputs: changeOutputs,
+		FeeAmount:     p.feeAmount,
+	}
+}
+
+// receivers returns the outs of the plan that are neither change nor fees.
+func (p *transferPlan) receivers() []domain.TransferReceiver {
+	changeScripts := make(map[string]struct{})
+	for _, out := range p.changeOutputs {
+		changeScripts[hex.EncodeToString(out.Script)] = struct{}{}
+	}
+
+	receivers := make([]domain.TransferReceiver, 0, len(p.outs))
+	for _, out := range p.outs {
+		if len(out.Script) <= 0 {
+			continue
+		}
+		if _, ok := changeScripts[hex.EncodeToString(out.Script)]; ok {
+			continue
+		}
+		receivers = append(receivers, domain.TransferReceiver{
+			Asset:       out.Asset,
+			Amount:      out.Amount,
+			Script:      out.Script,
+			BlindingKey: out.BlindingKey,
+		})
+	}
+	return receivers
+}
+
+// spendingSummary is what a transaction about to be signed does to the
+// accounts of the wallet, as required to check it against their spending
+// policies. Destinations are the hex scripts of the outputs not owned by the
+// wallet, and the size is the estimated virtual one of the final tx.
+type spendingSummary struct {
+	netAmountByAccount map[string]map[string]int64
+	destinations       []string
+	feeAmount          uint64
+	txSize             uint64
+	// txid is the unsigned txid of a tx given by the caller, to which the
+	// approvals of its spendings are bound. It's empty for the txs crafted by
+	// the wallet since a new one is crafted at every attempt.
+	txid string
+}
+
+func (s *spendingSummary) addNetAmount(accountName, asset string, amount int64) {
+	if accountName == "" || asset == "" {
+		return
+	}
+	if _, ok := s.netAmountByAccount[accountName]; !ok {
+		s.netAmountByAccount[accountName] = make(map[string]int64)
+	}
+	s.netAmountByAccount[accountName][asset] += amount
+}
+
+// feeRate returns the effective mSats/byte fee ratio paid by the tx, or 0 if
+// its size is unknown.
+func (s *spendingSummary) feeRate() uint64 {
+	if s.txSize <= 0 {
+		return 0
+	}
+	return s.feeAmount * 1000 / s.txSize
+}
+
 type transactionQueue struct {
 	lock                *sync.RWMutex
 	transactions        []*domain.Transaction
@@ -236,9 +601,10 @@ func (q *transactionQueue) pushBack(newTx *domain.Transaction) {
 		if tx.TxID == newTx.TxID {
 			for _, account := range newTx.GetAccounts() {
 				q.transactions[i].AddAccount(account)
-				q.transactions[i].BlockHash = newTx.BlockHash
-				q.transactions[i].BlockHeight = newTx.BlockHeight
 			}
+			q.transactions[i].BlockHash = newTx.BlockHash
+			q.transactions[i].BlockHeight = newTx.BlockHeight
+			q.transactions[i].BlockTime = newTx.BlockTime
 		}
 	}
 }
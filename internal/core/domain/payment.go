@@ -0,0 +1,28 @@
+package domain
+
+// Payment is a payout request queued to be sent, along with the others of the
+// same account, with a single batched transaction.
+// A payment being sent is bound to the batched transaction before it's
+// broadcasted, while one that can't be sent at all is marked as failed.
+type Payment struct {
+	ID            string
+	AccountName   string
+	Asset         string
+	Amount        uint64
+	Script        []byte
+	BlindingKey   []byte
+	Timestamp     int64
+	TxID          string
+	FailureReason string
+}
+
+// IsSending returns whether the payment is bound to a transaction not yet
+// known to be broadcasted.
+func (p *Payment) IsSending() bool {
+	return p.TxID != ""
+}
+
+// IsFailed returns whether the payment has been rejected and won't be sent.
+func (p *Payment) IsFailed() bool {
+	return p.FailureReason != ""
+}
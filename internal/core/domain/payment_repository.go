@@ -0,0 +1,57 @@
+package domain
+
+import "context"
+
+const (
+	PaymentsFlushed PaymentEventType = iota
+)
+
+var (
+	paymentTypeString = map[PaymentEventType]string{
+		PaymentsFlushed: "PaymentsFlushed",
+	}
+)
+
+type PaymentEventType int
+
+func (t PaymentEventType) String() string {
+	return paymentTypeString[t]
+}
+
+// PaymentEvent holds info about an event occured within the repository.
+type PaymentEvent struct {
+	EventType PaymentEventType
+	TxID      string
+	Payments  []Payment
+}
+
+// PaymentRepository is the abstraction for any kind of database intended
+// to persist the queue of Payments.
+type PaymentRepository interface {
+	// AddPayment adds the provided payment to the queue by preventing
+	// duplicates.
+	AddPayment(ctx context.Context, payment *Payment) (bool, error)
+	// GetQueuedPayments returns the list of all queued Payments, from the
+	// oldest to the newest.
+	GetQueuedPayments(ctx context.Context) ([]*Payment, error)
+	// DeletePayment removes the Payment identified by the given id from the
+	// queue.
+	DeletePayment(ctx context.Context, id string) (bool, error)
+	// MarkPaymentsSending binds the Payments identified by the given ids to the
+	// tx identified by the given txid before it's broadcasted. An empty txid
+	// brings them back to the queue.
+	MarkPaymentsSending(
+		ctx context.Context, ids []string, txid string,
+	) (int, error)
+	// MarkPaymentsFailed marks the Payments identified by the given ids as
+	// failed for the given reason, they are kept in queue but not sent anymore.
+	MarkPaymentsFailed(
+		ctx context.Context, ids []string, reason string,
+	) (int, error)
+	// FlushPayments removes the Payments identified by the given ids from the
+	// queue since they've been sent with the tx identified by the given txid.
+	// Generates a PaymentsFlushed event if successful.
+	FlushPayments(ctx context.Context, ids []string, txid string) (int, error)
+	// GetEventChannel returns the channel of PaymentEvents.
+	GetEventChannel() chan PaymentEvent
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	PolicyRuleMaxAmountPerTx        PolicyRule = "max_amount_per_tx"
+	PolicyRuleMaxAmountPerDay       PolicyRule = "max_amount_per_day"
+	PolicyRuleDeniedDestination     PolicyRule = "denied_destination"
+	PolicyRuleDestinationNotAllowed PolicyRule = "destination_not_allowed"
+	PolicyRuleMaxFeeRate            PolicyRule = "max_fee_rate"
+	PolicyRuleMaxFeeAmount          PolicyRule = "max_fee_amount"
+	PolicyRuleApprovalRequired      PolicyRule = "approval_required"
+)
+
+var (
+	ErrSpendingPolicyNotFound  = fmt.Errorf("spending policy not found")
+	ErrSpendingNotFound        = fmt.Errorf("spending not found")
+	ErrSpendingAlreadyApproved = fmt.Errorf("spending already approved by the given approver")
+	ErrSpendingAlreadySigned   = fmt.Errorf("spending already signed")
+)
+
+// PolicyRule identifies one of the rules of a SpendingPolicy.
+type PolicyRule string
+
+// SpendingPolicy is the set of rules that any outgoing transaction of an
+// account must comply with before being signed. Any zero value disables the
+// related rule. Scripts are hex encoded, while MaxFeeRate is expressed in
+// millisats per byte. Approvers are the hex encoded compressed public keys of
+// who is entitled to approve the spendings of the account.
+type SpendingPolicy struct {
+	AccountName       string
+	AssetLimits       []AssetSpendingLimit
+	AllowedScripts    []string
+	DeniedScripts     []string
+	MaxFeeRate        uint64
+	MaxFeeAmount      uint64
+	RequiredApprovals uint32
+	Approvers         []string
+}
+
+// IsApprover returns whether the given public key is one of the approvers of
+// the policy.
+func (p *SpendingPolicy) IsApprover(pubkey string) bool {
+	for _, approver := range p.Approvers {
+		if approver == pubkey {
+			return true
+		}
+	}
+	return false
+}
+
+// AssetSpendingLimit defines how much of an asset an account can spend with a
+// single transaction and within a rolling day. Spending more than
+// ApprovalThreshold with a single transaction requires the approval of
+// SpendingPolicy.RequiredApprovals approvers.
+type AssetSpendingLimit struct {
+	Asset             string
+	MaxAmountPerTx    uint64
+	MaxAmountPerDay   uint64
+	ApprovalThreshold uint64
+}
+
+// Spending is an outgoing transaction of an account subject to a spending
+// policy. A spending is identified across several signing attempts by its
+// Digest, which commits to the spent amounts and to the destinations.
+// It's pending until signed, and once signed, it counts towards the daily
+// limits of the account. Approvers are the public keys of the approvers that
+// signed the Digest.
+type Spending struct {
+	ID          string
+	AccountName string
+	Digest      string
+	Amounts     map[string]uint64
+	Approvers   []string
+	CreatedAt   int64
+	SignedAt    int64
+}
+
+// Approve adds the given approver to the spending.
+func (s *Spending) Approve(approver string) error {
+	if s.IsSigned() {
+		return ErrSpendingAlreadySigned
+	}
+	for _, a := range s.Approvers {
+		if a == approver {
+			return ErrSpendingAlreadyApproved
+		}
+	}
+	s.Approvers = append(s.Approvers, approver)
+	return nil
+}
+
+// Sign marks the spending as signed.
+func (s *Spending) Sign() {
+	if s.IsSigned() {
+		return
+	}
+	s.SignedAt = time.Now().Unix()
+}
+
+// IsSigned returns whether the spending has been signed.
+func (s *Spending) IsSigned() bool {
+	return s.SignedAt > 0
+}
+
+// PolicyViolation is the audit record of an attempt to sign a transaction
+// breaking a rule of the spending policy of an account.
+type PolicyViolation struct {
+	ID          string
+	AccountName string
+	Rule        PolicyRule
+	Reason      string
+	Timestamp   int64
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "context"
+
+const (
+	PolicyViolated PolicyEventType = iota
+)
+
+var (
+	policyTypeString = map[PolicyEventType]string{
+		PolicyViolated: "PolicyViolated",
+	}
+)
+
+type PolicyEventType int
+
+func (t PolicyEventType) String() string {
+	return policyTypeString[t]
+}
+
+// PolicyEvent holds info about an event occured within the repository.
+type PolicyEvent struct {
+	EventType PolicyEventType
+	Violation PolicyViolation
+}
+
+// SpendingPolicyRepository is the abstraction for any kind of database
+// intended to persist the SpendingPolicies of the accounts, along with their
+// Spendings and the audit log of PolicyViolations.
+type SpendingPolicyRepository interface {
+	// SetPolicy adds the given policy to the repository or replaces the one of
+	// the same account.
+	SetPolicy(ctx context.Context, policy *SpendingPolicy) error
+	// GetPolicy returns the SpendingPolicy of the given account or
+	// ErrSpendingPolicyNotFound.
+	GetPolicy(ctx context.Context, accountName string) (*SpendingPolicy, error)
+	// DeletePolicy removes the SpendingPolicy of the given account.
+	DeletePolicy(ctx context.Context, accountName string) (bool, error)
+	// AddSpending adds the provided spending to the repository by preventing
+	// duplicates.
+	AddSpending(ctx context.Context, spending *Spending) (bool, error)
+	// GetSpending returns the Spending identified by the given id or
+	// ErrSpendingNotFound.
+	GetSpending(ctx context.Context, id string) (*Spending, error)
+	// GetSpendingsForAccount returns the list of Spendings of the given
+	// account created since the given unix timestamp, from the oldest to the
+	// newest.
+	GetSpendingsForAccount(
+		ctx context.Context, accountName string, since int64,
+	) ([]*Spending, error)
+	// UpdateSpending allows to commit multiple changes to the same Spending in
+	// a transactional way.
+	UpdateSpending(
+		ctx context.Context, id string,
+		updateFn func(s *Spending) (*Spending, error),
+	) error
+	// AddViolation adds the given violation to the audit log.
+	// Generates a PolicyViolated event if successful.
+	AddViolation(ctx context.Context, violation *PolicyViolation) error
+	// GetViolationsForAccount returns the audit log of the PolicyViolations
+	// of the given account, from the oldest to the newest.
+	GetViolationsForAccount(
+		ctx context.Context, accountName string,
+	) ([]*PolicyViolation, error)
+	// GetEventChannel returns the channel of PolicyEvents.
+	GetEventChannel() chan PolicyEvent
+}
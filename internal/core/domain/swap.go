@@ -0,0 +1,16 @@
+package domain
+
+// Swap is one side of an atomic swap, where an account trades an amount of an
+// asset for an amount of another one with a counterparty. The utxos spent by
+// the account are locked by the lease with the same id of the swap until this
+// is completed or expires. FeeAmount is the lbtc amount paid by the account
+// for the network fees, if any.
+type Swap struct {
+	ID          string
+	AccountName string
+	GiveAsset   string
+	GiveAmount  uint64
+	WantAsset   string
+	WantAmount  uint64
+	FeeAmount   uint64
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// SwapRepository is the abstraction for any kind of database intended to
+// persist Swaps.
+type SwapRepository interface {
+	// AddSwap adds the provided swap to the repository by preventing
+	// duplicates.
+	AddSwap(ctx context.Context, swap *Swap) (bool, error)
+	// GetSwap returns the Swap identified by the given id.
+	GetSwap(ctx context.Context, id string) (*Swap, error)
+	// DeleteSwap removes the Swap identified by the given id.
+	DeleteSwap(ctx context.Context, id string) (bool, error)
+}
@@ -3,12 +3,14 @@ package domain
 // Transaction is the data structure representing an Elements tx with extra
 // info like whether it is conifirmed/unconfirmed and the name of the accounts
 // owning one or more of its inputs.
+// Internal marks the txs moving funds between accounts of the wallet.
 type Transaction struct {
 	TxID        string
 	TxHex       string
 	BlockHash   string
 	BlockHeight uint64
 	BlockTime   int64
+	Internal    bool
 	Accounts    map[string]struct{}
 }
 
@@ -30,6 +32,18 @@ func (t *Transaction) Confirm(
 	t.BlockTime = blockTime
 }
 
+// Unconfirm resets the block info of a confirmed tx, for example in case the
+// block including it has been orphaned by a chain reorg.
+func (t *Transaction) Unconfirm() {
+	if !t.IsConfirmed() {
+		return
+	}
+
+	t.BlockHash = ""
+	t.BlockHeight = 0
+	t.BlockTime = 0
+}
+
 // AddAccount adds the given account to the map of those involved in the tx.
 func (t *Transaction) AddAccount(accountName string) {
 	if t.Accounts == nil {
@@ -6,6 +6,7 @@ const (
 	TransactionAdded TransactionEventType = iota
 	TransactionUnconfirmed
 	TransactionConfirmed
+	TransactionDeleted
 )
 
 var (
@@ -13,6 +14,7 @@ var (
 		TransactionAdded:       "TransactionAdded",
 		TransactionUnconfirmed: "TransactionUnconfirmed",
 		TransactionConfirmed:   "TransactionConfirmed",
+		TransactionDeleted:     "TransactionDeleted",
 	}
 )
 
@@ -42,8 +44,27 @@ type TransactionRepository interface {
 		ctx context.Context,
 		txid, blockHash string, blockheight uint64, blocktime int64,
 	) (bool, error)
+	// UnconfirmTransaction resets the block info of the Transaction identified
+	// by the given txid.
+	// Generates a TransactionUnconfirmed event if successful.
+	UnconfirmTransaction(ctx context.Context, txid string) (bool, error)
+	// DeleteTransaction removes the Transaction identified by the given txid,
+	// for example when it's evicted from mempool.
+	// Generates a TransactionDeleted event if successful.
+	DeleteTransaction(ctx context.Context, txid string) (bool, error)
 	// GetTransaction returns the Transaction identified by the given txid.
 	GetTransaction(ctx context.Context, txid string) (*Transaction, error)
+	// GetTransactionsForAccount returns the list of all Transactions involving
+	// the given account.
+	GetTransactionsForAccount(
+		ctx context.Context, accountName string,
+	) ([]*Transaction, error)
+	// GetTransactionsForAccountPage returns at most limit Transactions
+	// involving the given account, skipping the first offset ones. They are
+	// sorted by block height, with the unconfirmed ones last, and then by txid.
+	GetTransactionsForAccountPage(
+		ctx context.Context, accountName string, offset, limit int,
+	) ([]*Transaction, error)
 	// UpdateTransaction allows to commit multiple changes to the same
 	// Transaction in a transactional way.
 	UpdateTransaction(
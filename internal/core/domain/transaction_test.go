@@ -16,6 +16,17 @@ func TestConfirmTransaction(t *testing.T) {
 	require.True(t, tx.IsConfirmed())
 }
 
+func TestUnconfirmTransaction(t *testing.T) {
+	tx := &domain.Transaction{}
+	tx.Confirm("fa84eb6806daf1b3c495ed30554d80573a39335b2993b66b3cc1afaa53816e47", 1728312, time.Now().Unix())
+	require.True(t, tx.IsConfirmed())
+
+	tx.Unconfirm()
+	require.False(t, tx.IsConfirmed())
+	require.Zero(t, tx.BlockHeight)
+	require.Zero(t, tx.BlockTime)
+}
+
 func TestAddAccounts(t *testing.T) {
 	tx := &domain.Transaction{}
 	accounts := tx.GetAccounts()
@@ -0,0 +1,19 @@
+package domain
+
+// Transfer holds the info about a transaction crafted by the wallet that
+// can't be retrieved from the transaction itself once blinded, like the
+// amounts and blinding keys of its receivers. These are required to rebuild
+// the tx, for example to replace it with one paying higher fees.
+type Transfer struct {
+	TxID        string
+	AccountName string
+	Receivers   []TransferReceiver
+}
+
+// TransferReceiver is an output of a Transfer other than change and fees.
+type TransferReceiver struct {
+	Asset       string
+	Amount      uint64
+	Script      []byte
+	BlindingKey []byte
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// TransferRepository is the abstraction for any kind of database intended
+// to persist Transfers.
+type TransferRepository interface {
+	// AddTransfer adds the provided transfer to the repository by preventing
+	// duplicates.
+	AddTransfer(ctx context.Context, transfer *Transfer) (bool, error)
+	// GetTransfer returns the Transfer identified by the given txid.
+	GetTransfer(ctx context.Context, txid string) (*Transfer, error)
+	// DeleteTransfer removes the Transfer identified by the given txid.
+	DeleteTransfer(ctx context.Context, txid string) (bool, error)
+}
@@ -10,6 +10,9 @@ import (
 
 var (
 	ErrUtxoAlreadyLocked = fmt.Errorf("utxo is already locked")
+	ErrUtxoNotLocked     = fmt.Errorf("utxo is not locked")
+	ErrUtxoLeaseMismatch = fmt.Errorf("utxo is locked by another lease")
+	ErrUtxoFrozen        = fmt.Errorf("utxo is frozen")
 )
 
 // UtxoKey represents the key of an Utxo, composed by its txid and vout.
@@ -40,6 +43,7 @@ type UtxoInfo struct {
 	AccountName     string
 	SpentStatus     UtxoStatus
 	ConfirmedStatus UtxoStatus
+	FreezeReason    string
 }
 
 func (i UtxoInfo) Key() UtxoKey {
@@ -51,6 +55,7 @@ type Balance struct {
 	Confirmed   uint64
 	Unconfirmed uint64
 	Locked      uint64
+	Frozen      uint64
 }
 
 func (b *Balance) Total() uint64 {
@@ -65,8 +70,8 @@ type UtxoStatus struct {
 }
 
 // Utxo is the data structure representing an Elements UTXO with extra info
-// like whether it is spent/utxo, confirmed/unconfirmed or locked/unlocked and
-// the name of the account owning it.
+// like whether it is spent/utxo, confirmed/unconfirmed, locked/unlocked or
+// frozen and the name of the account owning it.
 type Utxo struct {
 	UtxoKey
 	Value               uint64
@@ -82,6 +87,9 @@ type Utxo struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 	SpentStatus         UtxoStatus
 	ConfirmedStatus     UtxoStatus
 }
@@ -101,6 +109,15 @@ func (u *Utxo) IsConfirmed() bool {
 	return u.ConfirmedStatus != UtxoStatus{}
 }
 
+// Confirmations returns the number of confirmations of the utxo given the
+// height of the current chain tip. Unconfirmed utxos have 0 confirmations.
+func (u *Utxo) Confirmations(tipHeight uint64) uint64 {
+	if !u.IsConfirmed() || u.ConfirmedStatus.BlockHeight > tipHeight {
+		return 0
+	}
+	return tipHeight - u.ConfirmedStatus.BlockHeight + 1
+}
+
 // IsConfidential returns whether the utxo is a confidential one.
 func (u *Utxo) IsConfidential() bool {
 	return len(u.ValueCommitment) > 0 && len(u.AssetCommitment) > 0
@@ -117,6 +134,11 @@ func (u *Utxo) IsLocked() bool {
 	return u.LockTimestamp > 0
 }
 
+// IsFrozen returns whether the utxo is frozen.
+func (u *Utxo) IsFrozen() bool {
+	return u.FrozenTimestamp > 0
+}
+
 // CanUnlock reutrns whether a locked utxo can be unlocked.
 func (u *Utxo) CanUnlock() bool {
 	if !u.IsLocked() {
@@ -134,7 +156,7 @@ func (u *Utxo) Key() UtxoKey {
 func (u *Utxo) Info() UtxoInfo {
 	return UtxoInfo{
 		u.Key(), u.Value, u.Asset, u.Script, u.ValueBlinder, u.AssetBlinder,
-		u.AccountName, u.SpentStatus, u.ConfirmedStatus,
+		u.AccountName, u.SpentStatus, u.ConfirmedStatus, u.FreezeReason,
 	}
 }
 
@@ -151,6 +173,7 @@ func (u *Utxo) Spend(txid string) error {
 		Txid: txid,
 	}
 	u.LockTimestamp = 0
+	u.LeaseID = ""
 	return nil
 }
 
@@ -193,9 +216,36 @@ func (u *Utxo) Confirm(status UtxoStatus) error {
 	return nil
 }
 
-// Lock marks the current utxo as locked.
-func (u *Utxo) Lock(timestamp, expiryTimestamp int64) {
-	if !u.IsLocked() {
+// Unconfirm resets the confirmation (block) info of the utxo, for example in
+// case of a chain reorg. Since the spending tx can't be confirmed if the one
+// creating the utxo is not, its block info is reset as well.
+func (u *Utxo) Unconfirm() {
+	u.ConfirmedStatus = UtxoStatus{}
+	u.UnconfirmSpend()
+}
+
+// UnconfirmSpend resets the confirmation (block) info of the tx spending the
+// utxo, if any.
+func (u *Utxo) UnconfirmSpend() {
+	if u.IsConfirmedSpent() {
+		u.SpentStatus = UtxoStatus{Txid: u.SpentStatus.Txid}
+	}
+}
+
+// Unspend resets the spent status of the utxo, for example in case the
+// spending tx is evicted from mempool. A confirmed spent utxo can't be
+// unspent.
+func (u *Utxo) Unspend() {
+	if u.IsSpent() && !u.IsConfirmedSpent() {
+		u.SpentStatus = UtxoStatus{}
+	}
+}
+
+// Lock marks the current utxo as locked by the given lease. Frozen utxos
+// can't be locked.
+func (u *Utxo) Lock(leaseID string, timestamp, expiryTimestamp int64) {
+	if !u.IsLocked() && !u.IsFrozen() {
+		u.LeaseID = leaseID
 		u.LockTimestamp = timestamp
 		u.LockExpiryTimestamp = expiryTimestamp
 	}
@@ -207,6 +257,57 @@ func (u *Utxo) Unlock() {
 		return
 	}
 
+	u.LeaseID = ""
+	u.LockTimestamp = 0
+	u.LockExpiryTimestamp = 0
+}
+
+// Release unlocks the current utxo before its lock expires. Only the owner
+// of the lease, ie. the one knowing its id, is allowed to release the utxo.
+func (u *Utxo) Release(leaseID string) error {
+	if !u.IsLocked() {
+		return nil
+	}
+	if u.LeaseID != leaseID {
+		return ErrUtxoLeaseMismatch
+	}
+
+	u.LeaseID = ""
 	u.LockTimestamp = 0
 	u.LockExpiryTimestamp = 0
+	return nil
+}
+
+// ExtendLease updates the expiration of the lock of the current utxo. Only
+// the owner of the lease is allowed to extend it.
+func (u *Utxo) ExtendLease(leaseID string, expiryTimestamp int64) error {
+	if !u.IsLocked() {
+		return ErrUtxoNotLocked
+	}
+	if u.LeaseID != leaseID {
+		return ErrUtxoLeaseMismatch
+	}
+
+	u.LockExpiryTimestamp = expiryTimestamp
+	return nil
+}
+
+// Freeze marks the current utxo as frozen for the given reason. Unlike a lock,
+// a freeze never expires and the utxo can't be spent until unfrozen.
+func (u *Utxo) Freeze(reason string, timestamp int64) error {
+	if u.IsFrozen() {
+		return nil
+	}
+	if reason == "" {
+		return fmt.Errorf("missing freeze reason")
+	}
+	u.FrozenTimestamp = timestamp
+	u.FreezeReason = reason
+	return nil
+}
+
+// Unfreeze marks the current frozen utxo as spendable again.
+func (u *Utxo) Unfreeze() {
+	u.FrozenTimestamp = 0
+	u.FreezeReason = ""
 }
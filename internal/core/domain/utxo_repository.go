@@ -11,6 +11,10 @@ const (
 	UtxoUnlocked
 	UtxoSpent
 	UtxoConfirmedSpend
+	UtxoUnconfirmed
+	UtxoFrozen
+	UtxoUnfrozen
+	UtxoUnspent
 )
 
 var (
@@ -21,6 +25,10 @@ var (
 		UtxoUnlocked:       "UtxoUnlocked",
 		UtxoSpent:          "UtxoSpent",
 		UtxoConfirmedSpend: "UtxoConfirmedSpend",
+		UtxoUnconfirmed:    "UtxoUnconfirmed",
+		UtxoFrozen:         "UtxoFrozen",
+		UtxoUnfrozen:       "UtxoUnfrozen",
+		UtxoUnspent:        "UtxoUnspent",
 	}
 )
 
@@ -48,19 +56,23 @@ type UtxoRepository interface {
 	// GetAllUtxos returns the entire UTXO set, included those locked or
 	// already spent.
 	GetAllUtxos(ctx context.Context) ([]*Utxo, error)
-	// GetSpendableUtxos returns all unlocked utxo UTXOs.
+	// GetSpendableUtxos returns all unlocked and unfrozen utxo UTXOs.
 	GetSpendableUtxos(ctx context.Context) ([]*Utxo, error)
 	// GetAllUtxosForAccount returns the list of all utxos for the given
 	// account.
 	GetAllUtxosForAccount(ctx context.Context, account string) ([]*Utxo, error)
 	// GetSpendableUtxosForAccount returns the list of spendable utxos for the
-	// given account. The list incldues only confirmed and unlocked utxos.
+	// given account. The list incldues only confirmed, unlocked and unfrozen
+	// utxos.
 	GetSpendableUtxosForAccount(ctx context.Context, account string) ([]*Utxo, error)
 	// GetLockedUtxosForAccount returns the list of all currently locked utxos
-	// for the given account.
+	// for the given account, those also frozen are excluded.
 	GetLockedUtxosForAccount(ctx context.Context, account string) ([]*Utxo, error)
-	// GetBalanceForAccount returns the confirmed, unconfirmed and locked
-	// balances per each asset for the given account.
+	// GetFrozenUtxosForAccount returns the list of all unspent frozen utxos
+	// for the given account.
+	GetFrozenUtxosForAccount(ctx context.Context, account string) ([]*Utxo, error)
+	// GetBalanceForAccount returns the confirmed, unconfirmed, locked and
+	// frozen balances per each asset for the given account.
 	GetBalanceForAccount(ctx context.Context, account string) (map[string]*Balance, error)
 	// SpendUtxos updates the status of the given list of utxos to "spent" by the given txid.
 	// Generates a UtxoSpent event if successfull.
@@ -71,15 +83,52 @@ type UtxoRepository interface {
 	// ConfirmUtxos updates the status of the given list of utxos to "confirmed".
 	// Generates a UtxoConfirmed event if successfull.
 	ConfirmUtxos(ctx context.Context, utxoKeys []UtxoKey, status UtxoStatus) (int, error)
-	// LockUtxos updates the status of the given list of utxos to "locked".
+	// UnconfirmUtxos resets the confirmation status of the given list of utxos,
+	// those not confirmed are skipped.
+	// Generates a UtxoUnconfirmed event if successfull.
+	UnconfirmUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
+	// UnconfirmSpendUtxos resets the confirmation status of the txs spending
+	// the given list of utxos, those not confirmed spent are skipped.
+	// Generates a UtxoUnconfirmed event if successfull.
+	UnconfirmSpendUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
+	// UnspendUtxos resets the spent status of the given list of utxos, for
+	// example when the spending tx is evicted from mempool. Those not spent or
+	// whose spending tx is already confirmed are skipped.
+	// Generates a UtxoUnspent event if successfull.
+	UnspendUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
+	// GetUtxosForLease returns the list of utxos currently locked by the given
+	// lease.
+	GetUtxosForLease(ctx context.Context, leaseID string) ([]*Utxo, error)
+	// LockUtxos updates the status of the given list of utxos to "locked" and
+	// assigns them to the given lease.
 	// Generates a UtxoLocked event if successfull.
-	LockUtxos(ctx context.Context, utxoKeys []UtxoKey, timestamp, expiryTimestamp int64) (int, error)
+	LockUtxos(ctx context.Context, utxoKeys []UtxoKey, leaseID string, timestamp, expiryTimestamp int64) (int, error)
 	// UnlockUtxos updates the status of the given list of utxos to "unlocked".
+	// Only those whose lock is expired are unlocked.
 	// Generates a UtxoUnlocked event if successfull.
 	UnlockUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
+	// ReleaseUtxos updates the status of the given list of utxos locked by the
+	// given lease to "unlocked", regardless of their lock expiration. None is
+	// updated if any of them is locked by another lease.
+	// Generates a UtxoUnlocked event if successfull.
+	ReleaseUtxos(ctx context.Context, utxoKeys []UtxoKey, leaseID string) (int, error)
+	// ExtendUtxosLease updates the lock expiration of the given list of utxos
+	// locked by the given lease. None is updated if any of them is not locked
+	// or is locked by another lease.
+	ExtendUtxosLease(ctx context.Context, utxoKeys []UtxoKey, leaseID string, expiryTimestamp int64) (int, error)
+	// FreezeUtxos marks the given list of utxos as "frozen" for the given
+	// reason, those already frozen are skipped.
+	// Generates a UtxoFrozen event if successfull.
+	FreezeUtxos(ctx context.Context, utxoKeys []UtxoKey, reason string, timestamp int64) (int, error)
+	// UnfreezeUtxos marks the given list of frozen utxos as spendable again.
+	// Generates a UtxoUnfrozen event if successfull.
+	UnfreezeUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
 	// DeleteUtxosForAccount deletes every utxo associated to the given account
 	// from the repository.
 	DeleteUtxosForAccount(ctx context.Context, accountName string) error
+	// DeleteUtxos deletes the given list of utxos from the repository, those
+	// not found are skipped.
+	DeleteUtxos(ctx context.Context, utxoKeys []UtxoKey) (int, error)
 	// GetEventChannel returns the channel of UtxoEvents.
 	GetEventChannel() chan UtxoEvent
 }
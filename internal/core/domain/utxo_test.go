@@ -52,15 +52,150 @@ func TestConfirmUtxo(t *testing.T) {
 	require.True(t, u.IsConfirmed())
 }
 
+func TestUnconfirmUtxo(t *testing.T) {
+	t.Parallel()
+
+	txid := hex.EncodeToString(make([]byte, 32))
+	status := domain.UtxoStatus{
+		Txid:        txid,
+		BlockHeight: 1,
+		BlockTime:   time.Now().Unix(),
+		BlockHash:   hex.EncodeToString(make([]byte, 32)),
+	}
+
+	u := domain.Utxo{}
+	u.Confirm(status)
+	u.Spend(txid)
+	u.ConfirmSpend(status)
+	require.True(t, u.IsConfirmed())
+	require.True(t, u.IsConfirmedSpent())
+
+	u.UnconfirmSpend()
+	require.True(t, u.IsConfirmed())
+	require.True(t, u.IsSpent())
+	require.False(t, u.IsConfirmedSpent())
+
+	u.ConfirmSpend(status)
+	require.True(t, u.IsConfirmedSpent())
+
+	u.Unconfirm()
+	require.False(t, u.IsConfirmed())
+	require.True(t, u.IsSpent())
+	require.False(t, u.IsConfirmedSpent())
+}
+
+func TestUnspendUtxo(t *testing.T) {
+	t.Parallel()
+
+	txid := hex.EncodeToString(make([]byte, 32))
+	status := domain.UtxoStatus{
+		Txid:        txid,
+		BlockHeight: 1,
+		BlockTime:   time.Now().Unix(),
+		BlockHash:   hex.EncodeToString(make([]byte, 32)),
+	}
+
+	u := domain.Utxo{}
+	u.Spend(txid)
+	u.ConfirmSpend(status)
+	require.True(t, u.IsConfirmedSpent())
+
+	u.Unspend()
+	require.True(t, u.IsConfirmedSpent())
+
+	u.UnconfirmSpend()
+	u.Unspend()
+	require.False(t, u.IsSpent())
+}
+
+func TestUtxoConfirmations(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	require.Zero(t, u.Confirmations(100))
+
+	u.Confirm(domain.UtxoStatus{"", 100, 0, ""})
+	require.Equal(t, uint64(1), u.Confirmations(100))
+	require.Equal(t, uint64(6), u.Confirmations(105))
+	require.Zero(t, u.Confirmations(99))
+}
+
 func TestLockUnlockUtxo(t *testing.T) {
 	t.Parallel()
 
 	u := domain.Utxo{}
 	require.False(t, u.IsLocked())
 
-	u.Lock(time.Now().Unix(), 0)
+	u.Lock("lease", time.Now().Unix(), 0)
 	require.True(t, u.IsLocked())
+	require.Equal(t, "lease", u.LeaseID)
 
 	u.Unlock()
 	require.False(t, u.IsLocked())
+	require.Empty(t, u.LeaseID)
+}
+
+func TestReleaseUtxo(t *testing.T) {
+	t.Parallel()
+
+	expiry := time.Now().Add(time.Minute).Unix()
+	u := domain.Utxo{}
+	u.Lock("lease", time.Now().Unix(), expiry)
+
+	// Not expired yet.
+	u.Unlock()
+	require.True(t, u.IsLocked())
+
+	err := u.Release("other lease")
+	require.ErrorIs(t, err, domain.ErrUtxoLeaseMismatch)
+	require.True(t, u.IsLocked())
+
+	err = u.Release("lease")
+	require.NoError(t, err)
+	require.False(t, u.IsLocked())
+	require.Empty(t, u.LeaseID)
+}
+
+func TestExtendUtxoLease(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	err := u.ExtendLease("lease", time.Now().Unix())
+	require.ErrorIs(t, err, domain.ErrUtxoNotLocked)
+
+	u.Lock("lease", time.Now().Unix(), time.Now().Unix())
+	require.True(t, u.CanUnlock())
+
+	expiry := time.Now().Add(time.Minute).Unix()
+	err = u.ExtendLease("other lease", expiry)
+	require.ErrorIs(t, err, domain.ErrUtxoLeaseMismatch)
+
+	err = u.ExtendLease("lease", expiry)
+	require.NoError(t, err)
+	require.Equal(t, expiry, u.LockExpiryTimestamp)
+	require.False(t, u.CanUnlock())
+}
+
+func TestFreezeUtxo(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Utxo{}
+	require.False(t, u.IsFrozen())
+
+	err := u.Freeze("", time.Now().Unix())
+	require.Error(t, err)
+	require.False(t, u.IsFrozen())
+
+	err = u.Freeze("dust attack", time.Now().Unix())
+	require.NoError(t, err)
+	require.True(t, u.IsFrozen())
+	require.Equal(t, "dust attack", u.Info().FreezeReason)
+
+	// Frozen utxos can't be locked.
+	u.Lock("lease", time.Now().Unix(), 0)
+	require.False(t, u.IsLocked())
+
+	u.Unfreeze()
+	require.False(t, u.IsFrozen())
+	require.Empty(t, u.FreezeReason)
 }
@@ -323,6 +323,30 @@ func (w *Wallet) DeriveNextInternalAddressForAccount(
 	return w.deriveNextAddressForAccount(accountName, internalChain)
 }
 
+// PeekNextExternalAddressesForAccount returns info about the given number of
+// next receiving addresses for the given account, without deriving them. The
+// state of the account is left untouched, therefore the same addresses are
+// returned by the next derivations.
+func (w *Wallet) PeekNextExternalAddressesForAccount(
+	accountName string, numOfAddresses uint64,
+) ([]AddressInfo, error) {
+	return w.peekNextAddressesForAccount(
+		accountName, externalChain, numOfAddresses,
+	)
+}
+
+// PeekNextInternalAddressesForAccount returns info about the given number of
+// next change addresses for the given account, without deriving them. The
+// state of the account is left untouched, therefore the same addresses are
+// returned by the next derivations.
+func (w *Wallet) PeekNextInternalAddressesForAccount(
+	accountName string, numOfAddresses uint64,
+) ([]AddressInfo, error) {
+	return w.peekNextAddressesForAccount(
+		accountName, internalChain, numOfAddresses,
+	)
+}
+
 // AllDerivedAddressesForAccount returns info about all derived receiving and
 // change addresses derived so far for the given account.
 func (w *Wallet) AllDerivedAddressesForAccount(
@@ -367,16 +391,59 @@ func (w *Wallet) deriveNextAddressForAccount(
 		return nil, err
 	}
 
+	addressIndex := account.NextExternalIndex
+	if chainIndex == internalChain {
+		addressIndex = account.NextInternalIndex
+	}
+	info, err := w.deriveAddressForAccount(account, chainIndex, addressIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	account.addDerivationPath(info.Script, info.DerivationPath)
+	if chainIndex == internalChain {
+		account.incrementInternalIndex()
+	} else {
+		account.incrementExternalIndex()
+	}
+
+	return info, nil
+}
+
+func (w *Wallet) peekNextAddressesForAccount(
+	accountName string, chainIndex int, numOfAddresses uint64,
+) ([]AddressInfo, error) {
+	account, err := w.getAccount(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	addressIndex := account.NextExternalIndex
+	if chainIndex == internalChain {
+		addressIndex = account.NextInternalIndex
+	}
+	addressesInfo := make([]AddressInfo, 0, numOfAddresses)
+	for i := uint64(0); i < numOfAddresses; i++ {
+		info, err := w.deriveAddressForAccount(
+			account, chainIndex, addressIndex+uint(i),
+		)
+		if err != nil {
+			return nil, err
+		}
+		addressesInfo = append(addressesInfo, *info)
+	}
+	return addressesInfo, nil
+}
+
+func (w *Wallet) deriveAddressForAccount(
+	account *Account, chainIndex int, addressIndex uint,
+) (*AddressInfo, error) {
 	mnemonic, _ := w.GetMnemonic()
 	ww, _ := singlesig.NewWalletFromMnemonic(singlesig.NewWalletFromMnemonicArgs{
 		RootPath: w.RootPath,
 		Mnemonic: mnemonic,
 	})
 
-	addressIndex := account.NextExternalIndex
-	if chainIndex == internalChain {
-		addressIndex = account.NextInternalIndex
-	}
 	derivationPath := fmt.Sprintf(
 		"%d'/%d/%d", account.Index, chainIndex, addressIndex,
 	)
@@ -394,13 +461,6 @@ func (w *Wallet) deriveNextAddressForAccount(
 		Script: script,
 	})
 
-	account.addDerivationPath(hex.EncodeToString(script), derivationPath)
-	if chainIndex == internalChain {
-		account.incrementInternalIndex()
-	} else {
-		account.incrementExternalIndex()
-	}
-
 	return &AddressInfo{
 		Account:        account.Namespace,
 		Address:        addr,
@@ -220,6 +220,19 @@ func TestWalletAccount(t *testing.T) {
 	require.Len(t, allAddrInfo, 1)
 	require.Exactly(t, *addrInfo, allAddrInfo[0])
 
+	peekedAddrInfo, err := w.PeekNextInternalAddressesForAccount(accountName, 2)
+	require.NoError(t, err)
+	require.Len(t, peekedAddrInfo, 2)
+	require.NotEqual(t, peekedAddrInfo[0].Address, peekedAddrInfo[1].Address)
+
+	allAddrInfo, err = w.AllDerivedAddressesForAccount(accountName)
+	require.NoError(t, err)
+	require.Len(t, allAddrInfo, 1)
+
+	changeAddrInfo, err := w.DeriveNextInternalAddressForAccount(accountName)
+	require.NoError(t, err)
+	require.Exactly(t, peekedAddrInfo[0], *changeAddrInfo)
+
 	err = w.Lock(password)
 	require.NoError(t, err)
 
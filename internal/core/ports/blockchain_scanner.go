@@ -42,6 +42,11 @@ type BlockchainScanner interface {
 	// GetTxChannel returns the channel where notification about txs realated to
 	// the given HD account are sent.
 	GetTxChannel(accountName string) chan *domain.Transaction
+	// GetEvictedTxChannel returns the channel where the ids of the txs related
+	// to the given HD account that have been evicted from the blockchain, or
+	// the mempool, are sent. Implementations unable to detect evictions must
+	// return nil.
+	GetEvictedTxChannel(accountName string) chan string
 
 	// GetLatestBlock returns the header of the latest block of the blockchain.
 	GetLatestBlock() ([]byte, uint32, error)
@@ -57,4 +62,8 @@ type BlockchainScanner interface {
 	BroadcastTransaction(txHex string) (string, error)
 	// GetTransactions returns info about the given txids.
 	GetTransactions(txids []string) ([]domain.Transaction, error)
+	// EstimateFeeRate returns the fee rate (in mSats/byte) required for a tx
+	// to be included within the given number of blocks. It returns 0 if the
+	// backend can't provide any estimation.
+	EstimateFeeRate(confTarget uint32) (uint64, error)
 }
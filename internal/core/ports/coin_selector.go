@@ -5,6 +5,7 @@ import "github.com/vulpemventures/ocean/internal/core/domain"
 // CoinSelector is the abstraction for any kind of service intended to return a
 // subset of the given utxos with target asset hash, covering the target amount
 // based on a specific strategy.
+// Frozen utxos must never be selected.
 type CoinSelector interface {
 	// SelectUtxos implements a certain coin selection strategy.
 	SelectUtxos(
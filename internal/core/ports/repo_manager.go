@@ -20,6 +20,14 @@ type RepoManager interface {
 	TransactionRepository() domain.TransactionRepository
 	// ExternalScriptRepository returns the external scripts repository.
 	ExternalScriptRepository() domain.ExternalScriptRepository
+	// TransferRepository returns the transfers repository.
+	TransferRepository() domain.TransferRepository
+	// PaymentRepository returns the payments queue repository.
+	PaymentRepository() domain.PaymentRepository
+	// SwapRepository returns the swaps repository.
+	SwapRepository() domain.SwapRepository
+	// SpendingPolicyRepository returns the spending policies repository.
+	SpendingPolicyRepository() domain.SpendingPolicyRepository
 
 	// RegisterHandlerForWalletEvent registers an handler function, executed
 	// whenever the given event type occurs.
@@ -10,6 +10,7 @@ type electrumClient interface {
 	close()
 
 	subscribeForBlocks()
+	chainTipChannel() chan blockInfo
 	subscribeForAccount(
 		account string, addresses []domain.AddressInfo,
 	) (chan accountReport, map[string][]txInfo)
@@ -21,4 +22,5 @@ type electrumClient interface {
 	getTxs(txids []string) ([]*transaction.Transaction, error)
 	getUtxos(outpoints []domain.Utxo) ([]domain.Utxo, error)
 	broadcastTx(txHex string) (string, error)
+	estimateFee(numOfBlocks uint32) (float64, error)
 }
@@ -7,6 +7,12 @@ import (
 const (
 	txAdded dbEventType = iota
 	txConfirmed
+	txUnconfirmed
+	txRemoved
+
+	// staleHeight is the height assigned to those txs whose block has been
+	// orphaned by a reorg, until their history is fetched again.
+	staleHeight = -2
 )
 
 type dbEventType int
@@ -17,6 +23,10 @@ func (t dbEventType) String() string {
 		return "TX_ADDED"
 	case txConfirmed:
 		return "TX_CONFIRMED"
+	case txUnconfirmed:
+		return "TX_UNCONFIRMED"
+	case txRemoved:
+		return "TX_REMOVED"
 	default:
 		return "UNKNOWN"
 	}
@@ -38,9 +48,10 @@ type db struct {
 	lock   *sync.RWMutex
 	chLock *sync.RWMutex
 
-	txHistoryByAccount map[string]map[string]int64
-	eventHandler       func(dbEvent)
-	chEvents           chan dbEvent
+	txHistoryByAccount    map[string]map[string]int64
+	scriptHashesByAccount map[string]map[string]map[string]struct{}
+	eventHandler          func(dbEvent)
+	chEvents              chan dbEvent
 }
 
 func newDb() *db {
@@ -48,8 +59,9 @@ func newDb() *db {
 		lock:   &sync.RWMutex{},
 		chLock: &sync.RWMutex{},
 
-		txHistoryByAccount: make(map[string]map[string]int64),
-		chEvents:           make(chan dbEvent),
+		txHistoryByAccount:    make(map[string]map[string]int64),
+		scriptHashesByAccount: make(map[string]map[string]map[string]struct{}),
+		chEvents:              make(chan dbEvent),
 	}
 
 	go db.listen()
@@ -59,35 +71,105 @@ func newDb() *db {
 
 // updateAccountTxHistory updates the history of an account address and
 // generates an event for every tx that has either been added to the store or
-// has changed status (ie. it was in mempool and later was confirmed).
+// has changed status (ie. it was in mempool and later was confirmed, or it was
+// confirmed and its block has been orphaned by a reorg).
+// Txs that are no longer part of the history of any of the account addresses
+// are removed from the store.
 func (d *db) updateAccountTxHistory(account, scriptHash string, newHistory []txInfo) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
 	if _, ok := d.txHistoryByAccount[account]; !ok {
 		d.txHistoryByAccount[account] = make(map[string]int64)
+		d.scriptHashesByAccount[account] = make(map[string]map[string]struct{})
 	}
 
+	newTxs := make(map[string]struct{})
 	for _, tx := range newHistory {
-		prevHistory := d.txHistoryByAccount
+		newTxs[tx.Txid] = struct{}{}
+		if _, ok := d.scriptHashesByAccount[account][tx.Txid]; !ok {
+			d.scriptHashesByAccount[account][tx.Txid] = make(map[string]struct{})
+		}
+		d.scriptHashesByAccount[account][tx.Txid][scriptHash] = struct{}{}
+
 		// unconfirmed txs have height 0 or -1, while those confirmed have height
 		// equals to the one of the block in which they are contained.
-		// If the tx is stored in the db and is confirmed, we don't have nothing
-		// to do and we can skip to the next tx of the given history.
-		if height, ok := prevHistory[account][tx.Txid]; ok && height == tx.Height {
+		// If the tx is stored in the db and its height didn't change, we don't
+		// have nothing to do and we can skip to the next tx of the given history.
+		prevHeight, isTxTracked := d.txHistoryByAccount[account][tx.Txid]
+		if isTxTracked && prevHeight == tx.Height {
 			continue
 		}
 
-		_, isTxTracked := d.txHistoryByAccount[account][tx.Txid]
 		d.txHistoryByAccount[account][tx.Txid] = tx.Height
 
-		eventType := txConfirmed
-		if !isTxTracked {
+		var eventType dbEventType
+		switch {
+		case !isTxTracked:
 			eventType = txAdded
+		case tx.Height > 0:
+			eventType = txConfirmed
+		case prevHeight > 0 || prevHeight == staleHeight:
+			eventType = txUnconfirmed
+		default:
+			// The tx moved from mempool to mempool (ie. one of its parents has
+			// been confirmed), nothing to notify.
+			continue
 		}
 		event := dbEvent{eventType, tx, account, scriptHash}
 		go d.publishEvent(event)
 	}
+
+	for txid, scriptHashes := range d.scriptHashesByAccount[account] {
+		if _, ok := scriptHashes[scriptHash]; !ok {
+			continue
+		}
+		if _, ok := newTxs[txid]; ok {
+			continue
+		}
+
+		delete(scriptHashes, scriptHash)
+		if len(scriptHashes) > 0 {
+			continue
+		}
+
+		height := d.txHistoryByAccount[account][txid]
+		delete(d.scriptHashesByAccount[account], txid)
+		delete(d.txHistoryByAccount[account], txid)
+
+		event := dbEvent{txRemoved, txInfo{txid, height}, account, scriptHash}
+		go d.publishEvent(event)
+	}
+}
+
+// invalidateTxsAboveHeight marks as stale all txs confirmed in a block higher
+// than the given one, and returns the script hashes involved, grouped by
+// account, whose history must be fetched again.
+func (d *db) invalidateTxsAboveHeight(height int64) map[string][]string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	scriptHashesByAccount := make(map[string][]string)
+	for account, history := range d.txHistoryByAccount {
+		scriptHashes := make(map[string]struct{})
+		for txid, txHeight := range history {
+			if txHeight <= height {
+				continue
+			}
+
+			history[txid] = staleHeight
+			for scriptHash := range d.scriptHashesByAccount[account][txid] {
+				scriptHashes[scriptHash] = struct{}{}
+			}
+		}
+
+		for scriptHash := range scriptHashes {
+			scriptHashesByAccount[account] = append(
+				scriptHashesByAccount[account], scriptHash,
+			)
+		}
+	}
+	return scriptHashesByAccount
 }
 
 func (d *db) listen() {
@@ -3,6 +3,8 @@ package electrum_scanner
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"sync"
 
@@ -18,6 +20,10 @@ import (
 	"github.com/vulpemventures/ocean/internal/core/ports"
 )
 
+// maxReorgDepth is the number of latest blocks whose hashes are kept in memory
+// to detect chain reorgs.
+const maxReorgDepth = 100
+
 type service struct {
 	client electrumClient
 	db     *db
@@ -28,8 +34,11 @@ type service struct {
 	accountAddressesByScriptHash map[string]map[string]domain.AddressInfo
 	utxoChannelByAccount         map[string]chan []*domain.Utxo
 	txChannelByAccount           map[string]chan *domain.Transaction
+	evictedTxChannelByAccount    map[string]chan string
 	reportChannelByAccount       map[string]chan accountReport
 	blocksByHeight               map[uint64]blockInfo
+	tipHashByHeight              map[uint64]string
+	tipHeight                    uint64
 
 	log  func(format string, a ...interface{})
 	warn func(err error, format string, a ...interface{})
@@ -77,11 +86,13 @@ func NewService(args ServiceArgs) (ports.BlockchainScanner, error) {
 	lock := &sync.RWMutex{}
 	utxoChannelByAccount := make(map[string]chan []*domain.Utxo)
 	txChannelByAccount := make(map[string]chan *domain.Transaction)
+	evictedTxChannelByAccount := make(map[string]chan string)
 	reportChannelByAccount := make(map[string]chan accountReport)
 	accountAddressesByScriptHash := make(
 		map[string]map[string]domain.AddressInfo,
 	)
 	blocksByHeight := make(map[uint64]blockInfo)
+	tipHashByHeight := make(map[uint64]string)
 
 	client, err := args.client()
 	if err != nil {
@@ -99,8 +110,9 @@ func NewService(args ServiceArgs) (ports.BlockchainScanner, error) {
 
 	svc := &service{
 		client, db, lock, args.Network, accountAddressesByScriptHash,
-		utxoChannelByAccount, txChannelByAccount, reportChannelByAccount,
-		blocksByHeight, logFn, warnFn,
+		utxoChannelByAccount, txChannelByAccount, evictedTxChannelByAccount,
+		reportChannelByAccount, blocksByHeight, tipHashByHeight, 0,
+		logFn, warnFn,
 	}
 	svc.db.registerEventHandler(svc.dbEventHandler)
 
@@ -111,6 +123,7 @@ func (s *service) Start() {
 	s.log("start listening to messages from electrum server")
 
 	go s.client.listen()
+	go s.listenToChainTipChannel(s.client.chainTipChannel())
 	s.client.subscribeForBlocks()
 }
 
@@ -177,6 +190,10 @@ func (s *service) GetTxChannel(accountName string) chan *domain.Transaction {
 	return s.getTxChannelByAccount(accountName)
 }
 
+func (s *service) GetEvictedTxChannel(accountName string) chan string {
+	return s.getEvictedTxChannelByAccount(accountName)
+}
+
 func (s *service) GetLatestBlock() ([]byte, uint32, error) {
 	return s.client.getLatestBlock()
 }
@@ -376,6 +393,20 @@ func (s *service) BroadcastTransaction(txHex string) (string, error) {
 	return s.client.broadcastTx(txHex)
 }
 
+// EstimateFeeRate returns the fee rate (in mSats/byte) estimated by the
+// electrum server for the given confirmation target. The server returns
+// BTC/kB, or -1 if it doesn't have enough data.
+func (s *service) EstimateFeeRate(confTarget uint32) (uint64, error) {
+	btcPerKb, err := s.client.estimateFee(confTarget)
+	if err != nil {
+		return 0, err
+	}
+	if btcPerKb <= 0 {
+		return 0, nil
+	}
+	return uint64(math.Ceil(btcPerKb * 1e8)), nil
+}
+
 // GetTransactions returns info about the given txids.
 func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error) {
 	res, err := s.client.getTxs(txids)
@@ -449,6 +480,13 @@ func (s *service) getTxChannelByAccount(account string) chan *domain.Transaction
 	return s.txChannelByAccount[account]
 }
 
+func (s *service) getEvictedTxChannelByAccount(account string) chan string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.evictedTxChannelByAccount[account]
+}
+
 func (s *service) setAccountChannels(
 	account string, chReports chan accountReport,
 ) {
@@ -458,6 +496,7 @@ func (s *service) setAccountChannels(
 	s.reportChannelByAccount[account] = chReports
 	s.utxoChannelByAccount[account] = make(chan []*domain.Utxo)
 	s.txChannelByAccount[account] = make(chan *domain.Transaction)
+	s.evictedTxChannelByAccount[account] = make(chan string)
 }
 
 func (s *service) listenToAccountChannel(chReports chan accountReport) {
@@ -478,7 +517,185 @@ func (s *service) listenToAccountChannel(chReports chan accountReport) {
 	}
 }
 
+// listenToChainTipChannel keeps track of the hashes of the latest blocks
+// notified by the electrum server and, whenever a new chain tip doesn't extend
+// the known chain, it looks for the fork point and fetches again the history
+// of those script hashes with txs confirmed in the orphaned blocks.
+// Since the server may skip some blocks when notifying a new tip, the headers
+// between the tip and its latest known ancestor are fetched and added to the
+// chain one by one, so that reorgs in the gap are not missed.
+func (s *service) listenToChainTipChannel(chTip chan blockInfo) {
+	for tip := range chTip {
+		blocks, err := s.getBlocksFromKnownAncestor(tip)
+		if err != nil {
+			s.warn(err, "failed to get headers preceding block %d", tip.Height)
+			blocks = []blockInfo{tip}
+		}
+
+		var forkHeight uint64
+		var isReorg bool
+		for _, block := range blocks {
+			height, reorg := s.updateChainTip(block)
+			if reorg && (!isReorg || height < forkHeight) {
+				forkHeight, isReorg = height, true
+			}
+		}
+		if !isReorg {
+			continue
+		}
+
+		s.log("detected chain reorg from block %d", forkHeight+1)
+
+		scriptHashesByAccount := s.db.invalidateTxsAboveHeight(int64(forkHeight))
+		for account, scriptHashes := range scriptHashesByAccount {
+			history, err := s.client.getScriptHashesHistory(scriptHashes)
+			if err != nil {
+				s.warn(
+					err, "failed to get history after reorg for account %s", account,
+				)
+				continue
+			}
+			for _, scriptHash := range scriptHashes {
+				s.db.updateAccountTxHistory(account, scriptHash, history[scriptHash])
+			}
+		}
+	}
+}
+
+// updateChainTip stores the hash of the given tip and, in case the previous
+// one is orphaned, returns the height of the fork point after having dropped
+// the info of all blocks above it.
+// The fork point is found by comparing the parent hash of the new tip with the
+// known chain: if it doesn't match, the fork is deeper than the parent block
+// and all known blocks are dropped. This is why new tips are expected to be
+// preceded by the headers returned by getBlocksFromKnownAncestor.
+func (s *service) updateChainTip(tip blockInfo) (uint64, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tipHash := tip.hash().String()
+	if hash, ok := s.tipHashByHeight[tip.Height]; ok && hash == tipHash {
+		return 0, false
+	}
+
+	prevTipHeight := s.tipHeight
+	forkHeight := prevTipHeight
+	if tip.Height > 0 {
+		parentHeight := tip.Height - 1
+		parentHash, ok := s.tipHashByHeight[parentHeight]
+		prevHash := tip.prevHash()
+		switch {
+		case ok && prevHash != nil && parentHash == prevHash.String():
+			if parentHeight < forkHeight {
+				forkHeight = parentHeight
+			}
+		case ok:
+			forkHeight = parentHeight
+			for height := range s.tipHashByHeight {
+				if height <= forkHeight {
+					forkHeight = height
+				}
+			}
+			if forkHeight > 0 {
+				forkHeight--
+			}
+		case parentHeight < forkHeight:
+			forkHeight = parentHeight
+		}
+	}
+
+	for height := range s.tipHashByHeight {
+		if height > forkHeight || height+maxReorgDepth < tip.Height {
+			delete(s.tipHashByHeight, height)
+		}
+	}
+	s.tipHashByHeight[tip.Height] = tipHash
+	s.tipHeight = tip.Height
+
+	if forkHeight == prevTipHeight {
+		return 0, false
+	}
+
+	for height := range s.blocksByHeight {
+		if height > forkHeight {
+			delete(s.blocksByHeight, height)
+		}
+	}
+	return forkHeight, true
+}
+
+// getBlocksFromKnownAncestor returns the given tip preceded by the headers of
+// the blocks between it and its latest ancestor in the known chain, sorted by
+// height. These are the blocks skipped since the previous tip, plus those of
+// the known chain that have been orphaned, up to maxReorgDepth blocks.
+func (s *service) getBlocksFromKnownAncestor(tip blockInfo) ([]blockInfo, error) {
+	s.lock.RLock()
+	prevTipHeight := s.tipHeight
+	isEmpty := len(s.tipHashByHeight) <= 0
+	s.lock.RUnlock()
+
+	if isEmpty || tip.Height <= prevTipHeight+1 {
+		return s.prependOrphanedBlocks([]blockInfo{tip})
+	}
+
+	fromHeight := prevTipHeight + 1
+	if tip.Height > maxReorgDepth && fromHeight < tip.Height-maxReorgDepth {
+		fromHeight = tip.Height - maxReorgDepth
+	}
+	heights := make([]uint32, 0, tip.Height-fromHeight)
+	for height := fromHeight; height < tip.Height; height++ {
+		heights = append(heights, uint32(height))
+	}
+	blocks, err := s.client.getBlocksInfo(heights)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].Height < blocks[j].Height
+	})
+
+	return s.prependOrphanedBlocks(append(blocks, tip))
+}
+
+// prependOrphanedBlocks walks back the given chain of blocks, fetching the
+// header of the parent of the lowest one until it matches the hash known for
+// that height, or there's no known hash at all.
+func (s *service) prependOrphanedBlocks(blocks []blockInfo) ([]blockInfo, error) {
+	for i := 0; i < maxReorgDepth; i++ {
+		lowest := blocks[0]
+		if lowest.Height <= 0 {
+			break
+		}
+		parentHeight := lowest.Height - 1
+
+		s.lock.RLock()
+		parentHash, ok := s.tipHashByHeight[parentHeight]
+		s.lock.RUnlock()
+
+		prevHash := lowest.prevHash()
+		if !ok || prevHash == nil || prevHash.String() == parentHash {
+			break
+		}
+
+		parents, err := s.client.getBlocksInfo([]uint32{uint32(parentHeight)})
+		if err != nil {
+			return nil, err
+		}
+		if len(parents) <= 0 {
+			break
+		}
+		blocks = append([]blockInfo{parents[0]}, blocks...)
+	}
+	return blocks, nil
+}
+
 func (s *service) dbEventHandler(event dbEvent) {
+	if event.eventType == txRemoved {
+		chEvictedTx := s.getEvictedTxChannelByAccount(event.account)
+		go func() { chEvictedTx <- event.tx.Txid }()
+		return
+	}
+
 	txs, err := s.client.getTxs([]string{event.tx.Txid})
 	if err != nil {
 		s.warn(err, "failed to fetch tx for event %+v", event)
@@ -27,6 +27,7 @@ type tcpClient struct {
 	nextId         uint64
 	chHandler      *chHandler
 	chainTip       blockInfo
+	chChainTip     chan blockInfo
 	reportHandlers map[string]*reportHandler
 	chQuit         chan struct{}
 	subscriptions  []request
@@ -60,6 +61,7 @@ func newTCPClient(addr string) (electrumClient, error) {
 		chHandler:      newChHandler(),
 		reportHandlers: make(map[string]*reportHandler, 0),
 		chQuit:         make(chan struct{}),
+		chChainTip:     make(chan blockInfo, 10),
 		subscriptions:  make([]request, 0),
 		tipLock:        &sync.RWMutex{},
 		sendLock:       &sync.RWMutex{},
@@ -164,6 +166,11 @@ func (c *tcpClient) reconnect() {
 }
 
 func (c *tcpClient) close() {
+	c.tipLock.Lock()
+	close(c.chChainTip)
+	c.chChainTip = nil
+	c.tipLock.Unlock()
+
 	c.conn.Close()
 	c.chHandler.clear()
 	c.chQuit <- struct{}{}
@@ -406,6 +413,21 @@ func (c *tcpClient) broadcastTx(txHex string) (string, error) {
 	return resp.Result.(string), nil
 }
 
+func (c *tcpClient) estimateFee(numOfBlocks uint32) (float64, error) {
+	resp, err := c.request("blockchain.estimatefee", numOfBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if err := resp.error(); err != nil {
+		return 0, err
+	}
+	feeRate, ok := resp.Result.(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid fee estimate: %v", resp.Result)
+	}
+	return feeRate, nil
+}
+
 func (c *tcpClient) request(method string, params ...interface{}) (*response, error) {
 	req := c.newJSONRequest(method, params...)
 	reqBytes, _ := json.Marshal(req)
@@ -484,4 +506,17 @@ func (c *tcpClient) updateChainTip(tip blockInfo) {
 	defer c.tipLock.Unlock()
 
 	c.chainTip = tip
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case c.chChainTip <- tip:
+	default:
+	}
+}
+
+func (c *tcpClient) chainTipChannel() chan blockInfo {
+	c.tipLock.RLock()
+	defer c.tipLock.RUnlock()
+
+	return c.chChainTip
 }
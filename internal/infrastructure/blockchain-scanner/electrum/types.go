@@ -31,6 +31,16 @@ func (i blockInfo) hash() *chainhash.Hash {
 	return &hash
 }
 
+func (i blockInfo) prevHash() *chainhash.Hash {
+	header := i.header()
+	if header == nil {
+		return nil
+	}
+
+	hash, _ := chainhash.NewHash(header.PrevBlockHash)
+	return hash
+}
+
 func (i blockInfo) timestamp() int64 {
 	header := i.header()
 	if header == nil {
@@ -23,6 +23,7 @@ type wsClient struct {
 	nextId         uint64
 	chHandler      *chHandler
 	chainTip       blockInfo
+	chChainTip     chan blockInfo
 	reportHandlers map[string]*reportHandler
 	chQuit         chan struct{}
 
@@ -54,6 +55,7 @@ func newWSClient(addr string) (electrumClient, error) {
 		chHandler:      newChHandler(),
 		reportHandlers: make(map[string]*reportHandler),
 		chQuit:         make(chan struct{}),
+		chChainTip:     make(chan blockInfo, 10),
 		tipLock:        &sync.RWMutex{},
 		sendLock:       &sync.RWMutex{},
 		log:            logFn,
@@ -140,6 +142,11 @@ func (c *wsClient) keepAliveConnection() {
 }
 
 func (c *wsClient) close() {
+	c.tipLock.Lock()
+	close(c.chChainTip)
+	c.chChainTip = nil
+	c.tipLock.Unlock()
+
 	c.conn.Close()
 	c.chHandler.clear()
 	c.chQuit <- struct{}{}
@@ -350,6 +357,21 @@ func (c *wsClient) broadcastTx(txHex string) (string, error) {
 	return resp.Result.(string), nil
 }
 
+func (c *wsClient) estimateFee(numOfBlocks uint32) (float64, error) {
+	resp, err := c.request("blockchain.estimatefee", numOfBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if err := resp.error(); err != nil {
+		return 0, err
+	}
+	feeRate, ok := resp.Result.(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid fee estimate: %v", resp.Result)
+	}
+	return feeRate, nil
+}
+
 func (c *wsClient) subscribeForScripts(
 	accountName string, scriptHashes []string,
 ) error {
@@ -459,4 +481,17 @@ func (c *wsClient) updateChainTip(tip blockInfo) {
 	defer c.tipLock.Unlock()
 
 	c.chainTip = tip
+
+	// send over channel without blocking in case nobody is listening.
+	select {
+	case c.chChainTip <- tip:
+	default:
+	}
+}
+
+func (c *wsClient) chainTipChannel() chan blockInfo {
+	c.tipLock.RLock()
+	defer c.tipLock.RUnlock()
+
+	return c.chChainTip
 }
@@ -3,6 +3,7 @@ package elements_scanner
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -100,6 +101,12 @@ func (s *service) GetTxChannel(accountName string) chan *domain.Transaction {
 	return scannerSvc.chTxs
 }
 
+// GetEvictedTxChannel returns nil since this scanner has no visibility over
+// the mempool, therefore it can't detect evicted txs.
+func (s *service) GetEvictedTxChannel(_ string) chan string {
+	return nil
+}
+
 func (s *service) WatchForAccount(
 	accountName string, startingBlock uint32, addressesInfo []domain.AddressInfo,
 ) {
@@ -205,6 +212,25 @@ func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error)
 	return nil, fmt.Errorf("not implemented")
 }
 
+// EstimateFeeRate returns the fee rate (in mSats/byte) estimated by the node
+// via estimatesmartfee for the given confirmation target. The node returns
+// BTC/kvB, or some errors if it doesn't have enough data.
+func (s *service) EstimateFeeRate(confTarget uint32) (uint64, error) {
+	resp, err := s.rpcClient.call("estimatesmartfee", []interface{}{confTarget})
+	if err != nil {
+		return 0, err
+	}
+	res, ok := resp.(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("invalid estimatesmartfee response")
+	}
+	btcPerKb, ok := res["feerate"].(float64)
+	if !ok || btcPerKb <= 0 {
+		return 0, nil
+	}
+	return uint64(math.Ceil(btcPerKb * 1e8)), nil
+}
+
 func (s *service) GetLatestBlock() ([]byte, uint32, error) {
 	block, err := s.headersRepo.ChainTip(context.Background())
 	if err != nil {
@@ -1,7 +1,9 @@
 package neutrino_scanner
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -17,13 +19,25 @@ import (
 	"github.com/vulpemventures/ocean/internal/core/domain"
 )
 
+const (
+	// chainTipPollInterval is the frequency at which the chain tip is checked
+	// to detect reorgs.
+	chainTipPollInterval = 10 * time.Second
+	// maxReorgDepth is the number of blocks after which a confirmed tx is not
+	// checked anymore for reorgs.
+	maxReorgDepth = 100
+)
+
 type scannerService struct {
 	accountName         string
 	svc                 scanner.Service
+	headersDb           repository.BlockHeaderRepository
 	blindingKeys        map[string][]byte
+	confirmedTxs        map[string]*domain.Transaction
 	startingBlockHeight uint32
 	chTxs               chan *domain.Transaction
 	chUtxos             chan []*domain.Utxo
+	chQuit              chan struct{}
 	lock                *sync.RWMutex
 
 	log  func(format string, a ...interface{})
@@ -48,21 +62,26 @@ func newScannerSvc(
 	scannerSvc := &scannerService{
 		accountName:         accountName,
 		svc:                 scanner.New(filtersDb, headersDb, blockSvc, genesisHash),
+		headersDb:           headersDb,
 		blindingKeys:        make(map[string][]byte),
+		confirmedTxs:        make(map[string]*domain.Transaction),
 		startingBlockHeight: startingBlockHeight,
 		chTxs:               make(chan *domain.Transaction, 10),
 		chUtxos:             make(chan []*domain.Utxo, 10),
+		chQuit:              make(chan struct{}),
 		lock:                &sync.RWMutex{},
 		log:                 logFn,
 		warn:                warnFn,
 	}
 	chReports, _ := scannerSvc.svc.Start()
 	go scannerSvc.listenToReports(chReports)
+	go scannerSvc.watchChainTip()
 	return scannerSvc
 }
 
 func (s *scannerService) stop() {
 	s.svc.Stop()
+	close(s.chQuit)
 	close(s.chTxs)
 	close(s.chUtxos)
 }
@@ -130,8 +149,7 @@ func (s *scannerService) listenToReports(chReports <-chan scanner.Report) {
 			blockHash = r.BlockHash.String()
 			blockHeight = uint64(r.BlockHeight)
 		}
-		select {
-		case s.chTxs <- &domain.Transaction{
+		reportedTx := &domain.Transaction{
 			TxID:  txid,
 			TxHex: txHex,
 			Accounts: map[string]struct{}{
@@ -139,7 +157,12 @@ func (s *scannerService) listenToReports(chReports <-chan scanner.Report) {
 			},
 			BlockHash:   blockHash,
 			BlockHeight: blockHeight,
-		}:
+		}
+		if reportedTx.IsConfirmed() {
+			s.trackConfirmedTx(*reportedTx)
+		}
+		select {
+		case s.chTxs <- reportedTx:
 		default:
 		}
 
@@ -217,6 +240,79 @@ func (s *scannerService) listenToReports(chReports <-chan scanner.Report) {
 	}
 }
 
+// watchChainTip periodically checks the chain tip and, whenever it changes,
+// makes sure that the blocks including the confirmed txs reported so far are
+// still part of the best chain. The txs included in orphaned blocks are
+// notified again without block info.
+func (s *scannerService) watchChainTip() {
+	ticker := time.NewTicker(chainTipPollInterval)
+	defer ticker.Stop()
+
+	var prevTipHash string
+	for {
+		select {
+		case <-s.chQuit:
+			return
+		case <-ticker.C:
+			tip, err := s.headersDb.ChainTip(context.Background())
+			if err != nil {
+				s.warn(err, "failed to get chain tip")
+				continue
+			}
+			hash, _ := tip.Hash()
+			if hash.String() == prevTipHash {
+				continue
+			}
+			prevTipHash = hash.String()
+
+			for _, tx := range s.getOrphanedTxs(tip.Height) {
+				s.log("tx %s has been reorged out of block %d", tx.TxID, tx.BlockHeight)
+				tx.Unconfirm()
+				select {
+				case s.chTxs <- tx:
+				case <-s.chQuit:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *scannerService) getOrphanedTxs(tipHeight uint32) []*domain.Transaction {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	orphanedTxs := make([]*domain.Transaction, 0)
+	for txid, tx := range s.confirmedTxs {
+		if tx.BlockHeight+maxReorgDepth < uint64(tipHeight) {
+			delete(s.confirmedTxs, txid)
+			continue
+		}
+
+		hash, err := s.headersDb.GetBlockHashByHeight(
+			context.Background(), uint32(tx.BlockHeight),
+		)
+		if err != nil && !errors.Is(err, repository.ErrBlockNotFound) {
+			s.warn(err, "failed to get hash of block %d", tx.BlockHeight)
+			continue
+		}
+		if hash != nil && hash.String() == tx.BlockHash {
+			continue
+		}
+
+		delete(s.confirmedTxs, txid)
+		orphanedTxs = append(orphanedTxs, tx)
+	}
+	return orphanedTxs
+}
+
+func (s *scannerService) trackConfirmedTx(tx domain.Transaction) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.confirmedTxs[tx.TxID] = &tx
+}
+
 func (s *scannerService) getBlindingKey(script string) ([]byte, bool) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -26,6 +26,10 @@ import (
 
 const (
 	userAgent = "neutrino-elements"
+	// fallbackFeeRate is the fee rate in mSats/byte returned by EstimateFeeRate
+	// since neutrino peers don't provide any estimation. It matches the
+	// minimum relay fee of the Liquid network.
+	fallbackFeeRate = uint64(100)
 )
 
 type service struct {
@@ -122,6 +126,12 @@ func (s *service) GetTxChannel(accountName string) chan *domain.Transaction {
 	return scannerSvc.chTxs
 }
 
+// GetEvictedTxChannel returns nil since this scanner has no visibility over
+// the mempool, therefore it can't detect evicted txs.
+func (s *service) GetEvictedTxChannel(_ string) chan string {
+	return nil
+}
+
 func (s *service) WatchForAccount(
 	accountName string, startingBlock uint32, addressesInfo []domain.AddressInfo,
 ) {
@@ -201,6 +211,12 @@ func (s *service) GetTransactions(txids []string) ([]domain.Transaction, error)
 	return nil, fmt.Errorf("not implemented")
 }
 
+// EstimateFeeRate returns a static fee rate regardless of the confirmation
+// target.
+func (s *service) EstimateFeeRate(_ uint32) (uint64, error) {
+	return fallbackFeeRate, nil
+}
+
 func (s *service) GetLatestBlock() ([]byte, uint32, error) {
 	block, err := s.headersRepo.ChainTip(context.Background())
 	if err != nil {
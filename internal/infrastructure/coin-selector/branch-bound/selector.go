@@ -0,0 +1,225 @@
+package branchbound_selector
+
+import (
+	"sort"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
+	wallet "github.com/vulpemventures/ocean/pkg/wallet"
+)
+
+const (
+	// maxTries bounds the number of branches explored before giving up and
+	// falling back to the smallest-subset strategy.
+	maxTries = 100000
+)
+
+var (
+	// Placeholders used to estimate the cost of adding a (confidential)
+	// change output to a transaction and of spending it later.
+	changeScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	changeOutput = wallet.Output{
+		Script:      changeScript,
+		BlindingKey: make([]byte, 33),
+	}
+	changeInput = wallet.Input{
+		Script: changeScript,
+	}
+)
+
+type selector struct {
+	feeAsset         string
+	millisatsPerByte uint64
+	discountCT       bool
+	fallback         ports.CoinSelector
+}
+
+// NewBranchAndBoundCoinSelector returns a coin selector that searches for
+// an input set that covers the target amount without producing change.
+// For the fee asset, every utxo is weighted by its effective value, ie. its
+// value minus the fee required to spend it at the given mSats/byte ratio, and
+// the selected set can exceed the target amount at most of the cost of
+// creating and later spending a change output. For any other asset, only an
+// exact match is accepted.
+// If discountCT is true, the costs are based on the discounted virtual size
+// of confidential transactions.
+// If no such set is found, it falls back to the smallest-subset strategy.
+func NewBranchAndBoundCoinSelector(
+	feeAsset string, millisatsPerByte uint64, discountCT bool,
+) ports.CoinSelector {
+	return &selector{
+		feeAsset:         feeAsset,
+		millisatsPerByte: millisatsPerByte,
+		discountCT:       discountCT,
+		fallback:         ss_selector.NewSmallestSubsetCoinSelector(),
+	}
+}
+
+func (s *selector) SelectUtxos(
+	utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
+) ([]*domain.Utxo, uint64, error) {
+	targetUtxos := make([]*domain.Utxo, 0)
+	values := make(map[*domain.Utxo]uint64)
+	for i := range utxos {
+		utxo := utxos[i]
+		if utxo.IsFrozen() {
+			continue
+		}
+		if utxo.IsConfidential() && !utxo.IsRevealed() {
+			return nil, 0, ss_selector.ErrBlindedUtxos
+		}
+		if utxo.Asset != targetAsset {
+			continue
+		}
+
+		value := utxo.Value
+		if targetAsset == s.feeAsset {
+			fee := s.inputFee(utxo)
+			// Skip utxos that cost more than their value to be spent.
+			if utxo.Value <= fee {
+				continue
+			}
+			value -= fee
+		}
+		targetUtxos = append(targetUtxos, utxo)
+		values[utxo] = value
+	}
+
+	sort.SliceStable(targetUtxos, func(i, j int) bool {
+		return values[targetUtxos[i]] > values[targetUtxos[j]]
+	})
+	effectiveValues := make([]uint64, 0, len(targetUtxos))
+	for _, u := range targetUtxos {
+		effectiveValues = append(effectiveValues, values[u])
+	}
+
+	tolerance := uint64(0)
+	if targetAsset == s.feeAsset {
+		tolerance = s.costOfChange()
+	}
+
+	indexes := selectUtxos(effectiveValues, targetAmount, tolerance)
+	if len(indexes) <= 0 {
+		return s.fallback.SelectUtxos(utxos, targetAmount, targetAsset)
+	}
+
+	selectedUtxos := make([]*domain.Utxo, 0, len(indexes))
+	totalAmount := uint64(0)
+	for _, i := range indexes {
+		totalAmount += targetUtxos[i].Value
+		selectedUtxos = append(selectedUtxos, targetUtxos[i])
+	}
+
+	change := totalAmount - targetAmount
+	return selectedUtxos, change, nil
+}
+
+func (s *selector) inputFee(utxo *domain.Utxo) uint64 {
+	size := s.inputSize(wallet.Input{Script: utxo.Script})
+	return s.fee(size)
+}
+
+func (s *selector) costOfChange() uint64 {
+	size := s.outputSize(changeOutput) + s.inputSize(changeInput)
+	return s.fee(size)
+}
+
+// inputSize returns the virtual size of the given input. Inputs are not
+// affected by the discount of confidential transactions.
+func (s *selector) inputSize(in wallet.Input) uint64 {
+	return wallet.EstimateInputSize(in)
+}
+
+// outputSize returns the virtual size of the given output, discounted if
+// enabled.
+func (s *selector) outputSize(out wallet.Output) uint64 {
+	if s.discountCT {
+		return wallet.EstimateDiscountedOutputSize(out)
+	}
+	return wallet.EstimateOutputSize(out)
+}
+
+func (s *selector) fee(size uint64) uint64 {
+	return uint64(float64(size) * float64(s.millisatsPerByte) / 1000)
+}
+
+// selectUtxos returns the indexes of the values whose sum is in the range
+// [target, target + tolerance], preferring the one that exceeds the target
+// of the least amount and, among those, the one with less items.
+// The given values are expected to be sorted in descending order.
+// An empty list is returned if no such combination is found within maxTries.
+func selectUtxos(values []uint64, target, tolerance uint64) []int {
+	if target == 0 || len(values) == 0 {
+		return nil
+	}
+
+	// remaining[i] is the sum of all values from index i on, used to prune
+	// the branches that can't reach the target anymore.
+	remaining := make([]uint64, len(values)+1)
+	for i := len(values) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + values[i]
+	}
+	if remaining[0] < target {
+		return nil
+	}
+
+	s := &searcher{
+		values:    values,
+		remaining: remaining,
+		target:    target,
+		tolerance: tolerance,
+		tries:     maxTries,
+	}
+	s.explore(0, make([]int, 0, len(values)), 0)
+	return s.best
+}
+
+type searcher struct {
+	values     []uint64
+	remaining  []uint64
+	target     uint64
+	tolerance  uint64
+	tries      int
+	best       []int
+	bestExcess uint64
+}
+
+func (s *searcher) explore(index int, selected []int, total uint64) {
+	if s.tries <= 0 {
+		return
+	}
+	s.tries--
+
+	if total > s.target+s.tolerance {
+		return
+	}
+	if total >= s.target {
+		excess := total - s.target
+		if s.best == nil || excess < s.bestExcess ||
+			(excess == s.bestExcess && len(selected) < len(s.best)) {
+			s.best = append([]int{}, selected...)
+			s.bestExcess = excess
+		}
+		// An exact match can't be improved.
+		if excess == 0 {
+			s.tries = 0
+		}
+		return
+	}
+	if index >= len(s.values) || total+s.remaining[index] < s.target {
+		return
+	}
+
+	// Explore the inclusion branch first so that bigger values, and therefore
+	// smaller input sets, are preferred.
+	s.explore(index+1, append(selected, index), total+s.values[index])
+
+	// Omitting a value equal to the previous omitted one leads to the same
+	// combinations already explored, so it can be skipped.
+	next := index + 1
+	for next < len(s.values) && s.values[next] == s.values[index] {
+		next++
+	}
+	s.explore(next, selected, total)
+}
@@ -0,0 +1,174 @@
+package branchbound_selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
+	wallet "github.com/vulpemventures/ocean/pkg/wallet"
+)
+
+func TestSelect(t *testing.T) {
+	type args struct {
+		values    []uint64
+		target    uint64
+		tolerance uint64
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected []int
+	}{
+		{
+			name: "exact match",
+			args: args{
+				values: []uint64{61, 38, 5, 3, 1},
+				target: 6,
+			},
+			expected: []int{2, 4},
+		},
+		{
+			name: "match within tolerance",
+			args: args{
+				values:    []uint64{61, 38, 5, 3},
+				target:    6,
+				tolerance: 2,
+			},
+			expected: []int{2, 3},
+		},
+		{
+			name: "least excess",
+			args: args{
+				values:    []uint64{10, 7, 4},
+				target:    10,
+				tolerance: 5,
+			},
+			expected: []int{0},
+		},
+		{
+			name: "no match",
+			args: args{
+				values:    []uint64{61, 38},
+				target:    6,
+				tolerance: 10,
+			},
+			expected: nil,
+		},
+		{
+			name: "not enough funds",
+			args: args{
+				values: []uint64{2, 2},
+				target: 6,
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexes := selectUtxos(
+				tt.args.values, tt.args.target, tt.args.tolerance,
+			)
+			require.Equal(t, tt.expected, indexes)
+		})
+	}
+}
+
+func TestSelectUtxos(t *testing.T) {
+	feeAsset := "lbtc"
+	asset := "asset"
+	script := append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	// At 1 sat/byte, spending a p2wpkh input costs 69 sats.
+	millisatsPerByte := uint64(1000)
+
+	t.Run("fee asset", func(t *testing.T) {
+		utxos := []*domain.Utxo{
+			{Asset: feeAsset, Value: 20000, Script: script},
+			{Asset: feeAsset, Value: 10069, Script: script},
+			{Asset: feeAsset, Value: 5000, Script: script},
+		}
+
+		selectedUtxos, change, err := NewBranchAndBoundCoinSelector(
+			feeAsset, millisatsPerByte, false,
+		).SelectUtxos(utxos, 10000, feeAsset)
+		require.NoError(t, err)
+		require.Len(t, selectedUtxos, 1)
+		require.Equal(t, uint64(10069), selectedUtxos[0].Value)
+		require.Equal(t, uint64(69), change)
+	})
+
+	t.Run("asset", func(t *testing.T) {
+		utxos := []*domain.Utxo{
+			{Asset: asset, Value: 20000, Script: script},
+			{Asset: asset, Value: 6000, Script: script},
+			{Asset: asset, Value: 4000, Script: script},
+		}
+
+		selectedUtxos, change, err := NewBranchAndBoundCoinSelector(
+			feeAsset, millisatsPerByte, false,
+		).SelectUtxos(utxos, 10000, asset)
+		require.NoError(t, err)
+		require.Len(t, selectedUtxos, 2)
+		require.Zero(t, change)
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		utxos := []*domain.Utxo{
+			{Asset: feeAsset, Value: 50000, Script: script},
+			{Asset: feeAsset, Value: 30000, Script: script},
+		}
+
+		selectedUtxos, change, err := NewBranchAndBoundCoinSelector(
+			feeAsset, millisatsPerByte, false,
+		).SelectUtxos(utxos, 10000, feeAsset)
+		require.NoError(t, err)
+		require.Len(t, selectedUtxos, 1)
+		require.Equal(t, uint64(30000), selectedUtxos[0].Value)
+		require.Equal(t, uint64(20000), change)
+
+		_, _, err = NewBranchAndBoundCoinSelector(
+			feeAsset, millisatsPerByte, false,
+		).SelectUtxos(utxos, 100000, feeAsset)
+		require.ErrorIs(t, err, ss_selector.ErrTargetAmountNotReached)
+	})
+
+	t.Run("skips frozen", func(t *testing.T) {
+		utxos := []*domain.Utxo{
+			{Asset: asset, Value: 10, Script: script},
+			{
+				Asset: asset, Value: 5, Script: script,
+				FrozenTimestamp: 1, FreezeReason: "dust attack",
+			},
+		}
+
+		selectedUtxos, change, err := NewBranchAndBoundCoinSelector(
+			feeAsset, millisatsPerByte, false,
+		).SelectUtxos(utxos, 5, asset)
+		require.NoError(t, err)
+		require.Len(t, selectedUtxos, 1)
+		require.False(t, selectedUtxos[0].IsFrozen())
+		require.Equal(t, uint64(5), change)
+	})
+}
+
+func TestCostOfChange(t *testing.T) {
+	millisatsPerByte := uint64(1000)
+	s := NewBranchAndBoundCoinSelector("lbtc", millisatsPerByte, false).(*selector)
+	discountedS := NewBranchAndBoundCoinSelector(
+		"lbtc", millisatsPerByte, true,
+	).(*selector)
+
+	// The discounted confidential change output weighs as much as an explicit
+	// one, while the cost of spending it is not affected.
+	require.Less(t, discountedS.costOfChange(), s.costOfChange())
+	explicitChangeOutput := wallet.Output{Script: changeScript}
+	require.Equal(
+		t,
+		discountedS.fee(
+			wallet.EstimateOutputSize(explicitChangeOutput)+
+				wallet.EstimateInputSize(changeInput),
+		),
+		discountedS.costOfChange(),
+	)
+}
@@ -0,0 +1,61 @@
+package fragment_selector
+
+import (
+	"sort"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/core/ports"
+	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
+)
+
+type selector struct{}
+
+// NewFragmentCoinSelector returns a coin selector that aims to keep as many
+// utxos as possible available for other concurrent selections.
+// It selects the smallest utxo covering the target amount if any, otherwise
+// it accumulates the biggest ones until the target amount is reached.
+// This way, a wallet whose balance is split into many similar fragments can
+// serve many selections in parallel without waiting for change.
+func NewFragmentCoinSelector() ports.CoinSelector {
+	return &selector{}
+}
+
+func (s *selector) SelectUtxos(
+	utxos []*domain.Utxo, targetAmount uint64, targetAsset string,
+) ([]*domain.Utxo, uint64, error) {
+	targetUtxos := make([]*domain.Utxo, 0)
+	for i := range utxos {
+		utxo := utxos[i]
+		if utxo.IsFrozen() {
+			continue
+		}
+		if utxo.IsConfidential() && !utxo.IsRevealed() {
+			return nil, 0, ss_selector.ErrBlindedUtxos
+		}
+		if utxo.Asset == targetAsset {
+			targetUtxos = append(targetUtxos, utxo)
+		}
+	}
+
+	sort.SliceStable(targetUtxos, func(i, j int) bool {
+		return targetUtxos[i].Value < targetUtxos[j].Value
+	})
+
+	for _, utxo := range targetUtxos {
+		if utxo.Value >= targetAmount {
+			return []*domain.Utxo{utxo}, utxo.Value - targetAmount, nil
+		}
+	}
+
+	selectedUtxos := make([]*domain.Utxo, 0)
+	totalAmount := uint64(0)
+	for i := len(targetUtxos) - 1; i >= 0; i-- {
+		selectedUtxos = append(selectedUtxos, targetUtxos[i])
+		totalAmount += targetUtxos[i].Value
+		if totalAmount >= targetAmount {
+			return selectedUtxos, totalAmount - targetAmount, nil
+		}
+	}
+
+	return nil, 0, ss_selector.ErrTargetAmountNotReached
+}
@@ -0,0 +1,59 @@
+package fragment_selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	ss_selector "github.com/vulpemventures/ocean/internal/infrastructure/coin-selector/smallest-subset"
+)
+
+func TestSelectUtxos(t *testing.T) {
+	asset := "asset"
+	utxos := []*domain.Utxo{
+		{Asset: asset, Value: 100},
+		{Asset: asset, Value: 50},
+		{Asset: asset, Value: 40},
+		{Asset: asset, Value: 30},
+		{Asset: asset, Value: 60, FrozenTimestamp: 1, FreezeReason: "dust attack"},
+		{Asset: "another_asset", Value: 45},
+	}
+
+	tests := []struct {
+		name           string
+		targetAmount   uint64
+		expectedValues []uint64
+		expectedChange uint64
+	}{
+		{
+			name:           "smallest utxo covering target",
+			targetAmount:   45,
+			expectedValues: []uint64{50},
+			expectedChange: 5,
+		},
+		{
+			name:           "biggest utxos covering target",
+			targetAmount:   170,
+			expectedValues: []uint64{100, 50, 40},
+			expectedChange: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selectedUtxos, change, err := NewFragmentCoinSelector().SelectUtxos(
+				utxos, tt.targetAmount, asset,
+			)
+			require.NoError(t, err)
+			values := make([]uint64, 0, len(selectedUtxos))
+			for _, u := range selectedUtxos {
+				values = append(values, u.Value)
+			}
+			require.Equal(t, tt.expectedValues, values)
+			require.Equal(t, tt.expectedChange, change)
+		})
+	}
+
+	_, _, err := NewFragmentCoinSelector().SelectUtxos(utxos, 221, asset)
+	require.ErrorIs(t, err, ss_selector.ErrTargetAmountNotReached)
+}
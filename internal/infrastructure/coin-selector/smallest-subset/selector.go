@@ -30,6 +30,9 @@ func (s *selector) SelectUtxos(
 	balance := uint64(0)
 	for i := range utxos {
 		utxo := utxos[i]
+		if utxo.IsFrozen() {
+			continue
+		}
 		if utxo.IsConfidential() && !utxo.IsRevealed() {
 			return nil, 0, ErrBlindedUtxos
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
 )
 
 func TestGetBestPairs(t *testing.T) {
@@ -113,3 +114,22 @@ func TestFindIndexes(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectUtxosSkipsFrozen(t *testing.T) {
+	asset := "asset"
+	utxos := []*domain.Utxo{
+		{Asset: asset, Value: 10},
+		{Asset: asset, Value: 5, FrozenTimestamp: 1, FreezeReason: "dust attack"},
+	}
+
+	selectedUtxos, change, err := NewSmallestSubsetCoinSelector().SelectUtxos(
+		utxos, 5, asset,
+	)
+	require.NoError(t, err)
+	require.Len(t, selectedUtxos, 1)
+	require.False(t, selectedUtxos[0].IsFrozen())
+	require.Equal(t, uint64(5), change)
+
+	_, _, err = NewSmallestSubsetCoinSelector().SelectUtxos(utxos, 15, asset)
+	require.ErrorIs(t, err, ErrTargetAmountNotReached)
+}
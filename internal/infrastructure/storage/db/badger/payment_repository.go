@@ -0,0 +1,182 @@
+package dbbadger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type paymentRepository struct {
+	store    *badgerhold.Store
+	chEvents chan domain.PaymentEvent
+}
+
+func NewPaymentRepository(store *badgerhold.Store) domain.PaymentRepository {
+	return newPaymentRepository(store)
+}
+
+func newPaymentRepository(store *badgerhold.Store) *paymentRepository {
+	return &paymentRepository{store, make(chan domain.PaymentEvent)}
+}
+
+func (r *paymentRepository) AddPayment(
+	ctx context.Context, payment *domain.Payment,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, payment.ID, *payment)
+	} else {
+		err = r.store.Insert(payment.ID, *payment)
+	}
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *paymentRepository) GetQueuedPayments(
+	ctx context.Context,
+) ([]*domain.Payment, error) {
+	query := (&badgerhold.Query{}).SortBy("Timestamp")
+
+	var list []domain.Payment
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*domain.Payment, 0, len(list))
+	for i := range list {
+		payments = append(payments, &list[i])
+	}
+	return payments, nil
+}
+
+func (r *paymentRepository) DeletePayment(
+	ctx context.Context, id string,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, id, domain.Payment{})
+	} else {
+		err = r.store.Delete(id, domain.Payment{})
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *paymentRepository) MarkPaymentsSending(
+	ctx context.Context, ids []string, txid string,
+) (int, error) {
+	return r.updatePayments(ctx, ids, func(p *domain.Payment) {
+		p.TxID = txid
+	})
+}
+
+func (r *paymentRepository) MarkPaymentsFailed(
+	ctx context.Context, ids []string, reason string,
+) (int, error) {
+	return r.updatePayments(ctx, ids, func(p *domain.Payment) {
+		p.FailureReason = reason
+	})
+}
+
+func (r *paymentRepository) FlushPayments(
+	ctx context.Context, ids []string, txid string,
+) (int, error) {
+	payments := make([]domain.Payment, 0, len(ids))
+	for _, id := range ids {
+		var payment domain.Payment
+		if err := r.store.Get(id, &payment); err != nil {
+			if err == badgerhold.ErrNotFound {
+				continue
+			}
+			return -1, err
+		}
+		if _, err := r.DeletePayment(ctx, id); err != nil {
+			return -1, err
+		}
+		payments = append(payments, payment)
+	}
+
+	if len(payments) > 0 {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentsFlushed,
+			TxID:      txid,
+			Payments:  payments,
+		})
+	}
+
+	return len(payments), nil
+}
+
+func (r *paymentRepository) updatePayments(
+	ctx context.Context, ids []string, update func(*domain.Payment),
+) (int, error) {
+	count := 0
+	for _, id := range ids {
+		var payment domain.Payment
+		var err error
+		if ctx.Value("tx") != nil {
+			tx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxGet(tx, id, &payment)
+		} else {
+			err = r.store.Get(id, &payment)
+		}
+		if err != nil {
+			if err == badgerhold.ErrNotFound {
+				continue
+			}
+			return -1, err
+		}
+
+		update(&payment)
+
+		if ctx.Value("tx") != nil {
+			tx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxUpdate(tx, id, payment)
+		} else {
+			err = r.store.Update(id, payment)
+		}
+		if err != nil {
+			return -1, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (r *paymentRepository) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepository) publishEvent(event domain.PaymentEvent) {
+	r.chEvents <- event
+}
+
+func (r *paymentRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *paymentRepository) close() {
+	r.store.Close()
+	close(r.chEvents)
+}
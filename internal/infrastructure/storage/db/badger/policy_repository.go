@@ -0,0 +1,221 @@
+package dbbadger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type policyRepository struct {
+	store    *badgerhold.Store
+	chEvents chan domain.PolicyEvent
+}
+
+func NewSpendingPolicyRepository(
+	store *badgerhold.Store,
+) domain.SpendingPolicyRepository {
+	return newPolicyRepository(store)
+}
+
+func newPolicyRepository(store *badgerhold.Store) *policyRepository {
+	return &policyRepository{store, make(chan domain.PolicyEvent)}
+}
+
+func (r *policyRepository) SetPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+) error {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		return r.store.TxUpsert(tx, policy.AccountName, *policy)
+	}
+	return r.store.Upsert(policy.AccountName, *policy)
+}
+
+func (r *policyRepository) GetPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	var err error
+	var policy domain.SpendingPolicy
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, accountName, &policy)
+	} else {
+		err = r.store.Get(accountName, &policy)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrSpendingPolicyNotFound
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *policyRepository) DeletePolicy(
+	ctx context.Context, accountName string,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, accountName, domain.SpendingPolicy{})
+	} else {
+		err = r.store.Delete(accountName, domain.SpendingPolicy{})
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepository) AddSpending(
+	ctx context.Context, spending *domain.Spending,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, spending.ID, *spending)
+	} else {
+		err = r.store.Insert(spending.ID, *spending)
+	}
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepository) GetSpending(
+	ctx context.Context, id string,
+) (*domain.Spending, error) {
+	var err error
+	var spending domain.Spending
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, id, &spending)
+	} else {
+		err = r.store.Get(id, &spending)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, domain.ErrSpendingNotFound
+		}
+		return nil, err
+	}
+	return &spending, nil
+}
+
+func (r *policyRepository) GetSpendingsForAccount(
+	ctx context.Context, accountName string, since int64,
+) ([]*domain.Spending, error) {
+	query := badgerhold.Where("AccountName").Eq(accountName).
+		And("CreatedAt").Ge(since).SortBy("CreatedAt")
+
+	var list []domain.Spending
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spendings := make([]*domain.Spending, 0, len(list))
+	for i := range list {
+		spendings = append(spendings, &list[i])
+	}
+	return spendings, nil
+}
+
+func (r *policyRepository) UpdateSpending(
+	ctx context.Context, id string,
+	updateFn func(s *domain.Spending) (*domain.Spending, error),
+) error {
+	spending, err := r.GetSpending(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	updatedSpending, err := updateFn(spending)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		return r.store.TxUpdate(tx, id, *updatedSpending)
+	}
+	return r.store.Update(id, *updatedSpending)
+}
+
+func (r *policyRepository) AddViolation(
+	ctx context.Context, violation *domain.PolicyViolation,
+) error {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, violation.ID, *violation)
+	} else {
+		err = r.store.Insert(violation.ID, *violation)
+	}
+	if err != nil {
+		return err
+	}
+
+	go r.publishEvent(domain.PolicyEvent{
+		EventType: domain.PolicyViolated,
+		Violation: *violation,
+	})
+
+	return nil
+}
+
+func (r *policyRepository) GetViolationsForAccount(
+	ctx context.Context, accountName string,
+) ([]*domain.PolicyViolation, error) {
+	query := badgerhold.Where("AccountName").Eq(accountName).SortBy("Timestamp")
+
+	var list []domain.PolicyViolation
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &list, query)
+	} else {
+		err = r.store.Find(&list, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]*domain.PolicyViolation, 0, len(list))
+	for i := range list {
+		violations = append(violations, &list[i])
+	}
+	return violations, nil
+}
+
+func (r *policyRepository) GetEventChannel() chan domain.PolicyEvent {
+	return r.chEvents
+}
+
+func (r *policyRepository) publishEvent(event domain.PolicyEvent) {
+	r.chEvents <- event
+}
+
+func (r *policyRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *policyRepository) close() {
+	r.store.Close()
+	close(r.chEvents)
+}
@@ -17,10 +17,14 @@ import (
 // repoManager holds all the badgerhold stores and domain repositories
 // implementations in a single data structure.
 type repoManager struct {
-	utxoRepository   *utxoRepository
-	walletRepository *walletRepository
-	txRepository     *transactionRepository
-	scriptRepository *scriptRepository
+	utxoRepository     *utxoRepository
+	walletRepository   *walletRepository
+	txRepository       *transactionRepository
+	scriptRepository   *scriptRepository
+	transferRepository *transferRepository
+	paymentRepository  *paymentRepository
+	swapRepository     *swapRepository
+	policyRepository   *policyRepository
 
 	walletEventHandlers *handlerMap
 	utxoEventHandlers   *handlerMap
@@ -34,12 +38,17 @@ type repoManager struct {
 // is provided - to be used only for testing purposes), and opening and closing
 // the connection to them.
 func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager, error) {
-	var walletdbDir, utxoDir, txDir, scriptDir string
+	var walletdbDir, utxoDir, txDir, scriptDir, transferDir, paymentDir, swapDir string
+	var policyDir string
 	if len(baseDbDir) > 0 {
 		walletdbDir = filepath.Join(baseDbDir, "wallet")
 		utxoDir = filepath.Join(baseDbDir, "utxos")
 		txDir = filepath.Join(baseDbDir, "txs")
 		scriptDir = filepath.Join(baseDbDir, "scripts")
+		transferDir = filepath.Join(baseDbDir, "transfers")
+		paymentDir = filepath.Join(baseDbDir, "payments")
+		swapDir = filepath.Join(baseDbDir, "swaps")
+		policyDir = filepath.Join(baseDbDir, "policies")
 	}
 
 	walletDb, err := createDb(walletdbDir, logger)
@@ -60,17 +69,41 @@ func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager,
 	if err != nil {
 		return nil, fmt.Errorf("opening external scripts db: %w", err)
 	}
+	transferDb, err := createDb(transferDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening transfers db: %w", err)
+	}
+	paymentDb, err := createDb(paymentDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening payments db: %w", err)
+	}
+	swapDb, err := createDb(swapDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening swaps db: %w", err)
+	}
+	policyDb, err := createDb(policyDir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("opening spending policies db: %w", err)
+	}
 
 	utxoRepo := newUtxoRepository(utxoDb)
 	walletRepo := newWalletRepository(walletDb)
 	txRepo := newTransactionRepository(txDb)
 	scriptRepo := newExternalScriptRepository(scriptDb)
+	transferRepo := newTransferRepository(transferDb)
+	paymentRepo := newPaymentRepository(paymentDb)
+	swapRepo := newSwapRepository(swapDb)
+	policyRepo := newPolicyRepository(policyDb)
 
 	rm := &repoManager{
 		utxoRepository:      utxoRepo,
 		walletRepository:    walletRepo,
 		txRepository:        txRepo,
 		scriptRepository:    scriptRepo,
+		transferRepository:  transferRepo,
+		paymentRepository:   paymentRepo,
+		swapRepository:      swapRepo,
+		policyRepository:    policyRepo,
 		walletEventHandlers: newHandlerMap(),
 		utxoEventHandlers:   newHandlerMap(),
 		txEventHandlers:     newHandlerMap(),
@@ -101,6 +134,22 @@ func (d *repoManager) ExternalScriptRepository() domain.ExternalScriptRepository
 	return d.scriptRepository
 }
 
+func (d *repoManager) TransferRepository() domain.TransferRepository {
+	return d.transferRepository
+}
+
+func (d *repoManager) PaymentRepository() domain.PaymentRepository {
+	return d.paymentRepository
+}
+
+func (d *repoManager) SwapRepository() domain.SwapRepository {
+	return d.swapRepository
+}
+
+func (d *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return d.policyRepository
+}
+
 func (rm *repoManager) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -130,6 +179,10 @@ func (d *repoManager) Reset() {
 	d.utxoRepository.reset()
 	d.txRepository.reset()
 	d.scriptRepository.reset()
+	d.transferRepository.reset()
+	d.paymentRepository.reset()
+	d.swapRepository.reset()
+	d.policyRepository.reset()
 }
 
 func (d *repoManager) Close() {
@@ -137,6 +190,10 @@ func (d *repoManager) Close() {
 	d.utxoRepository.close()
 	d.txRepository.close()
 	d.scriptRepository.close()
+	d.transferRepository.close()
+	d.paymentRepository.close()
+	d.swapRepository.close()
+	d.policyRepository.close()
 }
 
 func (rm *repoManager) listenToWalletEvents() {
@@ -0,0 +1,88 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type swapRepository struct {
+	store *badgerhold.Store
+}
+
+func NewSwapRepository(store *badgerhold.Store) domain.SwapRepository {
+	return newSwapRepository(store)
+}
+
+func newSwapRepository(store *badgerhold.Store) *swapRepository {
+	return &swapRepository{store}
+}
+
+func (r *swapRepository) AddSwap(
+	ctx context.Context, swap *domain.Swap,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, swap.ID, *swap)
+	} else {
+		err = r.store.Insert(swap.ID, *swap)
+	}
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *swapRepository) GetSwap(
+	ctx context.Context, id string,
+) (*domain.Swap, error) {
+	var err error
+	var swap domain.Swap
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, id, &swap)
+	} else {
+		err = r.store.Get(id, &swap)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, fmt.Errorf("swap not found")
+		}
+		return nil, err
+	}
+	return &swap, nil
+}
+
+func (r *swapRepository) DeleteSwap(
+	ctx context.Context, id string,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, id, domain.Swap{})
+	} else {
+		err = r.store.Delete(id, domain.Swap{})
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *swapRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *swapRepository) close() {
+	r.store.Close()
+}
@@ -81,12 +81,104 @@ func (r *transactionRepository) ConfirmTransaction(
 	return true, nil
 }
 
+func (r *transactionRepository) UnconfirmTransaction(
+	ctx context.Context, txid string,
+) (bool, error) {
+	tx, err := r.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if !tx.IsConfirmed() {
+		return false, nil
+	}
+
+	tx.Unconfirm()
+
+	if err := r.updateTx(ctx, *tx); err != nil {
+		return false, err
+	}
+
+	go r.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionUnconfirmed,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
+func (r *transactionRepository) DeleteTransaction(
+	ctx context.Context, txid string,
+) (bool, error) {
+	tx, err := r.getTx(ctx, txid)
+	if err != nil {
+		return false, nil
+	}
+
+	if err := r.deleteTx(ctx, txid); err != nil {
+		return false, err
+	}
+
+	go r.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionDeleted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
 func (r *transactionRepository) GetTransaction(
 	ctx context.Context, txid string,
 ) (*domain.Transaction, error) {
 	return r.getTx(ctx, txid)
 }
 
+func (r *transactionRepository) GetTransactionsForAccount(
+	ctx context.Context, accountName string,
+) ([]*domain.Transaction, error) {
+	query := badgerhold.Where("Accounts").HasKey(accountName)
+
+	return r.findTxs(ctx, query)
+}
+
+func (r *transactionRepository) GetTransactionsForAccountPage(
+	ctx context.Context, accountName string, offset, limit int,
+) ([]*domain.Transaction, error) {
+	// Confirmed txs come first, followed by the unconfirmed ones.
+	confirmedQuery := func() *badgerhold.Query {
+		return badgerhold.Where("Accounts").HasKey(accountName).
+			And("BlockHash").Ne("")
+	}
+	txs, err := r.findTxs(
+		ctx, confirmedQuery().SortBy("BlockHeight", "TxID").
+			Skip(offset).Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) >= limit {
+		return txs, nil
+	}
+
+	count, err := r.countTxs(ctx, confirmedQuery())
+	if err != nil {
+		return nil, err
+	}
+	unconfirmedOffset := 0
+	if offset > count {
+		unconfirmedOffset = offset - count
+	}
+	unconfirmedTxs, err := r.findTxs(
+		ctx, badgerhold.Where("Accounts").HasKey(accountName).
+			And("BlockHash").Eq("").SortBy("TxID").
+			Skip(unconfirmedOffset).Limit(limit-len(txs)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return append(txs, unconfirmedTxs...), nil
+}
+
 func (r *transactionRepository) UpdateTransaction(
 	ctx context.Context, txid string,
 	updateFn func(*domain.Transaction) (*domain.Transaction, error),
@@ -152,6 +244,45 @@ func (r *transactionRepository) getTx(
 	return &tx, nil
 }
 
+func (r *transactionRepository) findTxs(
+	ctx context.Context, query *badgerhold.Query,
+) ([]*domain.Transaction, error) {
+	var txs []domain.Transaction
+	var err error
+	if ctx.Value("tx") != nil {
+		t := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(t, &txs, query)
+	} else {
+		err = r.store.Find(&txs, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*domain.Transaction, 0, len(txs))
+	for i := range txs {
+		list = append(list, &txs[i])
+	}
+	return list, nil
+}
+
+func (r *transactionRepository) countTxs(
+	ctx context.Context, query *badgerhold.Query,
+) (int, error) {
+	var count uint64
+	var err error
+	if ctx.Value("tx") != nil {
+		t := ctx.Value("tx").(*badger.Txn)
+		count, err = r.store.TxCount(t, &domain.Transaction{}, query)
+	} else {
+		count, err = r.store.Count(&domain.Transaction{}, query)
+	}
+	if err != nil {
+		return -1, err
+	}
+	return int(count), nil
+}
+
 func (r *transactionRepository) updateTx(
 	ctx context.Context, tx domain.Transaction,
 ) error {
@@ -162,6 +293,16 @@ func (r *transactionRepository) updateTx(
 	return r.store.Update(tx.TxID, tx)
 }
 
+func (r *transactionRepository) deleteTx(
+	ctx context.Context, txid string,
+) error {
+	if ctx.Value("tx") != nil {
+		t := ctx.Value("tx").(*badger.Txn)
+		return r.store.TxDelete(t, txid, domain.Transaction{})
+	}
+	return r.store.Delete(txid, domain.Transaction{})
+}
+
 func (r *transactionRepository) publishEvent(event domain.TransactionEvent) {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -0,0 +1,88 @@
+package dbbadger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type transferRepository struct {
+	store *badgerhold.Store
+}
+
+func NewTransferRepository(store *badgerhold.Store) domain.TransferRepository {
+	return newTransferRepository(store)
+}
+
+func newTransferRepository(store *badgerhold.Store) *transferRepository {
+	return &transferRepository{store}
+}
+
+func (r *transferRepository) AddTransfer(
+	ctx context.Context, transfer *domain.Transfer,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxInsert(tx, transfer.TxID, *transfer)
+	} else {
+		err = r.store.Insert(transfer.TxID, *transfer)
+	}
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *transferRepository) GetTransfer(
+	ctx context.Context, txid string,
+) (*domain.Transfer, error) {
+	var err error
+	var transfer domain.Transfer
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxGet(tx, txid, &transfer)
+	} else {
+		err = r.store.Get(txid, &transfer)
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, fmt.Errorf("transfer not found")
+		}
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (r *transferRepository) DeleteTransfer(
+	ctx context.Context, txid string,
+) (bool, error) {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, txid, domain.Transfer{})
+	} else {
+		err = r.store.Delete(txid, domain.Transfer{})
+	}
+	if err != nil {
+		if err == badgerhold.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *transferRepository) reset() {
+	r.store.Badger().DropAll()
+}
+
+func (r *transferRepository) close() {
+	r.store.Close()
+}
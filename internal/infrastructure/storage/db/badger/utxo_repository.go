@@ -69,7 +69,8 @@ func (r *utxoRepository) GetSpendableUtxos(
 	ctx context.Context,
 ) ([]*domain.Utxo, error) {
 	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
-		And("ConfirmedStatus").Ne(domain.UtxoStatus{}).And("LockTimestamp").Eq(int64(0))
+		And("ConfirmedStatus").Ne(domain.UtxoStatus{}).And("LockTimestamp").Eq(int64(0)).
+		And("FrozenTimestamp").Eq(int64(0))
 
 	return r.findUtxos(ctx, query)
 }
@@ -87,7 +88,8 @@ func (r *utxoRepository) GetSpendableUtxosForAccount(
 ) ([]*domain.Utxo, error) {
 	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
 		And("ConfirmedStatus").Ne(domain.UtxoStatus{}).
-		And("LockTimestamp").Eq(int64(0)).And("AccountName").Eq(accountName)
+		And("LockTimestamp").Eq(int64(0)).And("FrozenTimestamp").Eq(int64(0)).
+		And("AccountName").Eq(accountName)
 
 	return r.findUtxos(ctx, query)
 }
@@ -96,7 +98,26 @@ func (r *utxoRepository) GetLockedUtxosForAccount(
 	ctx context.Context, accountName string,
 ) ([]*domain.Utxo, error) {
 	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
-		And("LockTimestamp").Gt(int64(0)).And("AccountName").Eq(accountName)
+		And("LockTimestamp").Gt(int64(0)).And("FrozenTimestamp").Eq(int64(0)).
+		And("AccountName").Eq(accountName)
+
+	return r.findUtxos(ctx, query)
+}
+
+func (r *utxoRepository) GetFrozenUtxosForAccount(
+	ctx context.Context, accountName string,
+) ([]*domain.Utxo, error) {
+	query := badgerhold.Where("SpentStatus").Eq(domain.UtxoStatus{}).
+		And("FrozenTimestamp").Gt(int64(0)).And("AccountName").Eq(accountName)
+
+	return r.findUtxos(ctx, query)
+}
+
+func (r *utxoRepository) GetUtxosForLease(
+	ctx context.Context, leaseID string,
+) ([]*domain.Utxo, error) {
+	query := badgerhold.Where("LeaseID").Eq(leaseID).
+		And("LockTimestamp").Gt(int64(0))
 
 	return r.findUtxos(ctx, query)
 }
@@ -120,7 +141,9 @@ func (r *utxoRepository) GetBalanceForAccount(
 		}
 
 		b := balance[u.Asset]
-		if u.IsLocked() {
+		if u.IsFrozen() {
+			b.Frozen += u.Value
+		} else if u.IsLocked() {
 			b.Locked += u.Value
 		} else {
 			if u.IsConfirmed() {
@@ -151,11 +174,29 @@ func (r *utxoRepository) ConfirmUtxos(
 	return r.confirmUtxos(ctx, utxoKeys, status)
 }
 
+func (r *utxoRepository) UnconfirmUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return r.unconfirmUtxos(ctx, utxoKeys, false)
+}
+
+func (r *utxoRepository) UnconfirmSpendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return r.unconfirmUtxos(ctx, utxoKeys, true)
+}
+
+func (r *utxoRepository) UnspendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return r.unspendUtxos(ctx, utxoKeys)
+}
+
 func (r *utxoRepository) LockUtxos(
 	ctx context.Context,
-	utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	utxoKeys []domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (int, error) {
-	return r.lockUtxos(ctx, utxoKeys, timestamp, expiryTimestamp)
+	return r.lockUtxos(ctx, utxoKeys, leaseID, timestamp, expiryTimestamp)
 }
 
 func (r *utxoRepository) UnlockUtxos(
@@ -164,6 +205,32 @@ func (r *utxoRepository) UnlockUtxos(
 	return r.unlockUtxos(ctx, utxoKeys)
 }
 
+func (r *utxoRepository) ReleaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, leaseID string,
+) (int, error) {
+	return r.releaseUtxos(ctx, utxoKeys, leaseID)
+}
+
+func (r *utxoRepository) ExtendUtxosLease(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, leaseID string, expiryTimestamp int64,
+) (int, error) {
+	return r.extendUtxosLease(ctx, utxoKeys, leaseID, expiryTimestamp)
+}
+
+func (r *utxoRepository) FreezeUtxos(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, reason string, timestamp int64,
+) (int, error) {
+	return r.freezeUtxos(ctx, utxoKeys, reason, timestamp)
+}
+
+func (r *utxoRepository) UnfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return r.unfreezeUtxos(ctx, utxoKeys)
+}
+
 func (r *utxoRepository) DeleteUtxosForAccount(
 	ctx context.Context, accountName string,
 ) error {
@@ -181,6 +248,27 @@ func (r *utxoRepository) DeleteUtxosForAccount(
 	return r.deleteUtxos(ctx, utxoKeys)
 }
 
+func (r *utxoRepository) DeleteUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	utxos, err := r.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+	if len(utxos) <= 0 {
+		return 0, nil
+	}
+
+	keys := make([]domain.UtxoKey, 0, len(utxos))
+	for _, u := range utxos {
+		keys = append(keys, u.Key())
+	}
+	if err := r.deleteUtxos(ctx, keys); err != nil {
+		return -1, err
+	}
+	return len(keys), nil
+}
+
 func (r *utxoRepository) GetEventChannel() chan domain.UtxoEvent {
 	return r.externalChEvents
 }
@@ -291,14 +379,42 @@ func (r *utxoRepository) confirmUtxos(
 	return count, nil
 }
 
+func (r *utxoRepository) unconfirmUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, spendOnly bool,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := r.unconfirmUtxo(ctx, key, spendOnly)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnconfirmed,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (r *utxoRepository) lockUtxos(
 	ctx context.Context,
-	utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	utxoKeys []domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (int, error) {
 	count := 0
 	utxosInfo := make([]domain.UtxoInfo, 0)
 	for _, key := range utxoKeys {
-		done, info, err := r.lockUtxo(ctx, key, timestamp, expiryTimestamp)
+		done, info, err := r.lockUtxo(
+			ctx, key, leaseID, timestamp, expiryTimestamp,
+		)
 		if err != nil {
 			return -1, err
 		}
@@ -431,8 +547,43 @@ func (r *utxoRepository) confirmUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (r *utxoRepository) unconfirmUtxo(
+	ctx context.Context, key domain.UtxoKey, spendOnly bool,
+) (bool, *domain.UtxoInfo, error) {
+	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
+	utxos, err := r.findUtxos(ctx, query)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if utxos == nil {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if spendOnly {
+		if !utxo.IsConfirmedSpent() {
+			return false, nil, nil
+		}
+		utxo.UnconfirmSpend()
+	} else {
+		if !utxo.IsConfirmed() {
+			return false, nil, nil
+		}
+		utxo.Unconfirm()
+	}
+
+	if err := r.updateUtxo(ctx, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
 func (r *utxoRepository) lockUtxo(
-	ctx context.Context, key domain.UtxoKey, timestamp, expiryTimestamp int64,
+	ctx context.Context,
+	key domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (bool, *domain.UtxoInfo, error) {
 	query := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
 	utxos, err := r.findUtxos(ctx, query)
@@ -445,11 +596,11 @@ func (r *utxoRepository) lockUtxo(
 	}
 
 	utxo := utxos[0]
-	if utxo.IsLocked() {
+	if utxo.IsLocked() || utxo.IsFrozen() {
 		return false, nil, nil
 	}
 
-	utxo.Lock(timestamp, expiryTimestamp)
+	utxo.Lock(leaseID, timestamp, expiryTimestamp)
 	if err := r.updateUtxo(ctx, utxo); err != nil {
 		return false, nil, err
 	}
@@ -485,6 +636,189 @@ func (r *utxoRepository) unlockUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (r *utxoRepository) freezeUtxos(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, reason string, timestamp int64,
+) (int, error) {
+	utxos, err := r.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.IsFrozen() {
+			continue
+		}
+		if err := utxo.Freeze(reason, timestamp); err != nil {
+			return -1, err
+		}
+		if err := r.updateUtxo(ctx, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoFrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (r *utxoRepository) unfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	utxos, err := r.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.IsFrozen() {
+			continue
+		}
+		utxo.Unfreeze()
+		if err := r.updateUtxo(ctx, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnfrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (r *utxoRepository) unspendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	utxos, err := r.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.IsSpent() || utxo.IsConfirmedSpent() {
+			continue
+		}
+		utxo.Unspend()
+		if err := r.updateUtxo(ctx, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnspent,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (r *utxoRepository) releaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, leaseID string,
+) (int, error) {
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxoKeys))
+	if err := r.withTx(ctx, func(ctx context.Context) error {
+		utxos, err := r.GetUtxosByKey(ctx, utxoKeys)
+		if err != nil {
+			return err
+		}
+
+		utxosToRelease := make([]*domain.Utxo, 0, len(utxos))
+		for _, utxo := range utxos {
+			if !utxo.IsLocked() {
+				continue
+			}
+			if err := utxo.Release(leaseID); err != nil {
+				return err
+			}
+			utxosToRelease = append(utxosToRelease, utxo)
+		}
+
+		for _, utxo := range utxosToRelease {
+			if err := r.updateUtxo(ctx, utxo); err != nil {
+				return err
+			}
+			utxosInfo = append(utxosInfo, utxo.Info())
+		}
+		return nil
+	}); err != nil {
+		return -1, err
+	}
+
+	if len(utxosInfo) > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnlocked,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (r *utxoRepository) extendUtxosLease(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, leaseID string, expiryTimestamp int64,
+) (int, error) {
+	count := 0
+	if err := r.withTx(ctx, func(ctx context.Context) error {
+		utxos, err := r.GetUtxosByKey(ctx, utxoKeys)
+		if err != nil {
+			return err
+		}
+
+		for _, utxo := range utxos {
+			if err := utxo.ExtendLease(leaseID, expiryTimestamp); err != nil {
+				return err
+			}
+		}
+		for _, utxo := range utxos {
+			if err := r.updateUtxo(ctx, utxo); err != nil {
+				return err
+			}
+		}
+		count = len(utxos)
+		return nil
+	}); err != nil {
+		return -1, err
+	}
+
+	return count, nil
+}
+
+// withTx runs the given function within a single db transaction, unless the
+// given context already carries one.
+func (r *utxoRepository) withTx(
+	ctx context.Context, fn func(ctx context.Context) error,
+) error {
+	if ctx.Value("tx") != nil {
+		return fn(ctx)
+	}
+
+	tx := r.store.Badger().NewTransaction(true)
+	defer tx.Discard()
+
+	if err := fn(context.WithValue(ctx, "tx", tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func (r *utxoRepository) findUtxos(
 	ctx context.Context, query *badgerhold.Query,
 ) ([]*domain.Utxo, error) {
@@ -556,8 +890,12 @@ func (r *utxoRepository) insertUtxo(
 func (r *utxoRepository) deleteUtxos(
 	ctx context.Context, keys []domain.UtxoKey,
 ) error {
-	query := &badgerhold.Query{}
-	for _, key := range keys {
+	if len(keys) <= 0 {
+		return nil
+	}
+
+	query := badgerhold.Where("TxID").Eq(keys[0].TxID).And("VOut").Eq(keys[0].VOut)
+	for _, key := range keys[1:] {
 		qq := badgerhold.Where("TxID").Eq(key.TxID).And("VOut").Eq(key.VOut)
 		query = query.Or(qq)
 	}
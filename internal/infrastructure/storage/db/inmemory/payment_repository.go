@@ -0,0 +1,163 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type paymentInmemoryStore struct {
+	payments map[string]*domain.Payment
+	lock     *sync.RWMutex
+}
+
+type paymentRepository struct {
+	store    *paymentInmemoryStore
+	chEvents chan domain.PaymentEvent
+}
+
+func NewPaymentRepository() domain.PaymentRepository {
+	return newPaymentRepository()
+}
+
+func newPaymentRepository() *paymentRepository {
+	return &paymentRepository{
+		store: &paymentInmemoryStore{
+			payments: make(map[string]*domain.Payment),
+			lock:     &sync.RWMutex{},
+		},
+		chEvents: make(chan domain.PaymentEvent),
+	}
+}
+
+func (r *paymentRepository) AddPayment(
+	_ context.Context, payment *domain.Payment,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.payments[payment.ID]; ok {
+		return false, nil
+	}
+
+	r.store.payments[payment.ID] = payment
+	return true, nil
+}
+
+func (r *paymentRepository) GetQueuedPayments(
+	_ context.Context,
+) ([]*domain.Payment, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	payments := make([]*domain.Payment, 0, len(r.store.payments))
+	for _, payment := range r.store.payments {
+		payments = append(payments, payment)
+	}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].Timestamp < payments[j].Timestamp
+	})
+	return payments, nil
+}
+
+func (r *paymentRepository) DeletePayment(
+	_ context.Context, id string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.payments[id]; !ok {
+		return false, nil
+	}
+
+	delete(r.store.payments, id)
+	return true, nil
+}
+
+func (r *paymentRepository) MarkPaymentsSending(
+	_ context.Context, ids []string, txid string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	count := 0
+	for _, id := range ids {
+		payment, ok := r.store.payments[id]
+		if !ok {
+			continue
+		}
+		updatedPayment := *payment
+		updatedPayment.TxID = txid
+		r.store.payments[id] = &updatedPayment
+		count++
+	}
+	return count, nil
+}
+
+func (r *paymentRepository) MarkPaymentsFailed(
+	_ context.Context, ids []string, reason string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	count := 0
+	for _, id := range ids {
+		payment, ok := r.store.payments[id]
+		if !ok {
+			continue
+		}
+		updatedPayment := *payment
+		updatedPayment.FailureReason = reason
+		r.store.payments[id] = &updatedPayment
+		count++
+	}
+	return count, nil
+}
+
+func (r *paymentRepository) FlushPayments(
+	_ context.Context, ids []string, txid string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	payments := make([]domain.Payment, 0, len(ids))
+	for _, id := range ids {
+		payment, ok := r.store.payments[id]
+		if !ok {
+			continue
+		}
+		payments = append(payments, *payment)
+		delete(r.store.payments, id)
+	}
+
+	if len(payments) > 0 {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentsFlushed,
+			TxID:      txid,
+			Payments:  payments,
+		})
+	}
+
+	return len(payments), nil
+}
+
+func (r *paymentRepository) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepository) publishEvent(event domain.PaymentEvent) {
+	r.chEvents <- event
+}
+
+func (r *paymentRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.payments = make(map[string]*domain.Payment)
+}
+
+func (r *paymentRepository) close() {
+	close(r.chEvents)
+}
@@ -0,0 +1,202 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type policyInmemoryStore struct {
+	policies   map[string]*domain.SpendingPolicy
+	spendings  map[string]*domain.Spending
+	violations []*domain.PolicyViolation
+	lock       *sync.RWMutex
+}
+
+type policyRepository struct {
+	store    *policyInmemoryStore
+	chEvents chan domain.PolicyEvent
+}
+
+func NewSpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return newPolicyRepository()
+}
+
+func newPolicyRepository() *policyRepository {
+	return &policyRepository{
+		store: &policyInmemoryStore{
+			policies:  make(map[string]*domain.SpendingPolicy),
+			spendings: make(map[string]*domain.Spending),
+			lock:      &sync.RWMutex{},
+		},
+		chEvents: make(chan domain.PolicyEvent),
+	}
+}
+
+func (r *policyRepository) SetPolicy(
+	_ context.Context, policy *domain.SpendingPolicy,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.policies[policy.AccountName] = policy
+	return nil
+}
+
+func (r *policyRepository) GetPolicy(
+	_ context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	policy, ok := r.store.policies[accountName]
+	if !ok {
+		return nil, domain.ErrSpendingPolicyNotFound
+	}
+	return policy, nil
+}
+
+func (r *policyRepository) DeletePolicy(
+	_ context.Context, accountName string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.policies[accountName]; !ok {
+		return false, nil
+	}
+
+	delete(r.store.policies, accountName)
+	return true, nil
+}
+
+func (r *policyRepository) AddSpending(
+	_ context.Context, spending *domain.Spending,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.spendings[spending.ID]; ok {
+		return false, nil
+	}
+
+	r.store.spendings[spending.ID] = copySpending(spending)
+	return true, nil
+}
+
+func (r *policyRepository) GetSpending(
+	_ context.Context, id string,
+) (*domain.Spending, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	spending, ok := r.store.spendings[id]
+	if !ok {
+		return nil, domain.ErrSpendingNotFound
+	}
+	return copySpending(spending), nil
+}
+
+func (r *policyRepository) GetSpendingsForAccount(
+	_ context.Context, accountName string, since int64,
+) ([]*domain.Spending, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	spendings := make([]*domain.Spending, 0)
+	for _, spending := range r.store.spendings {
+		if spending.AccountName != accountName || spending.CreatedAt < since {
+			continue
+		}
+		spendings = append(spendings, copySpending(spending))
+	}
+	sort.SliceStable(spendings, func(i, j int) bool {
+		return spendings[i].CreatedAt < spendings[j].CreatedAt
+	})
+	return spendings, nil
+}
+
+func (r *policyRepository) UpdateSpending(
+	_ context.Context, id string,
+	updateFn func(s *domain.Spending) (*domain.Spending, error),
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	spending, ok := r.store.spendings[id]
+	if !ok {
+		return domain.ErrSpendingNotFound
+	}
+
+	updatedSpending, err := updateFn(copySpending(spending))
+	if err != nil {
+		return err
+	}
+
+	r.store.spendings[id] = copySpending(updatedSpending)
+	return nil
+}
+
+func (r *policyRepository) AddViolation(
+	_ context.Context, violation *domain.PolicyViolation,
+) error {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.violations = append(r.store.violations, violation)
+
+	go r.publishEvent(domain.PolicyEvent{
+		EventType: domain.PolicyViolated,
+		Violation: *violation,
+	})
+
+	return nil
+}
+
+func (r *policyRepository) GetViolationsForAccount(
+	_ context.Context, accountName string,
+) ([]*domain.PolicyViolation, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	violations := make([]*domain.PolicyViolation, 0)
+	for _, violation := range r.store.violations {
+		if violation.AccountName == accountName {
+			violations = append(violations, violation)
+		}
+	}
+	return violations, nil
+}
+
+func (r *policyRepository) GetEventChannel() chan domain.PolicyEvent {
+	return r.chEvents
+}
+
+func (r *policyRepository) publishEvent(event domain.PolicyEvent) {
+	r.chEvents <- event
+}
+
+func (r *policyRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.policies = make(map[string]*domain.SpendingPolicy)
+	r.store.spendings = make(map[string]*domain.Spending)
+	r.store.violations = nil
+}
+
+func (r *policyRepository) close() {
+	close(r.chEvents)
+}
+
+func copySpending(spending *domain.Spending) *domain.Spending {
+	s := *spending
+	s.Amounts = make(map[string]uint64, len(spending.Amounts))
+	for asset, amount := range spending.Amounts {
+		s.Amounts[asset] = amount
+	}
+	s.Approvers = append([]string{}, spending.Approvers...)
+	return &s
+}
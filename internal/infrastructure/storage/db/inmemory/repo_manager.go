@@ -9,10 +9,14 @@ import (
 )
 
 type repoManager struct {
-	utxoRepository   *utxoRepository
-	walletRepository *walletRepository
-	txRepository     *txRepository
-	scriptRepository *scriptRepository
+	utxoRepository     *utxoRepository
+	walletRepository   *walletRepository
+	txRepository       *txRepository
+	scriptRepository   *scriptRepository
+	transferRepository *transferRepository
+	paymentRepository  *paymentRepository
+	swapRepository     *swapRepository
+	policyRepository   *policyRepository
 
 	walletEventHandlers *handlerMap
 	utxoEventHandlers   *handlerMap
@@ -25,12 +29,20 @@ func NewRepoManager() ports.RepoManager {
 	walletRepo := newWalletRepository()
 	txRepo := newTransactionRepository()
 	scriptRepo := newExternalScriptRepository()
+	transferRepo := newTransferRepository()
+	paymentRepo := newPaymentRepository()
+	swapRepo := newSwapRepository()
+	policyRepo := newPolicyRepository()
 
 	rm := &repoManager{
 		utxoRepository:      utxoRepo,
 		walletRepository:    walletRepo,
 		txRepository:        txRepo,
 		scriptRepository:    scriptRepo,
+		transferRepository:  transferRepo,
+		paymentRepository:   paymentRepo,
+		swapRepository:      swapRepo,
+		policyRepository:    policyRepo,
 		walletEventHandlers: newHandlerMap(),
 		utxoEventHandlers:   newHandlerMap(),
 		txEventHandlers:     newHandlerMap(),
@@ -61,6 +73,22 @@ func (rm *repoManager) ExternalScriptRepository() domain.ExternalScriptRepositor
 	return rm.scriptRepository
 }
 
+func (rm *repoManager) TransferRepository() domain.TransferRepository {
+	return rm.transferRepository
+}
+
+func (rm *repoManager) PaymentRepository() domain.PaymentRepository {
+	return rm.paymentRepository
+}
+
+func (rm *repoManager) SwapRepository() domain.SwapRepository {
+	return rm.swapRepository
+}
+
+func (rm *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return rm.policyRepository
+}
+
 func (rm *repoManager) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -90,6 +118,10 @@ func (rm *repoManager) Reset() {
 	rm.utxoRepository.reset()
 	rm.txRepository.reset()
 	rm.scriptRepository.reset()
+	rm.transferRepository.reset()
+	rm.paymentRepository.reset()
+	rm.swapRepository.reset()
+	rm.policyRepository.reset()
 }
 
 func (rm *repoManager) listenToWalletEvents() {
@@ -149,6 +181,10 @@ func (rm *repoManager) Close() {
 	rm.utxoRepository.close()
 	rm.txRepository.close()
 	rm.scriptRepository.close()
+	rm.transferRepository.close()
+	rm.paymentRepository.close()
+	rm.swapRepository.close()
+	rm.policyRepository.close()
 }
 
 // handlerMap is a util type to prevent race conditions when registering
@@ -0,0 +1,81 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type swapInmemoryStore struct {
+	swaps map[string]*domain.Swap
+	lock  *sync.RWMutex
+}
+
+type swapRepository struct {
+	store *swapInmemoryStore
+}
+
+func NewSwapRepository() domain.SwapRepository {
+	return newSwapRepository()
+}
+
+func newSwapRepository() *swapRepository {
+	return &swapRepository{
+		store: &swapInmemoryStore{
+			swaps: make(map[string]*domain.Swap),
+			lock:  &sync.RWMutex{},
+		},
+	}
+}
+
+func (r *swapRepository) AddSwap(
+	_ context.Context, swap *domain.Swap,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.swaps[swap.ID]; ok {
+		return false, nil
+	}
+
+	r.store.swaps[swap.ID] = swap
+	return true, nil
+}
+
+func (r *swapRepository) GetSwap(
+	_ context.Context, id string,
+) (*domain.Swap, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	swap, ok := r.store.swaps[id]
+	if !ok {
+		return nil, fmt.Errorf("swap not found")
+	}
+	return swap, nil
+}
+
+func (r *swapRepository) DeleteSwap(
+	_ context.Context, id string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.swaps[id]; !ok {
+		return false, nil
+	}
+
+	delete(r.store.swaps, id)
+	return true, nil
+}
+
+func (r *swapRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.swaps = make(map[string]*domain.Swap)
+}
+
+func (r *swapRepository) close() {}
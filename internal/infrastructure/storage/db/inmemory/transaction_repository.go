@@ -3,6 +3,7 @@ package inmemory
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/vulpemventures/ocean/internal/core/domain"
@@ -55,6 +56,24 @@ func (r *txRepository) ConfirmTransaction(
 	return r.confirmTx(ctx, txid, blockHash, blockheight, blocktime)
 }
 
+func (r *txRepository) UnconfirmTransaction(
+	ctx context.Context, txid string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.unconfirmTx(ctx, txid)
+}
+
+func (r *txRepository) DeleteTransaction(
+	ctx context.Context, txid string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.deleteTx(ctx, txid)
+}
+
 func (r *txRepository) GetTransaction(
 	ctx context.Context, txid string,
 ) (*domain.Transaction, error) {
@@ -64,6 +83,45 @@ func (r *txRepository) GetTransaction(
 	return r.getTx(ctx, txid)
 }
 
+func (r *txRepository) GetTransactionsForAccount(
+	_ context.Context, accountName string,
+) ([]*domain.Transaction, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	txs := make([]*domain.Transaction, 0)
+	for _, tx := range r.store.txs {
+		if _, ok := tx.Accounts[accountName]; ok {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (r *txRepository) GetTransactionsForAccountPage(
+	ctx context.Context, accountName string, offset, limit int,
+) ([]*domain.Transaction, error) {
+	txs, _ := r.GetTransactionsForAccount(ctx, accountName)
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].IsConfirmed() != txs[j].IsConfirmed() {
+			return txs[i].IsConfirmed()
+		}
+		if txs[i].BlockHeight != txs[j].BlockHeight {
+			return txs[i].BlockHeight < txs[j].BlockHeight
+		}
+		return txs[i].TxID < txs[j].TxID
+	})
+
+	if offset >= len(txs) {
+		return []*domain.Transaction{}, nil
+	}
+	txs = txs[offset:]
+	if limit < len(txs) {
+		txs = txs[:limit]
+	}
+	return txs, nil
+}
+
 func (r *txRepository) UpdateTransaction(
 	ctx context.Context, txid string,
 	updateFn func(tx *domain.Transaction) (*domain.Transaction, error),
@@ -131,6 +189,48 @@ func (r *txRepository) confirmTx(
 	return true, nil
 }
 
+func (r *txRepository) unconfirmTx(
+	ctx context.Context, txid string,
+) (bool, error) {
+	tx, err := r.getTx(ctx, txid)
+	if err != nil {
+		return false, nil
+	}
+
+	if !tx.IsConfirmed() {
+		return false, nil
+	}
+
+	tx.Unconfirm()
+
+	r.store.txs[txid] = tx
+
+	go r.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionUnconfirmed,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
+func (r *txRepository) deleteTx(
+	ctx context.Context, txid string,
+) (bool, error) {
+	tx, err := r.getTx(ctx, txid)
+	if err != nil {
+		return false, nil
+	}
+
+	delete(r.store.txs, txid)
+
+	go r.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionDeleted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
 func (r *txRepository) getTx(
 	_ context.Context, txid string,
 ) (*domain.Transaction, error) {
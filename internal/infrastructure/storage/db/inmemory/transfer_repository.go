@@ -0,0 +1,81 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vulpemventures/ocean/internal/core/domain"
+)
+
+type transferInmemoryStore struct {
+	transfers map[string]*domain.Transfer
+	lock      *sync.RWMutex
+}
+
+type transferRepository struct {
+	store *transferInmemoryStore
+}
+
+func NewTransferRepository() domain.TransferRepository {
+	return newTransferRepository()
+}
+
+func newTransferRepository() *transferRepository {
+	return &transferRepository{
+		store: &transferInmemoryStore{
+			transfers: make(map[string]*domain.Transfer),
+			lock:      &sync.RWMutex{},
+		},
+	}
+}
+
+func (r *transferRepository) AddTransfer(
+	_ context.Context, transfer *domain.Transfer,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.transfers[transfer.TxID]; ok {
+		return false, nil
+	}
+
+	r.store.transfers[transfer.TxID] = transfer
+	return true, nil
+}
+
+func (r *transferRepository) GetTransfer(
+	_ context.Context, txid string,
+) (*domain.Transfer, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	transfer, ok := r.store.transfers[txid]
+	if !ok {
+		return nil, fmt.Errorf("transfer not found")
+	}
+	return transfer, nil
+}
+
+func (r *transferRepository) DeleteTransfer(
+	_ context.Context, txid string,
+) (bool, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	if _, ok := r.store.transfers[txid]; !ok {
+		return false, nil
+	}
+
+	delete(r.store.transfers, txid)
+	return true, nil
+}
+
+func (r *transferRepository) reset() {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	r.store.transfers = make(map[string]*domain.Transfer)
+}
+
+func (r *transferRepository) close() {}
@@ -84,7 +84,7 @@ func (r *utxoRepository) GetAllUtxosForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	return r.getUtxosForAccount(account, false, false)
+	return r.getUtxosForAccount(account, false, false, false)
 }
 
 func (r *utxoRepository) GetSpendableUtxosForAccount(
@@ -93,7 +93,7 @@ func (r *utxoRepository) GetSpendableUtxosForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	return r.getUtxosForAccount(account, true, false)
+	return r.getUtxosForAccount(account, true, false, false)
 }
 
 func (r *utxoRepository) GetLockedUtxosForAccount(
@@ -102,7 +102,31 @@ func (r *utxoRepository) GetLockedUtxosForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	return r.getUtxosForAccount(account, false, true)
+	return r.getUtxosForAccount(account, false, true, false)
+}
+
+func (r *utxoRepository) GetFrozenUtxosForAccount(
+	_ context.Context, account string,
+) ([]*domain.Utxo, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	return r.getUtxosForAccount(account, false, false, true)
+}
+
+func (r *utxoRepository) GetUtxosForLease(
+	_ context.Context, leaseID string,
+) ([]*domain.Utxo, error) {
+	r.store.lock.RLock()
+	defer r.store.lock.RUnlock()
+
+	utxos := make([]*domain.Utxo, 0)
+	for _, u := range r.store.utxos {
+		if u.IsLocked() && u.LeaseID == leaseID {
+			utxos = append(utxos, u)
+		}
+	}
+	return utxos, nil
 }
 
 func (r *utxoRepository) GetBalanceForAccount(
@@ -111,7 +135,7 @@ func (r *utxoRepository) GetBalanceForAccount(
 	r.store.lock.RLock()
 	defer r.store.lock.RUnlock()
 
-	utxos, _ := r.getUtxosForAccount(account, false, false)
+	utxos, _ := r.getUtxosForAccount(account, false, false, false)
 	balance := make(map[string]*domain.Balance)
 	for _, u := range utxos {
 		if u.IsSpent() {
@@ -122,7 +146,9 @@ func (r *utxoRepository) GetBalanceForAccount(
 			balance[u.Asset] = &domain.Balance{}
 		}
 		b := balance[u.Asset]
-		if u.IsLocked() {
+		if u.IsFrozen() {
+			b.Frozen += u.Value
+		} else if u.IsLocked() {
 			b.Locked += u.Value
 		} else {
 			if u.IsConfirmed() {
@@ -163,13 +189,41 @@ func (r *utxoRepository) ConfirmUtxos(
 	return r.confirmUtxos(utxos, status)
 }
 
+func (r *utxoRepository) UnconfirmUtxos(
+	_ context.Context, utxos []domain.UtxoKey,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.unconfirmUtxos(utxos, false)
+}
+
+func (r *utxoRepository) UnconfirmSpendUtxos(
+	_ context.Context, utxos []domain.UtxoKey,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.unconfirmUtxos(utxos, true)
+}
+
+func (r *utxoRepository) UnspendUtxos(
+	_ context.Context, utxos []domain.UtxoKey,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.unspendUtxos(utxos)
+}
+
 func (r *utxoRepository) LockUtxos(
-	_ context.Context, utxos []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	_ context.Context, utxos []domain.UtxoKey,
+	leaseID string, timestamp, expiryTimestamp int64,
 ) (int, error) {
 	r.store.lock.Lock()
 	defer r.store.lock.Unlock()
 
-	return r.lockUtxos(utxos, timestamp, expiryTimestamp)
+	return r.lockUtxos(utxos, leaseID, timestamp, expiryTimestamp)
 }
 
 func (r *utxoRepository) UnlockUtxos(
@@ -181,6 +235,43 @@ func (r *utxoRepository) UnlockUtxos(
 	return r.unlockUtxos(utxos)
 }
 
+func (r *utxoRepository) ReleaseUtxos(
+	_ context.Context, utxos []domain.UtxoKey, leaseID string,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.releaseUtxos(utxos, leaseID)
+}
+
+func (r *utxoRepository) ExtendUtxosLease(
+	_ context.Context, utxos []domain.UtxoKey,
+	leaseID string, expiryTimestamp int64,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.extendUtxosLease(utxos, leaseID, expiryTimestamp)
+}
+
+func (r *utxoRepository) FreezeUtxos(
+	_ context.Context, utxos []domain.UtxoKey, reason string, timestamp int64,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.freezeUtxos(utxos, reason, timestamp)
+}
+
+func (r *utxoRepository) UnfreezeUtxos(
+	_ context.Context, utxos []domain.UtxoKey,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.unfreezeUtxos(utxos)
+}
+
 func (r *utxoRepository) DeleteUtxosForAccount(
 	_ context.Context, accountName string,
 ) error {
@@ -195,6 +286,15 @@ func (r *utxoRepository) DeleteUtxosForAccount(
 	return nil
 }
 
+func (r *utxoRepository) DeleteUtxos(
+	_ context.Context, utxos []domain.UtxoKey,
+) (int, error) {
+	r.store.lock.Lock()
+	defer r.store.lock.Unlock()
+
+	return r.deleteUtxos(utxos), nil
+}
+
 func (r *utxoRepository) GetEventChannel() chan domain.UtxoEvent {
 	return r.externalChEvents
 }
@@ -228,7 +328,7 @@ func (r *utxoRepository) getUtxos(spendableOnly bool) []*domain.Utxo {
 	utxos := make([]*domain.Utxo, 0, len(r.store.utxos))
 	for _, u := range r.store.utxos {
 		if spendableOnly {
-			if !u.IsLocked() && u.IsConfirmed() && !u.IsSpent() {
+			if !u.IsLocked() && !u.IsFrozen() && u.IsConfirmed() && !u.IsSpent() {
 				utxos = append(utxos, u)
 			}
 			continue
@@ -239,7 +339,7 @@ func (r *utxoRepository) getUtxos(spendableOnly bool) []*domain.Utxo {
 }
 
 func (r *utxoRepository) getUtxosForAccount(
-	account string, spendableOnly, lockedOnly bool,
+	account string, spendableOnly, lockedOnly, frozenOnly bool,
 ) ([]*domain.Utxo, error) {
 	keys := r.store.utxosByAccount[account]
 	if len(keys) == 0 {
@@ -251,14 +351,21 @@ func (r *utxoRepository) getUtxosForAccount(
 		u := r.store.utxos[k.Hash()]
 
 		if spendableOnly {
-			if !u.IsLocked() && u.IsConfirmed() && !u.IsSpent() {
+			if !u.IsLocked() && !u.IsFrozen() && u.IsConfirmed() && !u.IsSpent() {
 				utxos = append(utxos, u)
 			}
 			continue
 		}
 
 		if lockedOnly {
-			if u.IsLocked() {
+			if u.IsLocked() && !u.IsFrozen() {
+				utxos = append(utxos, u)
+			}
+			continue
+		}
+
+		if frozenOnly {
+			if u.IsFrozen() && !u.IsSpent() {
 				utxos = append(utxos, u)
 			}
 			continue
@@ -368,8 +475,97 @@ func (r *utxoRepository) confirmUtxos(
 	return count, nil
 }
 
+func (r *utxoRepository) unconfirmUtxos(
+	keys []domain.UtxoKey, spendOnly bool,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if spendOnly {
+			if !utxo.IsConfirmedSpent() {
+				continue
+			}
+			utxo.UnconfirmSpend()
+		} else {
+			if !utxo.IsConfirmed() {
+				continue
+			}
+			utxo.Unconfirm()
+		}
+
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnconfirmed,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) unspendUtxos(keys []domain.UtxoKey) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if !utxo.IsSpent() || utxo.IsConfirmedSpent() {
+			continue
+		}
+
+		utxo.Unspend()
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnspent,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) deleteUtxos(keys []domain.UtxoKey) int {
+	count := 0
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		delete(r.store.utxos, key.Hash())
+
+		accountKeys := r.store.utxosByAccount[utxo.AccountName]
+		for i, k := range accountKeys {
+			if k.Hash() == key.Hash() {
+				accountKeys = append(accountKeys[:i], accountKeys[i+1:]...)
+				break
+			}
+		}
+		r.store.utxosByAccount[utxo.AccountName] = accountKeys
+		count++
+	}
+
+	return count
+}
+
 func (r *utxoRepository) lockUtxos(
-	keys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	keys []domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (int, error) {
 	count := 0
 	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
@@ -379,11 +575,11 @@ func (r *utxoRepository) lockUtxos(
 			continue
 		}
 
-		if utxo.IsLocked() {
+		if utxo.IsLocked() || utxo.IsFrozen() {
 			continue
 		}
 
-		utxo.Lock(timestamp, expiryTimestamp)
+		utxo.Lock(leaseID, timestamp, expiryTimestamp)
 		utxosInfo = append(utxosInfo, utxo.Info())
 		count++
 	}
@@ -426,6 +622,122 @@ func (r *utxoRepository) unlockUtxos(keys []domain.UtxoKey) (int, error) {
 	return count, nil
 }
 
+func (r *utxoRepository) releaseUtxos(
+	keys []domain.UtxoKey, leaseID string,
+) (int, error) {
+	utxos := make([]*domain.Utxo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok || !utxo.IsLocked() {
+			continue
+		}
+		if utxo.LeaseID != leaseID {
+			return -1, domain.ErrUtxoLeaseMismatch
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		utxo.Release(leaseID)
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnlocked,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (r *utxoRepository) extendUtxosLease(
+	keys []domain.UtxoKey, leaseID string, expiryTimestamp int64,
+) (int, error) {
+	utxos := make([]*domain.Utxo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+		if !utxo.IsLocked() {
+			return -1, domain.ErrUtxoNotLocked
+		}
+		if utxo.LeaseID != leaseID {
+			return -1, domain.ErrUtxoLeaseMismatch
+		}
+		utxos = append(utxos, utxo)
+	}
+
+	for _, utxo := range utxos {
+		utxo.ExtendLease(leaseID, expiryTimestamp)
+	}
+
+	return len(utxos), nil
+}
+
+func (r *utxoRepository) freezeUtxos(
+	keys []domain.UtxoKey, reason string, timestamp int64,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if utxo.IsFrozen() {
+			continue
+		}
+
+		if err := utxo.Freeze(reason, timestamp); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoFrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (r *utxoRepository) unfreezeUtxos(keys []domain.UtxoKey) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0, len(keys))
+	for _, key := range keys {
+		utxo, ok := r.store.utxos[key.Hash()]
+		if !ok {
+			continue
+		}
+
+		if !utxo.IsFrozen() {
+			continue
+		}
+
+		utxo.Unfreeze()
+		utxosInfo = append(utxosInfo, utxo.Info())
+		count++
+	}
+
+	if count > 0 {
+		go r.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnfrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
 func (r *utxoRepository) publishEvent(event domain.UtxoEvent) {
 	r.chLock.Lock()
 	defer r.chLock.Unlock()
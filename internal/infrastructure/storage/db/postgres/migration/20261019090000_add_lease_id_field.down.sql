@@ -0,0 +1 @@
+ALTER TABLE utxo DROP COLUMN lease_id;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE utxo ADD COLUMN lease_id VARCHAR(64) NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE utxo DROP COLUMN freeze_reason;
+ALTER TABLE utxo DROP COLUMN frozen_timestamp;
\ No newline at end of file
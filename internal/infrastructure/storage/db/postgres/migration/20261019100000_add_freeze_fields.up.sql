@@ -0,0 +1,2 @@
+ALTER TABLE utxo ADD COLUMN frozen_timestamp BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE utxo ADD COLUMN freeze_reason VARCHAR NOT NULL DEFAULT '';
\ No newline at end of file
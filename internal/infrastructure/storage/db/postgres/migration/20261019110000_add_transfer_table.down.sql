@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS transfer_receiver;
+DROP TABLE IF EXISTS transfer;
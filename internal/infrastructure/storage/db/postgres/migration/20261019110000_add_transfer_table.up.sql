@@ -0,0 +1,14 @@
+CREATE TABLE transfer (
+    tx_id VARCHAR(64) NOT NULL PRIMARY KEY,
+    account_name VARCHAR(50) NOT NULL
+);
+
+CREATE TABLE transfer_receiver (
+    id SERIAL PRIMARY KEY,
+    asset VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    script bytea NOT NULL,
+    blinding_key bytea,
+    fk_tx_id VARCHAR(64) NOT NULL,
+    FOREIGN KEY (fk_tx_id) REFERENCES transfer(tx_id) ON DELETE CASCADE
+);
@@ -0,0 +1,11 @@
+CREATE TABLE payment (
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    account_name VARCHAR(50) NOT NULL,
+    asset VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    script bytea NOT NULL,
+    blinding_key bytea,
+    timestamp BIGINT NOT NULL,
+    txid VARCHAR(64) NOT NULL DEFAULT '',
+    failure_reason VARCHAR NOT NULL DEFAULT ''
+);
@@ -0,0 +1,9 @@
+CREATE TABLE swap (
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    account_name VARCHAR(50) NOT NULL,
+    give_asset VARCHAR(64) NOT NULL,
+    give_amount BIGINT NOT NULL,
+    want_asset VARCHAR(64) NOT NULL,
+    want_amount BIGINT NOT NULL,
+    fee_amount BIGINT NOT NULL
+);
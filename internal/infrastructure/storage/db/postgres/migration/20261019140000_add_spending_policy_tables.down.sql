@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS policy_violation;
+DROP TABLE IF EXISTS spending_approver;
+DROP TABLE IF EXISTS spending_amount;
+DROP TABLE IF EXISTS spending;
+DROP TABLE IF EXISTS spending_policy_approver;
+DROP TABLE IF EXISTS spending_policy_script;
+DROP TABLE IF EXISTS spending_policy_limit;
+DROP TABLE IF EXISTS spending_policy;
@@ -0,0 +1,62 @@
+CREATE TABLE spending_policy (
+    account_name VARCHAR(50) NOT NULL PRIMARY KEY,
+    max_fee_rate BIGINT NOT NULL,
+    max_fee_amount BIGINT NOT NULL,
+    required_approvals INTEGER NOT NULL
+);
+
+CREATE TABLE spending_policy_limit (
+    id SERIAL PRIMARY KEY,
+    asset VARCHAR(64) NOT NULL,
+    max_amount_per_tx BIGINT NOT NULL,
+    max_amount_per_day BIGINT NOT NULL,
+    approval_threshold BIGINT NOT NULL,
+    fk_account_name VARCHAR(50) NOT NULL,
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE spending_policy_script (
+    id SERIAL PRIMARY KEY,
+    script VARCHAR(1000) NOT NULL,
+    allowed BOOLEAN NOT NULL,
+    fk_account_name VARCHAR(50) NOT NULL,
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE spending_policy_approver (
+    id SERIAL PRIMARY KEY,
+    pubkey VARCHAR(66) NOT NULL,
+    fk_account_name VARCHAR(50) NOT NULL,
+    FOREIGN KEY (fk_account_name) REFERENCES spending_policy(account_name) ON DELETE CASCADE
+);
+
+CREATE TABLE spending (
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    account_name VARCHAR(50) NOT NULL,
+    digest VARCHAR(64) NOT NULL,
+    created_at BIGINT NOT NULL,
+    signed_at BIGINT NOT NULL
+);
+
+CREATE TABLE spending_amount (
+    id SERIAL PRIMARY KEY,
+    asset VARCHAR(64) NOT NULL,
+    amount BIGINT NOT NULL,
+    fk_spending_id VARCHAR(64) NOT NULL,
+    FOREIGN KEY (fk_spending_id) REFERENCES spending(id) ON DELETE CASCADE
+);
+
+CREATE TABLE spending_approver (
+    id SERIAL PRIMARY KEY,
+    approver VARCHAR(100) NOT NULL,
+    fk_spending_id VARCHAR(64) NOT NULL,
+    FOREIGN KEY (fk_spending_id) REFERENCES spending(id) ON DELETE CASCADE
+);
+
+CREATE TABLE policy_violation (
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    account_name VARCHAR(50) NOT NULL,
+    rule VARCHAR(50) NOT NULL,
+    reason VARCHAR(1000) NOT NULL,
+    timestamp BIGINT NOT NULL
+);
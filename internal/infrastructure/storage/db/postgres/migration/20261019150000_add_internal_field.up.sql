@@ -0,0 +1 @@
+ALTER TABLE transaction ADD COLUMN internal BOOLEAN NOT NULL DEFAULT FALSE;
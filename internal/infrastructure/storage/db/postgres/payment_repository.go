@@ -0,0 +1,218 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type paymentRepositoryPg struct {
+	pgxPool  *pgxpool.Pool
+	querier  *queries.Queries
+	chEvents chan domain.PaymentEvent
+}
+
+func NewPaymentRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.PaymentRepository {
+	return newPaymentRepositoryPgImpl(pgxPool)
+}
+
+func newPaymentRepositoryPgImpl(pgxPool *pgxpool.Pool) *paymentRepositoryPg {
+	return &paymentRepositoryPg{
+		pgxPool:  pgxPool,
+		querier:  queries.New(pgxPool),
+		chEvents: make(chan domain.PaymentEvent),
+	}
+}
+
+func (r *paymentRepositoryPg) AddPayment(
+	ctx context.Context, payment *domain.Payment,
+) (bool, error) {
+	if err := r.querier.InsertPayment(ctx, queries.InsertPaymentParams{
+		ID:          payment.ID,
+		AccountName: payment.AccountName,
+		Asset:       payment.Asset,
+		Amount:      int64(payment.Amount),
+		Script:      payment.Script,
+		BlindingKey: payment.BlindingKey,
+		Timestamp:   payment.Timestamp,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok &&
+			pqErr.Code == uniqueViolation {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *paymentRepositoryPg) GetQueuedPayments(
+	ctx context.Context,
+) ([]*domain.Payment, error) {
+	rows, err := r.querier.GetQueuedPayments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*domain.Payment, 0, len(rows))
+	for _, row := range rows {
+		payment := toPayment(row)
+		payments = append(payments, &payment)
+	}
+	return payments, nil
+}
+
+func (r *paymentRepositoryPg) DeletePayment(
+	ctx context.Context, id string,
+) (bool, error) {
+	if _, err := r.querier.DeletePayment(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *paymentRepositoryPg) MarkPaymentsSending(
+	ctx context.Context, ids []string, txid string,
+) (int, error) {
+	return r.updatePayments(ctx, ids, func(
+		querier *queries.Queries, id string,
+	) (int64, error) {
+		return querier.UpdatePaymentTxID(ctx, queries.UpdatePaymentTxIDParams{
+			Txid: txid,
+			ID:   id,
+		})
+	})
+}
+
+func (r *paymentRepositoryPg) MarkPaymentsFailed(
+	ctx context.Context, ids []string, reason string,
+) (int, error) {
+	return r.updatePayments(ctx, ids, func(
+		querier *queries.Queries, id string,
+	) (int64, error) {
+		return querier.UpdatePaymentFailureReason(
+			ctx, queries.UpdatePaymentFailureReasonParams{
+				FailureReason: reason,
+				ID:            id,
+			},
+		)
+	})
+}
+
+func (r *paymentRepositoryPg) FlushPayments(
+	ctx context.Context, ids []string, txid string,
+) (int, error) {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	payments := make([]domain.Payment, 0, len(ids))
+	for _, id := range ids {
+		row, err := querierWithTx.DeletePayment(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return -1, err
+		}
+		payments = append(payments, toPayment(row))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return -1, err
+	}
+
+	if len(payments) > 0 {
+		go r.publishEvent(domain.PaymentEvent{
+			EventType: domain.PaymentsFlushed,
+			TxID:      txid,
+			Payments:  payments,
+		})
+	}
+
+	return len(payments), nil
+}
+
+func (r *paymentRepositoryPg) updatePayments(
+	ctx context.Context, ids []string,
+	update func(querier *queries.Queries, id string) (int64, error),
+) (int, error) {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	count := 0
+	for _, id := range ids {
+		rows, err := update(querierWithTx, id)
+		if err != nil {
+			return -1, err
+		}
+		count += int(rows)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return -1, err
+	}
+	return count, nil
+}
+
+func (r *paymentRepositoryPg) GetEventChannel() chan domain.PaymentEvent {
+	return r.chEvents
+}
+
+func (r *paymentRepositoryPg) publishEvent(event domain.PaymentEvent) {
+	r.chEvents <- event
+}
+
+func (r *paymentRepositoryPg) close() {
+	close(r.chEvents)
+}
+
+func (r *paymentRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetPayments(ctx)
+}
+
+func toPayment(row queries.Payment) domain.Payment {
+	return domain.Payment{
+		ID:            row.ID,
+		AccountName:   row.AccountName,
+		Asset:         row.Asset,
+		Amount:        uint64(row.Amount),
+		Script:        row.Script,
+		BlindingKey:   row.BlindingKey,
+		Timestamp:     row.Timestamp,
+		TxID:          row.Txid,
+		FailureReason: row.FailureReason,
+	}
+}
@@ -0,0 +1,442 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+type policyRepositoryPg struct {
+	pgxPool  *pgxpool.Pool
+	querier  *queries.Queries
+	chEvents chan domain.PolicyEvent
+}
+
+func NewSpendingPolicyRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.SpendingPolicyRepository {
+	return newPolicyRepositoryPgImpl(pgxPool)
+}
+
+func newPolicyRepositoryPgImpl(pgxPool *pgxpool.Pool) *policyRepositoryPg {
+	return &policyRepositoryPg{
+		pgxPool:  pgxPool,
+		querier:  queries.New(pgxPool),
+		chEvents: make(chan domain.PolicyEvent),
+	}
+}
+
+func (r *policyRepositoryPg) SetPolicy(
+	ctx context.Context, policy *domain.SpendingPolicy,
+) error {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	if err := querierWithTx.UpsertSpendingPolicy(
+		ctx, queries.UpsertSpendingPolicyParams{
+			AccountName:       policy.AccountName,
+			MaxFeeRate:        int64(policy.MaxFeeRate),
+			MaxFeeAmount:      int64(policy.MaxFeeAmount),
+			RequiredApprovals: int32(policy.RequiredApprovals),
+		},
+	); err != nil {
+		return err
+	}
+	if err := querierWithTx.DeleteSpendingPolicyLimits(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	if err := querierWithTx.DeleteSpendingPolicyScripts(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+	if err := querierWithTx.DeleteSpendingPolicyApprovers(
+		ctx, policy.AccountName,
+	); err != nil {
+		return err
+	}
+
+	for _, limit := range policy.AssetLimits {
+		if err := querierWithTx.InsertSpendingPolicyLimit(
+			ctx, queries.InsertSpendingPolicyLimitParams{
+				Asset:             limit.Asset,
+				MaxAmountPerTx:    int64(limit.MaxAmountPerTx),
+				MaxAmountPerDay:   int64(limit.MaxAmountPerDay),
+				ApprovalThreshold: int64(limit.ApprovalThreshold),
+				FkAccountName:     policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	for _, script := range policy.AllowedScripts {
+		if err := querierWithTx.InsertSpendingPolicyScript(
+			ctx, queries.InsertSpendingPolicyScriptParams{
+				Script:        script,
+				Allowed:       true,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	for _, script := range policy.DeniedScripts {
+		if err := querierWithTx.InsertSpendingPolicyScript(
+			ctx, queries.InsertSpendingPolicyScriptParams{
+				Script:        script,
+				Allowed:       false,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	for _, approver := range policy.Approvers {
+		if err := querierWithTx.InsertSpendingPolicyApprover(
+			ctx, queries.InsertSpendingPolicyApproverParams{
+				Pubkey:        approver,
+				FkAccountName: policy.AccountName,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *policyRepositoryPg) GetPolicy(
+	ctx context.Context, accountName string,
+) (*domain.SpendingPolicy, error) {
+	row, err := r.querier.GetSpendingPolicy(ctx, accountName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrSpendingPolicyNotFound
+		}
+		return nil, err
+	}
+	limits, err := r.querier.GetSpendingPolicyLimits(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	scripts, err := r.querier.GetSpendingPolicyScripts(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	approvers, err := r.querier.GetSpendingPolicyApprovers(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &domain.SpendingPolicy{
+		AccountName:       row.AccountName,
+		MaxFeeRate:        uint64(row.MaxFeeRate),
+		MaxFeeAmount:      uint64(row.MaxFeeAmount),
+		RequiredApprovals: uint32(row.RequiredApprovals),
+	}
+	for _, limit := range limits {
+		policy.AssetLimits = append(policy.AssetLimits, domain.AssetSpendingLimit{
+			Asset:             limit.Asset,
+			MaxAmountPerTx:    uint64(limit.MaxAmountPerTx),
+			MaxAmountPerDay:   uint64(limit.MaxAmountPerDay),
+			ApprovalThreshold: uint64(limit.ApprovalThreshold),
+		})
+	}
+	for _, script := range scripts {
+		if script.Allowed {
+			policy.AllowedScripts = append(policy.AllowedScripts, script.Script)
+			continue
+		}
+		policy.DeniedScripts = append(policy.DeniedScripts, script.Script)
+	}
+	for _, approver := range approvers {
+		policy.Approvers = append(policy.Approvers, approver.Pubkey)
+	}
+	return policy, nil
+}
+
+func (r *policyRepositoryPg) DeletePolicy(
+	ctx context.Context, accountName string,
+) (bool, error) {
+	if _, err := r.querier.DeleteSpendingPolicy(ctx, accountName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepositoryPg) AddSpending(
+	ctx context.Context, spending *domain.Spending,
+) (bool, error) {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	if err := querierWithTx.InsertSpending(ctx, queries.InsertSpendingParams{
+		ID:          spending.ID,
+		AccountName: spending.AccountName,
+		Digest:      spending.Digest,
+		CreatedAt:   spending.CreatedAt,
+		SignedAt:    spending.SignedAt,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok &&
+			pqErr.Code == uniqueViolation {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := insertSpendingDetails(ctx, querierWithTx, spending); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *policyRepositoryPg) GetSpending(
+	ctx context.Context, id string,
+) (*domain.Spending, error) {
+	row, err := r.querier.GetSpending(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrSpendingNotFound
+		}
+		return nil, err
+	}
+	return toSpending(ctx, r.querier, row)
+}
+
+func (r *policyRepositoryPg) GetSpendingsForAccount(
+	ctx context.Context, accountName string, since int64,
+) ([]*domain.Spending, error) {
+	rows, err := r.querier.GetSpendingsForAccount(
+		ctx, queries.GetSpendingsForAccountParams{
+			AccountName: accountName,
+			CreatedAt:   since,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	spendings := make([]*domain.Spending, 0, len(rows))
+	for _, row := range rows {
+		spending, err := toSpending(ctx, r.querier, row)
+		if err != nil {
+			return nil, err
+		}
+		spendings = append(spendings, spending)
+	}
+	return spendings, nil
+}
+
+func (r *policyRepositoryPg) UpdateSpending(
+	ctx context.Context, id string,
+	updateFn func(s *domain.Spending) (*domain.Spending, error),
+) error {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	row, err := querierWithTx.GetSpendingForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrSpendingNotFound
+		}
+		return err
+	}
+	spending, err := toSpending(ctx, querierWithTx, row)
+	if err != nil {
+		return err
+	}
+
+	updatedSpending, err := updateFn(spending)
+	if err != nil {
+		return err
+	}
+
+	if err := querierWithTx.UpdateSpending(ctx, queries.UpdateSpendingParams{
+		AccountName: updatedSpending.AccountName,
+		Digest:      updatedSpending.Digest,
+		CreatedAt:   updatedSpending.CreatedAt,
+		SignedAt:    updatedSpending.SignedAt,
+		ID:          id,
+	}); err != nil {
+		return err
+	}
+	if err := querierWithTx.DeleteSpendingAmounts(ctx, id); err != nil {
+		return err
+	}
+	if err := querierWithTx.DeleteSpendingApprovers(ctx, id); err != nil {
+		return err
+	}
+	if err := insertSpendingDetails(
+		ctx, querierWithTx, updatedSpending,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *policyRepositoryPg) AddViolation(
+	ctx context.Context, violation *domain.PolicyViolation,
+) error {
+	if err := r.querier.InsertPolicyViolation(
+		ctx, queries.InsertPolicyViolationParams{
+			ID:          violation.ID,
+			AccountName: violation.AccountName,
+			Rule:        string(violation.Rule),
+			Reason:      violation.Reason,
+			Timestamp:   violation.Timestamp,
+		},
+	); err != nil {
+		return err
+	}
+
+	go r.publishEvent(domain.PolicyEvent{
+		EventType: domain.PolicyViolated,
+		Violation: *violation,
+	})
+
+	return nil
+}
+
+func (r *policyRepositoryPg) GetViolationsForAccount(
+	ctx context.Context, accountName string,
+) ([]*domain.PolicyViolation, error) {
+	rows, err := r.querier.GetPolicyViolationsForAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]*domain.PolicyViolation, 0, len(rows))
+	for _, row := range rows {
+		violations = append(violations, &domain.PolicyViolation{
+			ID:          row.ID,
+			AccountName: row.AccountName,
+			Rule:        domain.PolicyRule(row.Rule),
+			Reason:      row.Reason,
+			Timestamp:   row.Timestamp,
+		})
+	}
+	return violations, nil
+}
+
+func (r *policyRepositoryPg) GetEventChannel() chan domain.PolicyEvent {
+	return r.chEvents
+}
+
+func (r *policyRepositoryPg) publishEvent(event domain.PolicyEvent) {
+	r.chEvents <- event
+}
+
+func (r *policyRepositoryPg) close() {
+	close(r.chEvents)
+}
+
+func (r *policyRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetSpendingPolicies(ctx)
+	querier.ResetSpendings(ctx)
+	querier.ResetPolicyViolations(ctx)
+}
+
+func insertSpendingDetails(
+	ctx context.Context, querier *queries.Queries, spending *domain.Spending,
+) error {
+	for asset, amount := range spending.Amounts {
+		if err := querier.InsertSpendingAmount(
+			ctx, queries.InsertSpendingAmountParams{
+				Asset:        asset,
+				Amount:       int64(amount),
+				FkSpendingID: spending.ID,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	for _, approver := range spending.Approvers {
+		if err := querier.InsertSpendingApprover(
+			ctx, queries.InsertSpendingApproverParams{
+				Approver:     approver,
+				FkSpendingID: spending.ID,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toSpending(
+	ctx context.Context, querier *queries.Queries, row queries.Spending,
+) (*domain.Spending, error) {
+	amounts, err := querier.GetSpendingAmounts(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	approvers, err := querier.GetSpendingApprovers(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	spending := &domain.Spending{
+		ID:          row.ID,
+		AccountName: row.AccountName,
+		Digest:      row.Digest,
+		Amounts:     make(map[string]uint64, len(amounts)),
+		CreatedAt:   row.CreatedAt,
+		SignedAt:    row.SignedAt,
+	}
+	for _, amount := range amounts {
+		spending.Amounts[amount.Asset] = uint64(amount.Amount)
+	}
+	for _, approver := range approvers {
+		spending.Approvers = append(spending.Approvers, approver.Approver)
+	}
+	return spending, nil
+}
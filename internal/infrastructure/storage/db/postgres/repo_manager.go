@@ -25,10 +25,14 @@ const (
 type repoManager struct {
 	pgxPool *pgxpool.Pool
 
-	utxoRepository   *utxoRepositoryPg
-	walletRepository *walletRepositoryPg
-	txRepository     *txRepositoryPg
-	scriptRepository *scriptRepositoryPg
+	utxoRepository     *utxoRepositoryPg
+	walletRepository   *walletRepositoryPg
+	txRepository       *txRepositoryPg
+	scriptRepository   *scriptRepositoryPg
+	transferRepository *transferRepositoryPg
+	paymentRepository  *paymentRepositoryPg
+	swapRepository     *swapRepositoryPg
+	policyRepository   *policyRepositoryPg
 
 	walletEventHandlers *handlerMap
 	utxoEventHandlers   *handlerMap
@@ -52,6 +56,10 @@ func NewRepoManager(dbConfig DbConfig) (ports.RepoManager, error) {
 	walletRepository := newWalletRepositoryPgImpl(pgxPool)
 	txRepository := newTxRepositoryPgImpl(pgxPool)
 	scriptRepository := newExternalScriptRepositoryPgImpl(pgxPool)
+	transferRepository := newTransferRepositoryPgImpl(pgxPool)
+	paymentRepository := newPaymentRepositoryPgImpl(pgxPool)
+	swapRepository := newSwapRepositoryPgImpl(pgxPool)
+	policyRepository := newPolicyRepositoryPgImpl(pgxPool)
 
 	rm := &repoManager{
 		pgxPool:             pgxPool,
@@ -59,6 +67,10 @@ func NewRepoManager(dbConfig DbConfig) (ports.RepoManager, error) {
 		walletRepository:    walletRepository,
 		txRepository:        txRepository,
 		scriptRepository:    scriptRepository,
+		transferRepository:  transferRepository,
+		paymentRepository:   paymentRepository,
+		swapRepository:      swapRepository,
+		policyRepository:    policyRepository,
 		walletEventHandlers: newHandlerMap(),
 		utxoEventHandlers:   newHandlerMap(),
 		txEventHandlers:     newHandlerMap(),
@@ -98,6 +110,22 @@ func (rm *repoManager) ExternalScriptRepository() domain.ExternalScriptRepositor
 	return rm.scriptRepository
 }
 
+func (rm *repoManager) TransferRepository() domain.TransferRepository {
+	return rm.transferRepository
+}
+
+func (rm *repoManager) PaymentRepository() domain.PaymentRepository {
+	return rm.paymentRepository
+}
+
+func (rm *repoManager) SwapRepository() domain.SwapRepository {
+	return rm.swapRepository
+}
+
+func (rm *repoManager) SpendingPolicyRepository() domain.SpendingPolicyRepository {
+	return rm.policyRepository
+}
+
 func (rm *repoManager) RegisterHandlerForWalletEvent(
 	eventType domain.WalletEventType, handler ports.WalletEventHandler,
 ) {
@@ -194,6 +222,10 @@ func (rm *repoManager) Reset() {
 	rm.utxoRepository.reset(querier, ctx)
 	rm.txRepository.reset(querier, ctx)
 	rm.scriptRepository.reset(querier, ctx)
+	rm.transferRepository.reset(querier, ctx)
+	rm.paymentRepository.reset(querier, ctx)
+	rm.swapRepository.reset(querier, ctx)
+	rm.policyRepository.reset(querier, ctx)
 
 	tx.Commit(ctx)
 }
@@ -203,6 +235,10 @@ func (rm *repoManager) Close() {
 	rm.txRepository.close()
 	rm.walletRepository.close()
 	rm.scriptRepository.close()
+	rm.transferRepository.close()
+	rm.paymentRepository.close()
+	rm.swapRepository.close()
+	rm.policyRepository.close()
 
 	rm.pgxPool.Close()
 }
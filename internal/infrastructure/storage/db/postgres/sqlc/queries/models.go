@@ -32,12 +32,107 @@ type ExternalScript struct {
 	BlindingKey []byte
 }
 
+type Payment struct {
+	ID            string
+	AccountName   string
+	Asset         string
+	Amount        int64
+	Script        []byte
+	BlindingKey   []byte
+	Timestamp     int64
+	Txid          string
+	FailureReason string
+}
+
+type PolicyViolation struct {
+	ID          string
+	AccountName string
+	Rule        string
+	Reason      string
+	Timestamp   int64
+}
+
+type Spending struct {
+	ID          string
+	AccountName string
+	Digest      string
+	CreatedAt   int64
+	SignedAt    int64
+}
+
+type SpendingAmount struct {
+	ID           int32
+	Asset        string
+	Amount       int64
+	FkSpendingID string
+}
+
+type SpendingApprover struct {
+	ID           int32
+	Approver     string
+	FkSpendingID string
+}
+
+type SpendingPolicy struct {
+	AccountName       string
+	MaxFeeRate        int64
+	MaxFeeAmount      int64
+	RequiredApprovals int32
+}
+
+type SpendingPolicyApprover struct {
+	ID            int32
+	Pubkey        string
+	FkAccountName string
+}
+
+type SpendingPolicyLimit struct {
+	ID                int32
+	Asset             string
+	MaxAmountPerTx    int64
+	MaxAmountPerDay   int64
+	ApprovalThreshold int64
+	FkAccountName     string
+}
+
+type SpendingPolicyScript struct {
+	ID            int32
+	Script        string
+	Allowed       bool
+	FkAccountName string
+}
+
+type Swap struct {
+	ID          string
+	AccountName string
+	GiveAsset   string
+	GiveAmount  int64
+	WantAsset   string
+	WantAmount  int64
+	FeeAmount   int64
+}
+
 type Transaction struct {
 	TxID        string
 	TxHex       string
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	Internal    bool
+}
+
+type Transfer struct {
+	TxID        string
+	AccountName string
+}
+
+type TransferReceiver struct {
+	ID          int32
+	Asset       string
+	Amount      int64
+	Script      []byte
+	BlindingKey []byte
+	FkTxID      string
 }
 
 type TxInputAccount struct {
@@ -63,6 +158,9 @@ type Utxo struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 }
 
 type UtxoStatus struct {
@@ -28,6 +28,27 @@ func (q *Queries) DeleteAccountScripts(ctx context.Context, fkAccountName string
 	return err
 }
 
+const deletePayment = `-- name: DeletePayment :one
+DELETE FROM payment WHERE id=$1 RETURNING id, account_name, asset, amount, script, blinding_key, timestamp, txid, failure_reason
+`
+
+func (q *Queries) DeletePayment(ctx context.Context, id string) (Payment, error) {
+	row := q.db.QueryRow(ctx, deletePayment, id)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.AccountName,
+		&i.Asset,
+		&i.Amount,
+		&i.Script,
+		&i.BlindingKey,
+		&i.Timestamp,
+		&i.Txid,
+		&i.FailureReason,
+	)
+	return i, err
+}
+
 const deleteScript = `-- name: DeleteScript :exec
 DELETE FROM external_script WHERE account = $1
 `
@@ -37,6 +58,98 @@ func (q *Queries) DeleteScript(ctx context.Context, account string) error {
 	return err
 }
 
+const deleteSpendingAmounts = `-- name: DeleteSpendingAmounts :exec
+DELETE FROM spending_amount WHERE fk_spending_id=$1
+`
+
+func (q *Queries) DeleteSpendingAmounts(ctx context.Context, fkSpendingID string) error {
+	_, err := q.db.Exec(ctx, deleteSpendingAmounts, fkSpendingID)
+	return err
+}
+
+const deleteSpendingApprovers = `-- name: DeleteSpendingApprovers :exec
+DELETE FROM spending_approver WHERE fk_spending_id=$1
+`
+
+func (q *Queries) DeleteSpendingApprovers(ctx context.Context, fkSpendingID string) error {
+	_, err := q.db.Exec(ctx, deleteSpendingApprovers, fkSpendingID)
+	return err
+}
+
+const deleteSpendingPolicy = `-- name: DeleteSpendingPolicy :one
+DELETE FROM spending_policy WHERE account_name=$1 RETURNING account_name, max_fee_rate, max_fee_amount, required_approvals
+`
+
+func (q *Queries) DeleteSpendingPolicy(ctx context.Context, accountName string) (SpendingPolicy, error) {
+	row := q.db.QueryRow(ctx, deleteSpendingPolicy, accountName)
+	var i SpendingPolicy
+	err := row.Scan(
+		&i.AccountName,
+		&i.MaxFeeRate,
+		&i.MaxFeeAmount,
+		&i.RequiredApprovals,
+	)
+	return i, err
+}
+
+const deleteSpendingPolicyApprovers = `-- name: DeleteSpendingPolicyApprovers :exec
+DELETE FROM spending_policy_approver WHERE fk_account_name=$1
+`
+
+func (q *Queries) DeleteSpendingPolicyApprovers(ctx context.Context, fkAccountName string) error {
+	_, err := q.db.Exec(ctx, deleteSpendingPolicyApprovers, fkAccountName)
+	return err
+}
+
+const deleteSpendingPolicyLimits = `-- name: DeleteSpendingPolicyLimits :exec
+DELETE FROM spending_policy_limit WHERE fk_account_name=$1
+`
+
+func (q *Queries) DeleteSpendingPolicyLimits(ctx context.Context, fkAccountName string) error {
+	_, err := q.db.Exec(ctx, deleteSpendingPolicyLimits, fkAccountName)
+	return err
+}
+
+const deleteSpendingPolicyScripts = `-- name: DeleteSpendingPolicyScripts :exec
+DELETE FROM spending_policy_script WHERE fk_account_name=$1
+`
+
+func (q *Queries) DeleteSpendingPolicyScripts(ctx context.Context, fkAccountName string) error {
+	_, err := q.db.Exec(ctx, deleteSpendingPolicyScripts, fkAccountName)
+	return err
+}
+
+const deleteSwap = `-- name: DeleteSwap :one
+DELETE FROM swap WHERE id=$1 RETURNING id, account_name, give_asset, give_amount, want_asset, want_amount, fee_amount
+`
+
+func (q *Queries) DeleteSwap(ctx context.Context, id string) (Swap, error) {
+	row := q.db.QueryRow(ctx, deleteSwap, id)
+	var i Swap
+	err := row.Scan(
+		&i.ID,
+		&i.AccountName,
+		&i.GiveAsset,
+		&i.GiveAmount,
+		&i.WantAsset,
+		&i.WantAmount,
+		&i.FeeAmount,
+	)
+	return i, err
+}
+
+const deleteTransaction = `-- name: DeleteTransaction :execrows
+DELETE FROM transaction WHERE tx_id=$1
+`
+
+func (q *Queries) DeleteTransaction(ctx context.Context, txID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTransaction, txID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteTransactionInputAccounts = `-- name: DeleteTransactionInputAccounts :exec
 DELETE FROM tx_input_account WHERE fk_tx_id=$1
 `
@@ -46,6 +159,24 @@ func (q *Queries) DeleteTransactionInputAccounts(ctx context.Context, fkTxID str
 	return err
 }
 
+const deleteTransfer = `-- name: DeleteTransfer :exec
+DELETE FROM transfer WHERE tx_id=$1
+`
+
+func (q *Queries) DeleteTransfer(ctx context.Context, txID string) error {
+	_, err := q.db.Exec(ctx, deleteTransfer, txID)
+	return err
+}
+
+const deleteUtxo = `-- name: DeleteUtxo :exec
+DELETE FROM utxo WHERE id=$1
+`
+
+func (q *Queries) DeleteUtxo(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteUtxo, id)
+	return err
+}
+
 const deleteUtxoStatuses = `-- name: DeleteUtxoStatuses :exec
 DELETE FROM utxo_status WHERE fk_utxo_id = $1
 `
@@ -110,7 +241,7 @@ func (q *Queries) GetAllScripts(ctx context.Context) ([]ExternalScript, error) {
 }
 
 const getAllUtxos = `-- name: GetAllUtxos :many
-SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 `
 
 type GetAllUtxosRow struct {
@@ -130,6 +261,9 @@ type GetAllUtxosRow struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 	ID_2                sql.NullInt32
 	BlockHeight         sql.NullInt32
 	BlockTime           sql.NullInt64
@@ -165,6 +299,9 @@ func (q *Queries) GetAllUtxos(ctx context.Context) ([]GetAllUtxosRow, error) {
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.LeaseID,
+			&i.FrozenTimestamp,
+			&i.FreezeReason,
 			&i.ID_2,
 			&i.BlockHeight,
 			&i.BlockTime,
@@ -183,6 +320,70 @@ func (q *Queries) GetAllUtxos(ctx context.Context) ([]GetAllUtxosRow, error) {
 	return items, nil
 }
 
+const getPolicyViolationsForAccount = `-- name: GetPolicyViolationsForAccount :many
+SELECT id, account_name, rule, reason, timestamp FROM policy_violation WHERE account_name=$1 ORDER BY timestamp
+`
+
+func (q *Queries) GetPolicyViolationsForAccount(ctx context.Context, accountName string) ([]PolicyViolation, error) {
+	rows, err := q.db.Query(ctx, getPolicyViolationsForAccount, accountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PolicyViolation
+	for rows.Next() {
+		var i PolicyViolation
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountName,
+			&i.Rule,
+			&i.Reason,
+			&i.Timestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQueuedPayments = `-- name: GetQueuedPayments :many
+SELECT id, account_name, asset, amount, script, blinding_key, timestamp, txid, failure_reason FROM payment ORDER BY timestamp
+`
+
+func (q *Queries) GetQueuedPayments(ctx context.Context) ([]Payment, error) {
+	rows, err := q.db.Query(ctx, getQueuedPayments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountName,
+			&i.Asset,
+			&i.Amount,
+			&i.Script,
+			&i.BlindingKey,
+			&i.Timestamp,
+			&i.Txid,
+			&i.FailureReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getScript = `-- name: GetScript :one
 SELECT account, script, blinding_key FROM external_script WHERE account = $1
 `
@@ -194,8 +395,249 @@ func (q *Queries) GetScript(ctx context.Context, account string) (ExternalScript
 	return i, err
 }
 
+const getSpending = `-- name: GetSpending :one
+SELECT id, account_name, digest, created_at, signed_at FROM spending WHERE id=$1
+`
+
+func (q *Queries) GetSpending(ctx context.Context, id string) (Spending, error) {
+	row := q.db.QueryRow(ctx, getSpending, id)
+	var i Spending
+	err := row.Scan(
+		&i.ID,
+		&i.AccountName,
+		&i.Digest,
+		&i.CreatedAt,
+		&i.SignedAt,
+	)
+	return i, err
+}
+
+const getSpendingAmounts = `-- name: GetSpendingAmounts :many
+SELECT id, asset, amount, fk_spending_id FROM spending_amount WHERE fk_spending_id=$1 ORDER BY id
+`
+
+func (q *Queries) GetSpendingAmounts(ctx context.Context, fkSpendingID string) ([]SpendingAmount, error) {
+	rows, err := q.db.Query(ctx, getSpendingAmounts, fkSpendingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendingAmount
+	for rows.Next() {
+		var i SpendingAmount
+		if err := rows.Scan(
+			&i.ID,
+			&i.Asset,
+			&i.Amount,
+			&i.FkSpendingID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingApprovers = `-- name: GetSpendingApprovers :many
+SELECT id, approver, fk_spending_id FROM spending_approver WHERE fk_spending_id=$1 ORDER BY id
+`
+
+func (q *Queries) GetSpendingApprovers(ctx context.Context, fkSpendingID string) ([]SpendingApprover, error) {
+	rows, err := q.db.Query(ctx, getSpendingApprovers, fkSpendingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendingApprover
+	for rows.Next() {
+		var i SpendingApprover
+		if err := rows.Scan(&i.ID, &i.Approver, &i.FkSpendingID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingForUpdate = `-- name: GetSpendingForUpdate :one
+SELECT id, account_name, digest, created_at, signed_at FROM spending WHERE id=$1 FOR UPDATE
+`
+
+func (q *Queries) GetSpendingForUpdate(ctx context.Context, id string) (Spending, error) {
+	row := q.db.QueryRow(ctx, getSpendingForUpdate, id)
+	var i Spending
+	err := row.Scan(
+		&i.ID,
+		&i.AccountName,
+		&i.Digest,
+		&i.CreatedAt,
+		&i.SignedAt,
+	)
+	return i, err
+}
+
+const getSpendingPolicy = `-- name: GetSpendingPolicy :one
+SELECT account_name, max_fee_rate, max_fee_amount, required_approvals FROM spending_policy WHERE account_name=$1
+`
+
+func (q *Queries) GetSpendingPolicy(ctx context.Context, accountName string) (SpendingPolicy, error) {
+	row := q.db.QueryRow(ctx, getSpendingPolicy, accountName)
+	var i SpendingPolicy
+	err := row.Scan(
+		&i.AccountName,
+		&i.MaxFeeRate,
+		&i.MaxFeeAmount,
+		&i.RequiredApprovals,
+	)
+	return i, err
+}
+
+const getSpendingPolicyApprovers = `-- name: GetSpendingPolicyApprovers :many
+SELECT id, pubkey, fk_account_name FROM spending_policy_approver WHERE fk_account_name=$1 ORDER BY id
+`
+
+func (q *Queries) GetSpendingPolicyApprovers(ctx context.Context, fkAccountName string) ([]SpendingPolicyApprover, error) {
+	rows, err := q.db.Query(ctx, getSpendingPolicyApprovers, fkAccountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendingPolicyApprover
+	for rows.Next() {
+		var i SpendingPolicyApprover
+		if err := rows.Scan(&i.ID, &i.Pubkey, &i.FkAccountName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingPolicyLimits = `-- name: GetSpendingPolicyLimits :many
+SELECT id, asset, max_amount_per_tx, max_amount_per_day, approval_threshold, fk_account_name FROM spending_policy_limit WHERE fk_account_name=$1 ORDER BY id
+`
+
+func (q *Queries) GetSpendingPolicyLimits(ctx context.Context, fkAccountName string) ([]SpendingPolicyLimit, error) {
+	rows, err := q.db.Query(ctx, getSpendingPolicyLimits, fkAccountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendingPolicyLimit
+	for rows.Next() {
+		var i SpendingPolicyLimit
+		if err := rows.Scan(
+			&i.ID,
+			&i.Asset,
+			&i.MaxAmountPerTx,
+			&i.MaxAmountPerDay,
+			&i.ApprovalThreshold,
+			&i.FkAccountName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingPolicyScripts = `-- name: GetSpendingPolicyScripts :many
+SELECT id, script, allowed, fk_account_name FROM spending_policy_script WHERE fk_account_name=$1 ORDER BY id
+`
+
+func (q *Queries) GetSpendingPolicyScripts(ctx context.Context, fkAccountName string) ([]SpendingPolicyScript, error) {
+	rows, err := q.db.Query(ctx, getSpendingPolicyScripts, fkAccountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpendingPolicyScript
+	for rows.Next() {
+		var i SpendingPolicyScript
+		if err := rows.Scan(
+			&i.ID,
+			&i.Script,
+			&i.Allowed,
+			&i.FkAccountName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSpendingsForAccount = `-- name: GetSpendingsForAccount :many
+SELECT id, account_name, digest, created_at, signed_at FROM spending WHERE account_name=$1 AND created_at>=$2 ORDER BY created_at
+`
+
+type GetSpendingsForAccountParams struct {
+	AccountName string
+	CreatedAt   int64
+}
+
+func (q *Queries) GetSpendingsForAccount(ctx context.Context, arg GetSpendingsForAccountParams) ([]Spending, error) {
+	rows, err := q.db.Query(ctx, getSpendingsForAccount, arg.AccountName, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Spending
+	for rows.Next() {
+		var i Spending
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountName,
+			&i.Digest,
+			&i.CreatedAt,
+			&i.SignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSwap = `-- name: GetSwap :one
+SELECT id, account_name, give_asset, give_amount, want_asset, want_amount, fee_amount FROM swap WHERE id=$1
+`
+
+func (q *Queries) GetSwap(ctx context.Context, id string) (Swap, error) {
+	row := q.db.QueryRow(ctx, getSwap, id)
+	var i Swap
+	err := row.Scan(
+		&i.ID,
+		&i.AccountName,
+		&i.GiveAsset,
+		&i.GiveAmount,
+		&i.WantAsset,
+		&i.WantAmount,
+		&i.FeeAmount,
+	)
+	return i, err
+}
+
 const getTransaction = `-- name: GetTransaction :many
-SELECT tx_id, tx_hex, block_hash, block_height, block_time, id, account_name, fk_tx_id FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id WHERE tx_id=$1
+SELECT tx_id, tx_hex, block_hash, block_height, block_time, internal, id, account_name, fk_tx_id FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id WHERE tx_id=$1
 `
 
 type GetTransactionRow struct {
@@ -204,28 +646,182 @@ type GetTransactionRow struct {
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	Internal    bool
+	ID          sql.NullInt32
+	AccountName sql.NullString
+	FkTxID      sql.NullString
+}
+
+func (q *Queries) GetTransaction(ctx context.Context, txID string) ([]GetTransactionRow, error) {
+	rows, err := q.db.Query(ctx, getTransaction, txID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTransactionRow
+	for rows.Next() {
+		var i GetTransactionRow
+		if err := rows.Scan(
+			&i.TxID,
+			&i.TxHex,
+			&i.BlockHash,
+			&i.BlockHeight,
+			&i.BlockTime,
+			&i.Internal,
+			&i.ID,
+			&i.AccountName,
+			&i.FkTxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransactionsForAccount = `-- name: GetTransactionsForAccount :many
+SELECT tx_id, tx_hex, block_hash, block_height, block_time, internal, id, account_name, fk_tx_id FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id
+WHERE t.tx_id IN (SELECT a.fk_tx_id FROM tx_input_account a WHERE a.account_name=$1)
+ORDER BY t.tx_id
+`
+
+type GetTransactionsForAccountRow struct {
+	TxID        string
+	TxHex       string
+	BlockHash   string
+	BlockHeight int32
+	BlockTime   sql.NullInt64
+	Internal    bool
+	ID          sql.NullInt32
+	AccountName sql.NullString
+	FkTxID      sql.NullString
+}
+
+func (q *Queries) GetTransactionsForAccount(ctx context.Context, accountName string) ([]GetTransactionsForAccountRow, error) {
+	rows, err := q.db.Query(ctx, getTransactionsForAccount, accountName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTransactionsForAccountRow
+	for rows.Next() {
+		var i GetTransactionsForAccountRow
+		if err := rows.Scan(
+			&i.TxID,
+			&i.TxHex,
+			&i.BlockHash,
+			&i.BlockHeight,
+			&i.BlockTime,
+			&i.Internal,
+			&i.ID,
+			&i.AccountName,
+			&i.FkTxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransactionsForAccountPage = `-- name: GetTransactionsForAccountPage :many
+SELECT tx_id, tx_hex, block_hash, block_height, block_time, internal, id, account_name, fk_tx_id FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id
+WHERE t.tx_id IN (
+    SELECT p.tx_id FROM transaction p
+    WHERE p.tx_id IN (SELECT a.fk_tx_id FROM tx_input_account a WHERE a.account_name=$1)
+    ORDER BY p.block_hash = '', p.block_height, p.tx_id
+    LIMIT $2 OFFSET $3
+)
+ORDER BY t.block_hash = '', t.block_height, t.tx_id
+`
+
+type GetTransactionsForAccountPageParams struct {
+	AccountName string
+	Limit       int32
+	Offset      int32
+}
+
+type GetTransactionsForAccountPageRow struct {
+	TxID        string
+	TxHex       string
+	BlockHash   string
+	BlockHeight int32
+	BlockTime   sql.NullInt64
+	Internal    bool
+	ID          sql.NullInt32
+	AccountName sql.NullString
+	FkTxID      sql.NullString
+}
+
+func (q *Queries) GetTransactionsForAccountPage(ctx context.Context, arg GetTransactionsForAccountPageParams) ([]GetTransactionsForAccountPageRow, error) {
+	rows, err := q.db.Query(ctx, getTransactionsForAccountPage, arg.AccountName, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTransactionsForAccountPageRow
+	for rows.Next() {
+		var i GetTransactionsForAccountPageRow
+		if err := rows.Scan(
+			&i.TxID,
+			&i.TxHex,
+			&i.BlockHash,
+			&i.BlockHeight,
+			&i.BlockTime,
+			&i.Internal,
+			&i.ID,
+			&i.AccountName,
+			&i.FkTxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransfer = `-- name: GetTransfer :many
+SELECT tx_id, account_name, id, asset, amount, script, blinding_key, fk_tx_id FROM transfer t left join transfer_receiver tr on t.tx_id = tr.fk_tx_id
+WHERE t.tx_id=$1 ORDER BY tr.id
+`
+
+type GetTransferRow struct {
+	TxID        string
+	AccountName string
 	ID          sql.NullInt32
-	AccountName sql.NullString
+	Asset       sql.NullString
+	Amount      sql.NullInt64
+	Script      []byte
+	BlindingKey []byte
 	FkTxID      sql.NullString
 }
 
-func (q *Queries) GetTransaction(ctx context.Context, txID string) ([]GetTransactionRow, error) {
-	rows, err := q.db.Query(ctx, getTransaction, txID)
+func (q *Queries) GetTransfer(ctx context.Context, txID string) ([]GetTransferRow, error) {
+	rows, err := q.db.Query(ctx, getTransfer, txID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetTransactionRow
+	var items []GetTransferRow
 	for rows.Next() {
-		var i GetTransactionRow
+		var i GetTransferRow
 		if err := rows.Scan(
 			&i.TxID,
-			&i.TxHex,
-			&i.BlockHash,
-			&i.BlockHeight,
-			&i.BlockTime,
-			&i.ID,
 			&i.AccountName,
+			&i.ID,
+			&i.Asset,
+			&i.Amount,
+			&i.Script,
+			&i.BlindingKey,
 			&i.FkTxID,
 		); err != nil {
 			return nil, err
@@ -239,7 +835,7 @@ func (q *Queries) GetTransaction(ctx context.Context, txID string) ([]GetTransac
 }
 
 const getUtxoForKey = `-- name: GetUtxoForKey :many
-SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 WHERE u.tx_id = $1 AND u.vout = $2
 `
 
@@ -265,6 +861,9 @@ type GetUtxoForKeyRow struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 	ID_2                sql.NullInt32
 	BlockHeight         sql.NullInt32
 	BlockTime           sql.NullInt64
@@ -300,6 +899,9 @@ func (q *Queries) GetUtxoForKey(ctx context.Context, arg GetUtxoForKeyParams) ([
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.LeaseID,
+			&i.FrozenTimestamp,
+			&i.FreezeReason,
 			&i.ID_2,
 			&i.BlockHeight,
 			&i.BlockTime,
@@ -319,7 +921,7 @@ func (q *Queries) GetUtxoForKey(ctx context.Context, arg GetUtxoForKeyParams) ([
 }
 
 const getUtxosForAccount = `-- name: GetUtxosForAccount :many
-SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 WHERE u.account_name = $1
 `
 
@@ -340,6 +942,9 @@ type GetUtxosForAccountRow struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 	ID_2                sql.NullInt32
 	BlockHeight         sql.NullInt32
 	BlockTime           sql.NullInt64
@@ -375,6 +980,9 @@ func (q *Queries) GetUtxosForAccount(ctx context.Context, accountName string) ([
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.LeaseID,
+			&i.FrozenTimestamp,
+			&i.FreezeReason,
 			&i.ID_2,
 			&i.BlockHeight,
 			&i.BlockTime,
@@ -394,7 +1002,7 @@ func (q *Queries) GetUtxosForAccount(ctx context.Context, accountName string) ([
 }
 
 const getUtxosForAccountName = `-- name: GetUtxosForAccountName :many
-SELECT id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp FROM utxo WHERE account_name=$1
+SELECT id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason FROM utxo WHERE account_name=$1
 `
 
 func (q *Queries) GetUtxosForAccountName(ctx context.Context, accountName string) ([]Utxo, error) {
@@ -423,6 +1031,90 @@ func (q *Queries) GetUtxosForAccountName(ctx context.Context, accountName string
 			&i.AccountName,
 			&i.LockTimestamp,
 			&i.LockExpiryTimestamp,
+			&i.LeaseID,
+			&i.FrozenTimestamp,
+			&i.FreezeReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUtxosForLease = `-- name: GetUtxosForLease :many
+SELECT u.id, u.tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason, us.id, block_height, block_time, block_hash, status, fk_utxo_id, us.tx_id FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+WHERE u.lease_id = $1 AND u.lock_timestamp > 0
+`
+
+type GetUtxosForLeaseRow struct {
+	ID                  int32
+	TxID                string
+	Vout                int32
+	Value               int64
+	Asset               string
+	ValueCommitment     []byte
+	AssetCommitment     []byte
+	ValueBlinder        []byte
+	AssetBlinder        []byte
+	Script              []byte
+	Nonce               []byte
+	RangeProof          []byte
+	SurjectionProof     []byte
+	AccountName         string
+	LockTimestamp       int64
+	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
+	ID_2                sql.NullInt32
+	BlockHeight         sql.NullInt32
+	BlockTime           sql.NullInt64
+	BlockHash           sql.NullString
+	Status              sql.NullInt32
+	FkUtxoID            sql.NullInt32
+	TxID_2              sql.NullString
+}
+
+func (q *Queries) GetUtxosForLease(ctx context.Context, leaseID string) ([]GetUtxosForLeaseRow, error) {
+	rows, err := q.db.Query(ctx, getUtxosForLease, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUtxosForLeaseRow
+	for rows.Next() {
+		var i GetUtxosForLeaseRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TxID,
+			&i.Vout,
+			&i.Value,
+			&i.Asset,
+			&i.ValueCommitment,
+			&i.AssetCommitment,
+			&i.ValueBlinder,
+			&i.AssetBlinder,
+			&i.Script,
+			&i.Nonce,
+			&i.RangeProof,
+			&i.SurjectionProof,
+			&i.AccountName,
+			&i.LockTimestamp,
+			&i.LockExpiryTimestamp,
+			&i.LeaseID,
+			&i.FrozenTimestamp,
+			&i.FreezeReason,
+			&i.ID_2,
+			&i.BlockHeight,
+			&i.BlockTime,
+			&i.BlockHash,
+			&i.Status,
+			&i.FkUtxoID,
+			&i.TxID_2,
 		); err != nil {
 			return nil, err
 		}
@@ -549,6 +1241,58 @@ type InsertAccountScriptsParams struct {
 	FkAccountName  string
 }
 
+const insertPayment = `-- name: InsertPayment :exec
+INSERT INTO payment(id,account_name,asset,amount,script,blinding_key,timestamp)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+`
+
+type InsertPaymentParams struct {
+	ID          string
+	AccountName string
+	Asset       string
+	Amount      int64
+	Script      []byte
+	BlindingKey []byte
+	Timestamp   int64
+}
+
+// PAYMENT
+func (q *Queries) InsertPayment(ctx context.Context, arg InsertPaymentParams) error {
+	_, err := q.db.Exec(ctx, insertPayment,
+		arg.ID,
+		arg.AccountName,
+		arg.Asset,
+		arg.Amount,
+		arg.Script,
+		arg.BlindingKey,
+		arg.Timestamp,
+	)
+	return err
+}
+
+const insertPolicyViolation = `-- name: InsertPolicyViolation :exec
+INSERT INTO policy_violation(id,account_name,rule,reason,timestamp) VALUES($1,$2,$3,$4,$5)
+`
+
+type InsertPolicyViolationParams struct {
+	ID          string
+	AccountName string
+	Rule        string
+	Reason      string
+	Timestamp   int64
+}
+
+func (q *Queries) InsertPolicyViolation(ctx context.Context, arg InsertPolicyViolationParams) error {
+	_, err := q.db.Exec(ctx, insertPolicyViolation,
+		arg.ID,
+		arg.AccountName,
+		arg.Rule,
+		arg.Reason,
+		arg.Timestamp,
+	)
+	return err
+}
+
 const insertScript = `-- name: InsertScript :exec
 INSERT INTO external_script(account,script,blinding_key) VALUES($1,$2,$3)
 `
@@ -565,9 +1309,143 @@ func (q *Queries) InsertScript(ctx context.Context, arg InsertScriptParams) erro
 	return err
 }
 
+const insertSpending = `-- name: InsertSpending :exec
+INSERT INTO spending(id,account_name,digest,created_at,signed_at) VALUES($1,$2,$3,$4,$5)
+`
+
+type InsertSpendingParams struct {
+	ID          string
+	AccountName string
+	Digest      string
+	CreatedAt   int64
+	SignedAt    int64
+}
+
+func (q *Queries) InsertSpending(ctx context.Context, arg InsertSpendingParams) error {
+	_, err := q.db.Exec(ctx, insertSpending,
+		arg.ID,
+		arg.AccountName,
+		arg.Digest,
+		arg.CreatedAt,
+		arg.SignedAt,
+	)
+	return err
+}
+
+const insertSpendingAmount = `-- name: InsertSpendingAmount :exec
+INSERT INTO spending_amount(asset,amount,fk_spending_id) VALUES($1,$2,$3)
+`
+
+type InsertSpendingAmountParams struct {
+	Asset        string
+	Amount       int64
+	FkSpendingID string
+}
+
+func (q *Queries) InsertSpendingAmount(ctx context.Context, arg InsertSpendingAmountParams) error {
+	_, err := q.db.Exec(ctx, insertSpendingAmount, arg.Asset, arg.Amount, arg.FkSpendingID)
+	return err
+}
+
+const insertSpendingApprover = `-- name: InsertSpendingApprover :exec
+INSERT INTO spending_approver(approver,fk_spending_id) VALUES($1,$2)
+`
+
+type InsertSpendingApproverParams struct {
+	Approver     string
+	FkSpendingID string
+}
+
+func (q *Queries) InsertSpendingApprover(ctx context.Context, arg InsertSpendingApproverParams) error {
+	_, err := q.db.Exec(ctx, insertSpendingApprover, arg.Approver, arg.FkSpendingID)
+	return err
+}
+
+const insertSpendingPolicyApprover = `-- name: InsertSpendingPolicyApprover :exec
+INSERT INTO spending_policy_approver(pubkey,fk_account_name) VALUES($1,$2)
+`
+
+type InsertSpendingPolicyApproverParams struct {
+	Pubkey        string
+	FkAccountName string
+}
+
+func (q *Queries) InsertSpendingPolicyApprover(ctx context.Context, arg InsertSpendingPolicyApproverParams) error {
+	_, err := q.db.Exec(ctx, insertSpendingPolicyApprover, arg.Pubkey, arg.FkAccountName)
+	return err
+}
+
+const insertSpendingPolicyLimit = `-- name: InsertSpendingPolicyLimit :exec
+INSERT INTO spending_policy_limit(asset,max_amount_per_tx,max_amount_per_day,approval_threshold,fk_account_name)
+VALUES($1,$2,$3,$4,$5)
+`
+
+type InsertSpendingPolicyLimitParams struct {
+	Asset             string
+	MaxAmountPerTx    int64
+	MaxAmountPerDay   int64
+	ApprovalThreshold int64
+	FkAccountName     string
+}
+
+func (q *Queries) InsertSpendingPolicyLimit(ctx context.Context, arg InsertSpendingPolicyLimitParams) error {
+	_, err := q.db.Exec(ctx, insertSpendingPolicyLimit,
+		arg.Asset,
+		arg.MaxAmountPerTx,
+		arg.MaxAmountPerDay,
+		arg.ApprovalThreshold,
+		arg.FkAccountName,
+	)
+	return err
+}
+
+const insertSpendingPolicyScript = `-- name: InsertSpendingPolicyScript :exec
+INSERT INTO spending_policy_script(script,allowed,fk_account_name) VALUES($1,$2,$3)
+`
+
+type InsertSpendingPolicyScriptParams struct {
+	Script        string
+	Allowed       bool
+	FkAccountName string
+}
+
+func (q *Queries) InsertSpendingPolicyScript(ctx context.Context, arg InsertSpendingPolicyScriptParams) error {
+	_, err := q.db.Exec(ctx, insertSpendingPolicyScript, arg.Script, arg.Allowed, arg.FkAccountName)
+	return err
+}
+
+const insertSwap = `-- name: InsertSwap :exec
+INSERT INTO swap(id,account_name,give_asset,give_amount,want_asset,want_amount,fee_amount)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+`
+
+type InsertSwapParams struct {
+	ID          string
+	AccountName string
+	GiveAsset   string
+	GiveAmount  int64
+	WantAsset   string
+	WantAmount  int64
+	FeeAmount   int64
+}
+
+// SWAP
+func (q *Queries) InsertSwap(ctx context.Context, arg InsertSwapParams) error {
+	_, err := q.db.Exec(ctx, insertSwap,
+		arg.ID,
+		arg.AccountName,
+		arg.GiveAsset,
+		arg.GiveAmount,
+		arg.WantAsset,
+		arg.WantAmount,
+		arg.FeeAmount,
+	)
+	return err
+}
+
 const insertTransaction = `-- name: InsertTransaction :one
-INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time)
-VALUES($1,$2,$3,$4,$5) RETURNING tx_id, tx_hex, block_hash, block_height, block_time
+INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time,internal)
+VALUES($1,$2,$3,$4,$5,$6) RETURNING tx_id, tx_hex, block_hash, block_height, block_time, internal
 `
 
 type InsertTransactionParams struct {
@@ -576,6 +1454,7 @@ type InsertTransactionParams struct {
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	Internal    bool
 }
 
 // TRANSACTION
@@ -586,6 +1465,7 @@ func (q *Queries) InsertTransaction(ctx context.Context, arg InsertTransactionPa
 		arg.BlockHash,
 		arg.BlockHeight,
 		arg.BlockTime,
+		arg.Internal,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -594,6 +1474,7 @@ func (q *Queries) InsertTransaction(ctx context.Context, arg InsertTransactionPa
 		&i.BlockHash,
 		&i.BlockHeight,
 		&i.BlockTime,
+		&i.Internal,
 	)
 	return i, err
 }
@@ -615,9 +1496,48 @@ func (q *Queries) InsertTransactionInputAccount(ctx context.Context, arg InsertT
 	return i, err
 }
 
+const insertTransfer = `-- name: InsertTransfer :exec
+INSERT INTO transfer(tx_id,account_name) VALUES($1,$2)
+`
+
+type InsertTransferParams struct {
+	TxID        string
+	AccountName string
+}
+
+// TRANSFER
+func (q *Queries) InsertTransfer(ctx context.Context, arg InsertTransferParams) error {
+	_, err := q.db.Exec(ctx, insertTransfer, arg.TxID, arg.AccountName)
+	return err
+}
+
+const insertTransferReceiver = `-- name: InsertTransferReceiver :exec
+INSERT INTO transfer_receiver(asset,amount,script,blinding_key,fk_tx_id)
+VALUES($1,$2,$3,$4,$5)
+`
+
+type InsertTransferReceiverParams struct {
+	Asset       string
+	Amount      int64
+	Script      []byte
+	BlindingKey []byte
+	FkTxID      string
+}
+
+func (q *Queries) InsertTransferReceiver(ctx context.Context, arg InsertTransferReceiverParams) error {
+	_, err := q.db.Exec(ctx, insertTransferReceiver,
+		arg.Asset,
+		arg.Amount,
+		arg.Script,
+		arg.BlindingKey,
+		arg.FkTxID,
+	)
+	return err
+}
+
 const insertUtxo = `-- name: InsertUtxo :one
-INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp)
-VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15) RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp
+INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp,lease_id,frozen_timestamp,freeze_reason)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15, $16, $17, $18) RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason
 `
 
 type InsertUtxoParams struct {
@@ -636,6 +1556,9 @@ type InsertUtxoParams struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 }
 
 // UTXO
@@ -656,6 +1579,9 @@ func (q *Queries) InsertUtxo(ctx context.Context, arg InsertUtxoParams) (Utxo, e
 		arg.AccountName,
 		arg.LockTimestamp,
 		arg.LockExpiryTimestamp,
+		arg.LeaseID,
+		arg.FrozenTimestamp,
+		arg.FreezeReason,
 	)
 	var i Utxo
 	err := row.Scan(
@@ -675,6 +1601,9 @@ func (q *Queries) InsertUtxo(ctx context.Context, arg InsertUtxoParams) (Utxo, e
 		&i.AccountName,
 		&i.LockTimestamp,
 		&i.LockExpiryTimestamp,
+		&i.LeaseID,
+		&i.FrozenTimestamp,
+		&i.FreezeReason,
 	)
 	return i, err
 }
@@ -754,6 +1683,24 @@ func (q *Queries) InsertWallet(ctx context.Context, arg InsertWalletParams) (Wal
 	return i, err
 }
 
+const resetPayments = `-- name: ResetPayments :exec
+DELETE FROM payment
+`
+
+func (q *Queries) ResetPayments(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetPayments)
+	return err
+}
+
+const resetPolicyViolations = `-- name: ResetPolicyViolations :exec
+DELETE FROM policy_violation
+`
+
+func (q *Queries) ResetPolicyViolations(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetPolicyViolations)
+	return err
+}
+
 const resetScripts = `-- name: ResetScripts :exec
 DELETE FROM external_script
 `
@@ -763,6 +1710,33 @@ func (q *Queries) ResetScripts(ctx context.Context) error {
 	return err
 }
 
+const resetSpendingPolicies = `-- name: ResetSpendingPolicies :exec
+DELETE FROM spending_policy
+`
+
+func (q *Queries) ResetSpendingPolicies(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetSpendingPolicies)
+	return err
+}
+
+const resetSpendings = `-- name: ResetSpendings :exec
+DELETE FROM spending
+`
+
+func (q *Queries) ResetSpendings(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetSpendings)
+	return err
+}
+
+const resetSwaps = `-- name: ResetSwaps :exec
+DELETE FROM swap
+`
+
+func (q *Queries) ResetSwaps(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetSwaps)
+	return err
+}
+
 const resetTransactions = `-- name: ResetTransactions :exec
 DELETE FROM transaction
 `
@@ -772,6 +1746,15 @@ func (q *Queries) ResetTransactions(ctx context.Context) error {
 	return err
 }
 
+const resetTransfers = `-- name: ResetTransfers :exec
+DELETE FROM transfer
+`
+
+func (q *Queries) ResetTransfers(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, resetTransfers)
+	return err
+}
+
 const resetUtxos = `-- name: ResetUtxos :exec
 DELETE FROM utxo
 `
@@ -823,8 +1806,65 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 	return i, err
 }
 
+const updatePaymentFailureReason = `-- name: UpdatePaymentFailureReason :execrows
+UPDATE payment SET failure_reason=$1 WHERE id=$2
+`
+
+type UpdatePaymentFailureReasonParams struct {
+	FailureReason string
+	ID            string
+}
+
+func (q *Queries) UpdatePaymentFailureReason(ctx context.Context, arg UpdatePaymentFailureReasonParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePaymentFailureReason, arg.FailureReason, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePaymentTxID = `-- name: UpdatePaymentTxID :execrows
+UPDATE payment SET txid=$1 WHERE id=$2
+`
+
+type UpdatePaymentTxIDParams struct {
+	Txid string
+	ID   string
+}
+
+func (q *Queries) UpdatePaymentTxID(ctx context.Context, arg UpdatePaymentTxIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePaymentTxID, arg.Txid, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateSpending = `-- name: UpdateSpending :exec
+UPDATE spending SET account_name=$1, digest=$2, created_at=$3, signed_at=$4 WHERE id=$5
+`
+
+type UpdateSpendingParams struct {
+	AccountName string
+	Digest      string
+	CreatedAt   int64
+	SignedAt    int64
+	ID          string
+}
+
+func (q *Queries) UpdateSpending(ctx context.Context, arg UpdateSpendingParams) error {
+	_, err := q.db.Exec(ctx, updateSpending,
+		arg.AccountName,
+		arg.Digest,
+		arg.CreatedAt,
+		arg.SignedAt,
+		arg.ID,
+	)
+	return err
+}
+
 const updateTransaction = `-- name: UpdateTransaction :one
-UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4 WHERE tx_id=$5 RETURNING tx_id, tx_hex, block_hash, block_height, block_time
+UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4,internal=$5 WHERE tx_id=$6 RETURNING tx_id, tx_hex, block_hash, block_height, block_time, internal
 `
 
 type UpdateTransactionParams struct {
@@ -832,6 +1872,7 @@ type UpdateTransactionParams struct {
 	BlockHash   string
 	BlockHeight int32
 	BlockTime   sql.NullInt64
+	Internal    bool
 	TxID        string
 }
 
@@ -841,6 +1882,7 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		arg.BlockHash,
 		arg.BlockHeight,
 		arg.BlockTime,
+		arg.Internal,
 		arg.TxID,
 	)
 	var i Transaction
@@ -850,12 +1892,13 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		&i.BlockHash,
 		&i.BlockHeight,
 		&i.BlockTime,
+		&i.Internal,
 	)
 	return i, err
 }
 
 const updateUtxo = `-- name: UpdateUtxo :one
-UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13 WHERE tx_id=$14 and vout=$15 RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp
+UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13, lease_id=$14, frozen_timestamp=$15, freeze_reason=$16 WHERE tx_id=$17 and vout=$18 RETURNING id, tx_id, vout, value, asset, value_commitment, asset_commitment, value_blinder, asset_blinder, script, nonce, range_proof, surjection_proof, account_name, lock_timestamp, lock_expiry_timestamp, lease_id, frozen_timestamp, freeze_reason
 `
 
 type UpdateUtxoParams struct {
@@ -872,6 +1915,9 @@ type UpdateUtxoParams struct {
 	AccountName         string
 	LockTimestamp       int64
 	LockExpiryTimestamp int64
+	LeaseID             string
+	FrozenTimestamp     int64
+	FreezeReason        string
 	TxID                string
 	Vout                int32
 }
@@ -891,6 +1937,9 @@ func (q *Queries) UpdateUtxo(ctx context.Context, arg UpdateUtxoParams) (Utxo, e
 		arg.AccountName,
 		arg.LockTimestamp,
 		arg.LockExpiryTimestamp,
+		arg.LeaseID,
+		arg.FrozenTimestamp,
+		arg.FreezeReason,
 		arg.TxID,
 		arg.Vout,
 	)
@@ -912,6 +1961,9 @@ func (q *Queries) UpdateUtxo(ctx context.Context, arg UpdateUtxoParams) (Utxo, e
 		&i.AccountName,
 		&i.LockTimestamp,
 		&i.LockExpiryTimestamp,
+		&i.LeaseID,
+		&i.FrozenTimestamp,
+		&i.FreezeReason,
 	)
 	return i, err
 }
@@ -952,3 +2004,27 @@ func (q *Queries) UpdateWallet(ctx context.Context, arg UpdateWalletParams) (Wal
 	)
 	return i, err
 }
+
+const upsertSpendingPolicy = `-- name: UpsertSpendingPolicy :exec
+INSERT INTO spending_policy(account_name,max_fee_rate,max_fee_amount,required_approvals)
+VALUES($1,$2,$3,$4)
+ON CONFLICT (account_name) DO UPDATE SET max_fee_rate=EXCLUDED.max_fee_rate, max_fee_amount=EXCLUDED.max_fee_amount, required_approvals=EXCLUDED.required_approvals
+`
+
+type UpsertSpendingPolicyParams struct {
+	AccountName       string
+	MaxFeeRate        int64
+	MaxFeeAmount      int64
+	RequiredApprovals int32
+}
+
+// SPENDING POLICY
+func (q *Queries) UpsertSpendingPolicy(ctx context.Context, arg UpsertSpendingPolicyParams) error {
+	_, err := q.db.Exec(ctx, upsertSpendingPolicy,
+		arg.AccountName,
+		arg.MaxFeeRate,
+		arg.MaxFeeAmount,
+		arg.RequiredApprovals,
+	)
+	return err
+}
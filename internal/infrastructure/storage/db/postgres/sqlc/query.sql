@@ -33,8 +33,8 @@ DELETE FROM account WHERE namespace = $1;
 
 /* UTXO */
 -- name: InsertUtxo :one
-INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp)
-VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15) RETURNING *;
+INSERT INTO utxo(tx_id,vout,value,asset,value_commitment,asset_commitment,value_blinder,asset_blinder,script,nonce,range_proof,surjection_proof,account_name,lock_timestamp,lock_expiry_timestamp,lease_id,frozen_timestamp,freeze_reason)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14, $15, $16, $17, $18) RETURNING *;
 
 -- name: InsertUtxoStatus :one
 INSERT INTO utxo_status(block_height,block_time,block_hash,status,fk_utxo_id,tx_id)
@@ -51,8 +51,12 @@ SELECT * FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id;
 SELECT * FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
 WHERE u.account_name = $1;
 
+-- name: GetUtxosForLease :many
+SELECT * FROM utxo u left join utxo_status us on u.id = us.fk_utxo_id
+WHERE u.lease_id = $1 AND u.lock_timestamp > 0;
+
 -- name: UpdateUtxo :one
-UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13 WHERE tx_id=$14 and vout=$15 RETURNING *;
+UPDATE utxo SET value=$1,asset=$2,value_commitment=$3,asset_commitment=$4,value_blinder=$5,asset_blinder=$6,script=$7,nonce=$8,range_proof=$9,surjection_proof=$10,account_name=$11,lock_timestamp=$12, lock_expiry_timestamp=$13, lease_id=$14, frozen_timestamp=$15, freeze_reason=$16 WHERE tx_id=$17 and vout=$18 RETURNING *;
 
 -- name: DeleteUtxoStatuses :exec
 DELETE FROM utxo_status WHERE fk_utxo_id = $1;
@@ -60,27 +64,48 @@ DELETE FROM utxo_status WHERE fk_utxo_id = $1;
 -- name: DeleteUtxosForAccountName :exec
 DELETE FROM utxo WHERE account_name=$1;
 
+-- name: DeleteUtxo :exec
+DELETE FROM utxo WHERE id=$1;
+
 -- name: GetUtxosForAccountName :many
 SELECT * FROM utxo WHERE account_name=$1;
 
 /* TRANSACTION */
 -- name: InsertTransaction :one
-INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time)
-VALUES($1,$2,$3,$4,$5) RETURNING *;
+INSERT INTO transaction(tx_id,tx_hex,block_hash,block_height,block_time,internal)
+VALUES($1,$2,$3,$4,$5,$6) RETURNING *;
 
 -- name: InsertTransactionInputAccount :one
 INSERT INTO tx_input_account(account_name, fk_tx_id)
 VALUES($1,$2) RETURNING *;
 
 -- name: UpdateTransaction :one
-UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4 WHERE tx_id=$5 RETURNING *;
+UPDATE transaction SET tx_hex=$1,block_hash=$2,block_height=$3,block_time=$4,internal=$5 WHERE tx_id=$6 RETURNING *;
 
 -- name: DeleteTransactionInputAccounts :exec
 DELETE FROM tx_input_account WHERE fk_tx_id=$1;
 
+-- name: DeleteTransaction :execrows
+DELETE FROM transaction WHERE tx_id=$1;
+
 -- name: GetTransaction :many
 SELECT * FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id WHERE tx_id=$1;
 
+-- name: GetTransactionsForAccount :many
+SELECT * FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id
+WHERE t.tx_id IN (SELECT a.fk_tx_id FROM tx_input_account a WHERE a.account_name=$1)
+ORDER BY t.tx_id;
+
+-- name: GetTransactionsForAccountPage :many
+SELECT * FROM transaction t left join tx_input_account tia on t.tx_id = tia.fk_tx_id
+WHERE t.tx_id IN (
+    SELECT p.tx_id FROM transaction p
+    WHERE p.tx_id IN (SELECT a.fk_tx_id FROM tx_input_account a WHERE a.account_name=$1)
+    ORDER BY p.block_hash = '', p.block_height, p.tx_id
+    LIMIT $2 OFFSET $3
+)
+ORDER BY t.block_hash = '', t.block_height, t.tx_id;
+
 /* EXTERNAL SCRIPT */
 -- name: InsertScript :exec
 INSERT INTO external_script(account,script,blinding_key) VALUES($1,$2,$3);
@@ -94,6 +119,128 @@ SELECT * FROM external_script WHERE account = $1;
 -- name: DeleteScript :exec
 DELETE FROM external_script WHERE account = $1;
 
+/* TRANSFER */
+-- name: InsertTransfer :exec
+INSERT INTO transfer(tx_id,account_name) VALUES($1,$2);
+
+-- name: InsertTransferReceiver :exec
+INSERT INTO transfer_receiver(asset,amount,script,blinding_key,fk_tx_id)
+VALUES($1,$2,$3,$4,$5);
+
+-- name: GetTransfer :many
+SELECT * FROM transfer t left join transfer_receiver tr on t.tx_id = tr.fk_tx_id
+WHERE t.tx_id=$1 ORDER BY tr.id;
+
+-- name: DeleteTransfer :exec
+DELETE FROM transfer WHERE tx_id=$1;
+
+/* PAYMENT */
+-- name: InsertPayment :exec
+INSERT INTO payment(id,account_name,asset,amount,script,blinding_key,timestamp)
+VALUES($1,$2,$3,$4,$5,$6,$7);
+
+-- name: GetQueuedPayments :many
+SELECT * FROM payment ORDER BY timestamp;
+
+-- name: UpdatePaymentTxID :execrows
+UPDATE payment SET txid=$1 WHERE id=$2;
+
+-- name: UpdatePaymentFailureReason :execrows
+UPDATE payment SET failure_reason=$1 WHERE id=$2;
+
+-- name: DeletePayment :one
+DELETE FROM payment WHERE id=$1 RETURNING *;
+
+/* SWAP */
+-- name: InsertSwap :exec
+INSERT INTO swap(id,account_name,give_asset,give_amount,want_asset,want_amount,fee_amount)
+VALUES($1,$2,$3,$4,$5,$6,$7);
+
+-- name: GetSwap :one
+SELECT * FROM swap WHERE id=$1;
+
+-- name: DeleteSwap :one
+DELETE FROM swap WHERE id=$1 RETURNING *;
+
+/* SPENDING POLICY */
+-- name: UpsertSpendingPolicy :exec
+INSERT INTO spending_policy(account_name,max_fee_rate,max_fee_amount,required_approvals)
+VALUES($1,$2,$3,$4)
+ON CONFLICT (account_name) DO UPDATE SET max_fee_rate=EXCLUDED.max_fee_rate, max_fee_amount=EXCLUDED.max_fee_amount, required_approvals=EXCLUDED.required_approvals;
+
+-- name: InsertSpendingPolicyLimit :exec
+INSERT INTO spending_policy_limit(asset,max_amount_per_tx,max_amount_per_day,approval_threshold,fk_account_name)
+VALUES($1,$2,$3,$4,$5);
+
+-- name: InsertSpendingPolicyScript :exec
+INSERT INTO spending_policy_script(script,allowed,fk_account_name) VALUES($1,$2,$3);
+
+-- name: DeleteSpendingPolicyLimits :exec
+DELETE FROM spending_policy_limit WHERE fk_account_name=$1;
+
+-- name: InsertSpendingPolicyApprover :exec
+INSERT INTO spending_policy_approver(pubkey,fk_account_name) VALUES($1,$2);
+
+-- name: DeleteSpendingPolicyScripts :exec
+DELETE FROM spending_policy_script WHERE fk_account_name=$1;
+
+-- name: DeleteSpendingPolicyApprovers :exec
+DELETE FROM spending_policy_approver WHERE fk_account_name=$1;
+
+-- name: GetSpendingPolicy :one
+SELECT * FROM spending_policy WHERE account_name=$1;
+
+-- name: GetSpendingPolicyLimits :many
+SELECT * FROM spending_policy_limit WHERE fk_account_name=$1 ORDER BY id;
+
+-- name: GetSpendingPolicyScripts :many
+SELECT * FROM spending_policy_script WHERE fk_account_name=$1 ORDER BY id;
+
+-- name: GetSpendingPolicyApprovers :many
+SELECT * FROM spending_policy_approver WHERE fk_account_name=$1 ORDER BY id;
+
+-- name: DeleteSpendingPolicy :one
+DELETE FROM spending_policy WHERE account_name=$1 RETURNING *;
+
+-- name: InsertSpending :exec
+INSERT INTO spending(id,account_name,digest,created_at,signed_at) VALUES($1,$2,$3,$4,$5);
+
+-- name: UpdateSpending :exec
+UPDATE spending SET account_name=$1, digest=$2, created_at=$3, signed_at=$4 WHERE id=$5;
+
+-- name: InsertSpendingAmount :exec
+INSERT INTO spending_amount(asset,amount,fk_spending_id) VALUES($1,$2,$3);
+
+-- name: InsertSpendingApprover :exec
+INSERT INTO spending_approver(approver,fk_spending_id) VALUES($1,$2);
+
+-- name: DeleteSpendingAmounts :exec
+DELETE FROM spending_amount WHERE fk_spending_id=$1;
+
+-- name: DeleteSpendingApprovers :exec
+DELETE FROM spending_approver WHERE fk_spending_id=$1;
+
+-- name: GetSpending :one
+SELECT * FROM spending WHERE id=$1;
+
+-- name: GetSpendingForUpdate :one
+SELECT * FROM spending WHERE id=$1 FOR UPDATE;
+
+-- name: GetSpendingsForAccount :many
+SELECT * FROM spending WHERE account_name=$1 AND created_at>=$2 ORDER BY created_at;
+
+-- name: GetSpendingAmounts :many
+SELECT * FROM spending_amount WHERE fk_spending_id=$1 ORDER BY id;
+
+-- name: GetSpendingApprovers :many
+SELECT * FROM spending_approver WHERE fk_spending_id=$1 ORDER BY id;
+
+-- name: InsertPolicyViolation :exec
+INSERT INTO policy_violation(id,account_name,rule,reason,timestamp) VALUES($1,$2,$3,$4,$5);
+
+-- name: GetPolicyViolationsForAccount :many
+SELECT * FROM policy_violation WHERE account_name=$1 ORDER BY timestamp;
+
 -- name: ResetUtxos :exec
 DELETE FROM utxo;
 
@@ -105,3 +252,21 @@ DELETE FROM transaction;
 
 -- name: ResetScripts :exec
 DELETE FROM external_script;
+
+-- name: ResetTransfers :exec
+DELETE FROM transfer;
+
+-- name: ResetPayments :exec
+DELETE FROM payment;
+
+-- name: ResetSwaps :exec
+DELETE FROM swap;
+
+-- name: ResetSpendingPolicies :exec
+DELETE FROM spending_policy;
+
+-- name: ResetSpendings :exec
+DELETE FROM spending;
+
+-- name: ResetPolicyViolations :exec
+DELETE FROM policy_violation;
@@ -0,0 +1,94 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+var (
+	ErrSwapNotFound = errors.New("swap not found")
+)
+
+type swapRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewSwapRepositoryPgImpl(pgxPool *pgxpool.Pool) domain.SwapRepository {
+	return newSwapRepositoryPgImpl(pgxPool)
+}
+
+func newSwapRepositoryPgImpl(pgxPool *pgxpool.Pool) *swapRepositoryPg {
+	return &swapRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *swapRepositoryPg) AddSwap(
+	ctx context.Context, swap *domain.Swap,
+) (bool, error) {
+	if err := r.querier.InsertSwap(ctx, queries.InsertSwapParams{
+		ID:          swap.ID,
+		AccountName: swap.AccountName,
+		GiveAsset:   swap.GiveAsset,
+		GiveAmount:  int64(swap.GiveAmount),
+		WantAsset:   swap.WantAsset,
+		WantAmount:  int64(swap.WantAmount),
+		FeeAmount:   int64(swap.FeeAmount),
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok &&
+			pqErr.Code == uniqueViolation {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *swapRepositoryPg) GetSwap(
+	ctx context.Context, id string,
+) (*domain.Swap, error) {
+	row, err := r.querier.GetSwap(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSwapNotFound
+		}
+		return nil, err
+	}
+	return &domain.Swap{
+		ID:          row.ID,
+		AccountName: row.AccountName,
+		GiveAsset:   row.GiveAsset,
+		GiveAmount:  uint64(row.GiveAmount),
+		WantAsset:   row.WantAsset,
+		WantAmount:  uint64(row.WantAmount),
+		FeeAmount:   uint64(row.FeeAmount),
+	}, nil
+}
+
+func (r *swapRepositoryPg) DeleteSwap(
+	ctx context.Context, id string,
+) (bool, error) {
+	if _, err := r.querier.DeleteSwap(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *swapRepositoryPg) close() {}
+
+func (r *swapRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetSwaps(ctx)
+}
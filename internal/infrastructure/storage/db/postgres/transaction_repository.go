@@ -64,6 +64,7 @@ func (t *txRepositoryPg) AddTransaction(
 			BlockHash:   trx.BlockHash,
 			BlockHeight: int32(trx.BlockHeight),
 			BlockTime:   sql.NullInt64{Int64: trx.BlockTime, Valid: true},
+			Internal:    trx.Internal,
 		},
 	)
 	if err != nil {
@@ -125,12 +126,97 @@ func (t *txRepositoryPg) ConfirmTransaction(
 	return true, nil
 }
 
+func (t *txRepositoryPg) UnconfirmTransaction(
+	ctx context.Context, txid string,
+) (bool, error) {
+	tx, err := t.getTx(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+
+	if !tx.IsConfirmed() {
+		return false, nil
+	}
+
+	tx.Unconfirm()
+
+	if err := t.updateTx(ctx, t.querier, *tx); err != nil {
+		return false, err
+	}
+
+	go t.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionUnconfirmed,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
+func (t *txRepositoryPg) DeleteTransaction(
+	ctx context.Context, txid string,
+) (bool, error) {
+	tx, err := t.getTx(ctx, txid)
+	if err != nil {
+		if err == ErrTxNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	count, err := t.querier.DeleteTransaction(ctx, txid)
+	if err != nil {
+		return false, err
+	}
+	if count <= 0 {
+		return false, nil
+	}
+
+	go t.publishEvent(domain.TransactionEvent{
+		EventType:   domain.TransactionDeleted,
+		Transaction: tx,
+	})
+
+	return true, nil
+}
+
 func (t *txRepositoryPg) GetTransaction(
 	ctx context.Context, txid string,
 ) (*domain.Transaction, error) {
 	return t.getTx(ctx, txid)
 }
 
+func (t *txRepositoryPg) GetTransactionsForAccount(
+	ctx context.Context, accountName string,
+) ([]*domain.Transaction, error) {
+	rows, err := t.querier.GetTransactionsForAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactions(rows), nil
+}
+
+func (t *txRepositoryPg) GetTransactionsForAccountPage(
+	ctx context.Context, accountName string, offset, limit int,
+) ([]*domain.Transaction, error) {
+	rows, err := t.querier.GetTransactionsForAccountPage(
+		ctx, queries.GetTransactionsForAccountPageParams{
+			AccountName: accountName,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]queries.GetTransactionsForAccountRow, 0, len(rows))
+	for _, v := range rows {
+		list = append(list, queries.GetTransactionsForAccountRow(v))
+	}
+	return toTransactions(list), nil
+}
+
 func (t *txRepositoryPg) UpdateTransaction(
 	ctx context.Context, txid string,
 	updateFn func(tx *domain.Transaction) (*domain.Transaction, error),
@@ -177,6 +263,7 @@ func (t *txRepositoryPg) updateTx(
 		BlockHash:   trx.BlockHash,
 		BlockHeight: int32(trx.BlockHeight),
 		BlockTime:   sql.NullInt64{Int64: trx.BlockTime, Valid: true},
+		Internal:    trx.Internal,
 		TxID:        trx.TxID,
 	}); err != nil {
 		return err
@@ -225,6 +312,7 @@ func (t *txRepositoryPg) getTx(
 		BlockHash:   tx[0].BlockHash,
 		BlockHeight: uint64(tx[0].BlockHeight),
 		BlockTime:   tx[0].BlockTime.Int64,
+		Internal:    tx[0].Internal,
 		Accounts:    accounts,
 	}, nil
 }
@@ -234,3 +322,30 @@ func (t *txRepositoryPg) reset(
 ) {
 	querier.ResetTransactions(ctx)
 }
+
+func toTransactions(
+	rows []queries.GetTransactionsForAccountRow,
+) []*domain.Transaction {
+	txs := make([]*domain.Transaction, 0)
+	txsByID := make(map[string]*domain.Transaction)
+	for _, v := range rows {
+		tx, ok := txsByID[v.TxID]
+		if !ok {
+			tx = &domain.Transaction{
+				TxID:        v.TxID,
+				TxHex:       v.TxHex,
+				BlockHash:   v.BlockHash,
+				BlockHeight: uint64(v.BlockHeight),
+				BlockTime:   v.BlockTime.Int64,
+				Internal:    v.Internal,
+				Accounts:    make(map[string]struct{}),
+			}
+			txsByID[v.TxID] = tx
+			txs = append(txs, tx)
+		}
+		if v.AccountName.Valid {
+			tx.Accounts[v.AccountName.String] = struct{}{}
+		}
+	}
+	return txs
+}
@@ -0,0 +1,138 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/postgres/sqlc/queries"
+)
+
+var (
+	ErrTransferNotFound = errors.New("transfer not found")
+)
+
+type transferRepositoryPg struct {
+	pgxPool *pgxpool.Pool
+	querier *queries.Queries
+}
+
+func NewTransferRepositoryPgImpl(
+	pgxPool *pgxpool.Pool,
+) domain.TransferRepository {
+	return newTransferRepositoryPgImpl(pgxPool)
+}
+
+func newTransferRepositoryPgImpl(pgxPool *pgxpool.Pool) *transferRepositoryPg {
+	return &transferRepositoryPg{
+		pgxPool: pgxPool,
+		querier: queries.New(pgxPool),
+	}
+}
+
+func (r *transferRepositoryPg) AddTransfer(
+	ctx context.Context, transfer *domain.Transfer,
+) (bool, error) {
+	conn, err := r.pgxPool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := r.querier.WithTx(tx)
+
+	if err := querierWithTx.InsertTransfer(ctx, queries.InsertTransferParams{
+		TxID:        transfer.TxID,
+		AccountName: transfer.AccountName,
+	}); err != nil {
+		if pqErr, ok := err.(*pgconn.PgError); pqErr != nil && ok &&
+			pqErr.Code == uniqueViolation {
+			return false, nil
+		} else {
+			return false, err
+		}
+	}
+
+	for _, receiver := range transfer.Receivers {
+		if err := querierWithTx.InsertTransferReceiver(
+			ctx, queries.InsertTransferReceiverParams{
+				Asset:       receiver.Asset,
+				Amount:      int64(receiver.Amount),
+				Script:      receiver.Script,
+				BlindingKey: receiver.BlindingKey,
+				FkTxID:      transfer.TxID,
+			},
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *transferRepositoryPg) GetTransfer(
+	ctx context.Context, txid string,
+) (*domain.Transfer, error) {
+	rows, err := r.querier.GetTransfer(ctx, txid)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) <= 0 {
+		return nil, ErrTransferNotFound
+	}
+
+	transfer := &domain.Transfer{
+		TxID:        rows[0].TxID,
+		AccountName: rows[0].AccountName,
+		Receivers:   make([]domain.TransferReceiver, 0, len(rows)),
+	}
+	for _, row := range rows {
+		if !row.ID.Valid {
+			continue
+		}
+		transfer.Receivers = append(transfer.Receivers, domain.TransferReceiver{
+			Asset:       row.Asset.String,
+			Amount:      uint64(row.Amount.Int64),
+			Script:      row.Script,
+			BlindingKey: row.BlindingKey,
+		})
+	}
+
+	return transfer, nil
+}
+
+func (r *transferRepositoryPg) DeleteTransfer(
+	ctx context.Context, txid string,
+) (bool, error) {
+	if _, err := r.GetTransfer(ctx, txid); err != nil {
+		if errors.Is(err, ErrTransferNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.querier.DeleteTransfer(ctx, txid); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *transferRepositoryPg) close() {}
+
+func (r *transferRepositoryPg) reset(
+	querier *queries.Queries, ctx context.Context,
+) {
+	querier.ResetTransfers(ctx)
+}
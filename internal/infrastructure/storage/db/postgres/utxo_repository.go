@@ -73,6 +73,9 @@ func (u *utxoRepositoryPg) AddUtxos(
 			AccountName:         v.AccountName,
 			LockTimestamp:       v.LockTimestamp,
 			LockExpiryTimestamp: v.LockExpiryTimestamp,
+			LeaseID:             v.LeaseID,
+			FrozenTimestamp:     v.FrozenTimestamp,
+			FreezeReason:        v.FreezeReason,
 		}
 		utxo, err := querierWithTx.InsertUtxo(ctx, req)
 		if err != nil {
@@ -132,10 +135,16 @@ func (u *utxoRepositoryPg) AddUtxos(
 func (u *utxoRepositoryPg) GetUtxosByKey(
 	ctx context.Context,
 	utxoKeys []domain.UtxoKey,
+) ([]*domain.Utxo, error) {
+	return u.getUtxosByKey(ctx, u.querier, utxoKeys)
+}
+
+func (u *utxoRepositoryPg) getUtxosByKey(
+	ctx context.Context, querier *queries.Queries, utxoKeys []domain.UtxoKey,
 ) ([]*domain.Utxo, error) {
 	utxos := make([]*domain.Utxo, 0, len(utxoKeys))
 	for _, key := range utxoKeys {
-		utxo, err := u.querier.GetUtxoForKey(ctx, queries.GetUtxoForKeyParams{
+		utxo, err := querier.GetUtxoForKey(ctx, queries.GetUtxoForKeyParams{
 			TxID: key.TxID,
 			Vout: int32(key.VOut),
 		})
@@ -165,6 +174,9 @@ func (u *utxoRepositoryPg) GetUtxosByKey(
 			AccountName:         utxo[0].AccountName,
 			LockTimestamp:       utxo[0].LockTimestamp,
 			LockExpiryTimestamp: utxo[0].LockExpiryTimestamp,
+			LeaseID:             utxo[0].LeaseID,
+			FrozenTimestamp:     utxo[0].FrozenTimestamp,
+			FreezeReason:        utxo[0].FreezeReason,
 		}
 
 		for _, v := range utxo {
@@ -229,7 +241,7 @@ func (u *utxoRepositoryPg) GetSpendableUtxos(
 	}
 
 	for _, v := range utxosByKey {
-		if !v.IsLocked() && v.IsConfirmed() && !v.IsSpent() {
+		if !v.IsLocked() && !v.IsFrozen() && v.IsConfirmed() && !v.IsSpent() {
 			resp = append(resp, v)
 		}
 	}
@@ -291,7 +303,7 @@ func (u *utxoRepositoryPg) GetSpendableUtxosForAccount(
 	}
 
 	for _, v := range utxosByKey {
-		if !v.IsLocked() && v.IsConfirmed() && !v.IsSpent() {
+		if !v.IsLocked() && !v.IsFrozen() && v.IsConfirmed() && !v.IsSpent() {
 			resp = append(resp, v)
 		}
 	}
@@ -323,7 +335,35 @@ func (u *utxoRepositoryPg) GetLockedUtxosForAccount(
 	}
 
 	for _, v := range utxosByKey {
-		if v.IsLocked() {
+		if v.IsLocked() && !v.IsFrozen() {
+			resp = append(resp, v)
+		}
+	}
+
+	return resp, nil
+}
+
+func (u *utxoRepositoryPg) GetFrozenUtxosForAccount(
+	ctx context.Context, account string,
+) ([]*domain.Utxo, error) {
+	resp := make([]*domain.Utxo, 0)
+	utxos, err := u.querier.GetUtxosForAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	req := make([]queries.GetAllUtxosRow, 0, len(utxos))
+	for _, v := range utxos {
+		req = append(req, toGetAllUtxosRow(v))
+	}
+
+	utxosByKey, err := u.convertToUtxos(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range utxosByKey {
+		if v.IsFrozen() && !v.IsSpent() {
 			resp = append(resp, v)
 		}
 	}
@@ -331,6 +371,31 @@ func (u *utxoRepositoryPg) GetLockedUtxosForAccount(
 	return resp, nil
 }
 
+func (u *utxoRepositoryPg) GetUtxosForLease(
+	ctx context.Context, leaseID string,
+) ([]*domain.Utxo, error) {
+	utxos, err := u.querier.GetUtxosForLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := make([]queries.GetAllUtxosRow, 0, len(utxos))
+	for _, v := range utxos {
+		req = append(req, queries.GetAllUtxosRow(v))
+	}
+
+	utxosByKey, err := u.convertToUtxos(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*domain.Utxo, 0, len(utxosByKey))
+	for _, v := range utxosByKey {
+		resp = append(resp, v)
+	}
+	return resp, nil
+}
+
 func (u *utxoRepositoryPg) GetBalanceForAccount(
 	ctx context.Context, account string,
 ) (map[string]*domain.Balance, error) {
@@ -364,7 +429,9 @@ func (u *utxoRepositoryPg) GetBalanceForAccount(
 		}
 
 		b := resp[v.Asset]
-		if v.IsLocked() {
+		if v.IsFrozen() {
+			b.Frozen += v.Value
+		} else if v.IsLocked() {
 			b.Locked += v.Value
 		} else {
 			if v.IsConfirmed() {
@@ -396,11 +463,29 @@ func (u *utxoRepositoryPg) ConfirmUtxos(
 	return u.confirmUtxos(ctx, utxoKeys, status)
 }
 
+func (u *utxoRepositoryPg) UnconfirmUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return u.unconfirmUtxos(ctx, utxoKeys, false)
+}
+
+func (u *utxoRepositoryPg) UnconfirmSpendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return u.unconfirmUtxos(ctx, utxoKeys, true)
+}
+
+func (u *utxoRepositoryPg) UnspendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return u.unspendUtxos(ctx, utxoKeys)
+}
+
 func (u *utxoRepositoryPg) LockUtxos(
 	ctx context.Context,
-	utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	utxoKeys []domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (int, error) {
-	return u.lockUtxos(ctx, utxoKeys, timestamp, expiryTimestamp)
+	return u.lockUtxos(ctx, utxoKeys, leaseID, timestamp, expiryTimestamp)
 }
 
 func (u *utxoRepositoryPg) UnlockUtxos(
@@ -409,6 +494,32 @@ func (u *utxoRepositoryPg) UnlockUtxos(
 	return u.unlockUtxos(ctx, utxoKeys)
 }
 
+func (u *utxoRepositoryPg) ReleaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, leaseID string,
+) (int, error) {
+	return u.releaseUtxos(ctx, utxoKeys, leaseID)
+}
+
+func (u *utxoRepositoryPg) ExtendUtxosLease(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, leaseID string, expiryTimestamp int64,
+) (int, error) {
+	return u.extendUtxosLease(ctx, utxoKeys, leaseID, expiryTimestamp)
+}
+
+func (u *utxoRepositoryPg) FreezeUtxos(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, reason string, timestamp int64,
+) (int, error) {
+	return u.freezeUtxos(ctx, utxoKeys, reason, timestamp)
+}
+
+func (u *utxoRepositoryPg) UnfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	return u.unfreezeUtxos(ctx, utxoKeys)
+}
+
 func (u *utxoRepositoryPg) DeleteUtxosForAccount(
 	ctx context.Context, accountName string,
 ) error {
@@ -450,6 +561,51 @@ func (u *utxoRepositoryPg) DeleteUtxosForAccount(
 	return tx.Commit(ctx)
 }
 
+func (u *utxoRepositoryPg) DeleteUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	conn, err := u.pgxPool.Acquire(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := u.querier.WithTx(tx)
+
+	count := 0
+	for _, key := range utxoKeys {
+		utxos, err := querierWithTx.GetUtxoForKey(ctx, queries.GetUtxoForKeyParams{
+			TxID: key.TxID,
+			Vout: int32(key.VOut),
+		})
+		if err != nil {
+			return -1, err
+		}
+		if len(utxos) <= 0 {
+			continue
+		}
+
+		if err := querierWithTx.DeleteUtxoStatuses(ctx, utxos[0].ID); err != nil {
+			return -1, err
+		}
+		if err := querierWithTx.DeleteUtxo(ctx, utxos[0].ID); err != nil {
+			return -1, err
+		}
+		count++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return -1, err
+	}
+	return count, nil
+}
+
 func (u *utxoRepositoryPg) GetEventChannel() chan domain.UtxoEvent {
 	return u.externalChEvents
 }
@@ -517,7 +673,7 @@ func (u *utxoRepositoryPg) spendUtxo(
 		return false, nil, err
 	}
 
-	if err := u.updateUtxo(ctx, utxo); err != nil {
+	if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
 		return false, nil, err
 	}
 
@@ -571,7 +727,7 @@ func (u *utxoRepositoryPg) confirmSpendUtxo(
 		return false, nil, err
 	}
 
-	if err := u.updateUtxo(ctx, utxo); err != nil {
+	if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
 		return false, nil, err
 	}
 
@@ -580,9 +736,9 @@ func (u *utxoRepositoryPg) confirmSpendUtxo(
 }
 
 func (u *utxoRepositoryPg) updateUtxo(
-	ctx context.Context, utxo *domain.Utxo,
+	ctx context.Context, querier *queries.Queries, utxo *domain.Utxo,
 ) error {
-	ut, err := u.querier.UpdateUtxo(ctx, queries.UpdateUtxoParams{
+	ut, err := querier.UpdateUtxo(ctx, queries.UpdateUtxoParams{
 		Value:               int64(utxo.Value),
 		Asset:               utxo.Asset,
 		ValueCommitment:     utxo.ValueCommitment,
@@ -596,6 +752,9 @@ func (u *utxoRepositoryPg) updateUtxo(
 		AccountName:         utxo.AccountName,
 		LockTimestamp:       utxo.LockTimestamp,
 		LockExpiryTimestamp: utxo.LockExpiryTimestamp,
+		LeaseID:             utxo.LeaseID,
+		FrozenTimestamp:     utxo.FrozenTimestamp,
+		FreezeReason:        utxo.FreezeReason,
 		TxID:                utxo.TxID,
 		Vout:                int32(utxo.VOut),
 	})
@@ -603,12 +762,12 @@ func (u *utxoRepositoryPg) updateUtxo(
 		return err
 	}
 
-	if err := u.querier.DeleteUtxoStatuses(ctx, ut.ID); err != nil {
+	if err := querier.DeleteUtxoStatuses(ctx, ut.ID); err != nil {
 		return err
 	}
 
 	if utxo.IsSpent() {
-		if _, err := u.querier.InsertUtxoStatus(ctx, queries.InsertUtxoStatusParams{
+		if _, err := querier.InsertUtxoStatus(ctx, queries.InsertUtxoStatusParams{
 			BlockHeight: int32(utxo.SpentStatus.BlockHeight),
 			BlockTime:   utxo.SpentStatus.BlockTime,
 			BlockHash:   utxo.SpentStatus.BlockHash,
@@ -623,7 +782,7 @@ func (u *utxoRepositoryPg) updateUtxo(
 		}
 	}
 	if utxo.IsConfirmed() {
-		if _, err := u.querier.InsertUtxoStatus(ctx, queries.InsertUtxoStatusParams{
+		if _, err := querier.InsertUtxoStatus(ctx, queries.InsertUtxoStatusParams{
 			BlockHeight: int32(utxo.ConfirmedStatus.BlockHeight),
 			BlockTime:   utxo.ConfirmedStatus.BlockTime,
 			BlockHash:   utxo.ConfirmedStatus.BlockHash,
@@ -683,7 +842,66 @@ func (u *utxoRepositoryPg) confirmUtxo(
 	if err := utxo.Confirm(status); err != nil {
 		return false, nil, err
 	}
-	if err := u.updateUtxo(ctx, utxo); err != nil {
+	if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
+		return false, nil, err
+	}
+
+	utxoInfo := utxo.Info()
+	return true, &utxoInfo, nil
+}
+
+func (u *utxoRepositoryPg) unconfirmUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, spendOnly bool,
+) (int, error) {
+	count := 0
+	utxosInfo := make([]domain.UtxoInfo, 0)
+	for _, key := range utxoKeys {
+		done, info, err := u.unconfirmUtxo(ctx, key, spendOnly)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+			utxosInfo = append(utxosInfo, *info)
+		}
+	}
+
+	if count > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnconfirmed,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return count, nil
+}
+
+func (u *utxoRepositoryPg) unconfirmUtxo(
+	ctx context.Context, key domain.UtxoKey, spendOnly bool,
+) (bool, *domain.UtxoInfo, error) {
+	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
+	if err != nil {
+		return false, nil, err
+	}
+
+	if len(utxos) <= 0 {
+		return false, nil, nil
+	}
+
+	utxo := utxos[0]
+	if spendOnly {
+		if !utxo.IsConfirmedSpent() {
+			return false, nil, nil
+		}
+		utxo.UnconfirmSpend()
+	} else {
+		if !utxo.IsConfirmed() {
+			return false, nil, nil
+		}
+		utxo.Unconfirm()
+	}
+
+	if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
 		return false, nil, err
 	}
 
@@ -691,13 +909,47 @@ func (u *utxoRepositoryPg) confirmUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (u *utxoRepositoryPg) unspendUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	utxos, err := u.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.IsSpent() || utxo.IsConfirmedSpent() {
+			continue
+		}
+		utxo.Unspend()
+
+		if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnspent,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
 func (u *utxoRepositoryPg) lockUtxos(
-	ctx context.Context, utxoKeys []domain.UtxoKey, timestamp, expiryTimestamp int64,
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (int, error) {
 	count := 0
 	utxosInfo := make([]domain.UtxoInfo, 0)
 	for _, key := range utxoKeys {
-		done, info, err := u.lockUtxo(ctx, key, timestamp, expiryTimestamp)
+		done, info, err := u.lockUtxo(
+			ctx, key, leaseID, timestamp, expiryTimestamp,
+		)
 		if err != nil {
 			return -1, err
 		}
@@ -718,7 +970,8 @@ func (u *utxoRepositoryPg) lockUtxos(
 }
 
 func (u *utxoRepositoryPg) lockUtxo(
-	ctx context.Context, key domain.UtxoKey, timestamp, expiryTimestamp int64,
+	ctx context.Context,
+	key domain.UtxoKey, leaseID string, timestamp, expiryTimestamp int64,
 ) (bool, *domain.UtxoInfo, error) {
 	utxos, err := u.GetUtxosByKey(ctx, []domain.UtxoKey{key})
 	if err != nil {
@@ -730,12 +983,12 @@ func (u *utxoRepositoryPg) lockUtxo(
 	}
 
 	utxo := utxos[0]
-	if utxo.IsLocked() {
+	if utxo.IsLocked() || utxo.IsFrozen() {
 		return false, nil, nil
 	}
 
-	utxo.Lock(timestamp, expiryTimestamp)
-	if err := u.updateUtxo(ctx, utxo); err != nil {
+	utxo.Lock(leaseID, timestamp, expiryTimestamp)
+	if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
 		return false, nil, err
 	}
 
@@ -787,7 +1040,7 @@ func (u *utxoRepositoryPg) unlockUtxo(
 	}
 
 	utxo.Unlock()
-	if err := u.updateUtxo(ctx, utxo); err != nil {
+	if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
 		return false, nil, err
 	}
 
@@ -795,6 +1048,165 @@ func (u *utxoRepositoryPg) unlockUtxo(
 	return true, &utxoInfo, nil
 }
 
+func (u *utxoRepositoryPg) freezeUtxos(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, reason string, timestamp int64,
+) (int, error) {
+	utxos, err := u.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.IsFrozen() {
+			continue
+		}
+		if err := utxo.Freeze(reason, timestamp); err != nil {
+			return -1, err
+		}
+		if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoFrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (u *utxoRepositoryPg) unfreezeUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey,
+) (int, error) {
+	utxos, err := u.GetUtxosByKey(ctx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.IsFrozen() {
+			continue
+		}
+		utxo.Unfreeze()
+		if err := u.updateUtxo(ctx, u.querier, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if len(utxosInfo) > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnfrozen,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (u *utxoRepositoryPg) releaseUtxos(
+	ctx context.Context, utxoKeys []domain.UtxoKey, leaseID string,
+) (int, error) {
+	conn, err := u.pgxPool.Acquire(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := u.querier.WithTx(tx)
+
+	utxos, err := u.getUtxosByKey(ctx, querierWithTx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	utxosToRelease := make([]*domain.Utxo, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !utxo.IsLocked() {
+			continue
+		}
+		if err := utxo.Release(leaseID); err != nil {
+			return -1, err
+		}
+		utxosToRelease = append(utxosToRelease, utxo)
+	}
+
+	utxosInfo := make([]domain.UtxoInfo, 0, len(utxosToRelease))
+	for _, utxo := range utxosToRelease {
+		if err := u.updateUtxo(ctx, querierWithTx, utxo); err != nil {
+			return -1, err
+		}
+		utxosInfo = append(utxosInfo, utxo.Info())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return -1, err
+	}
+
+	if len(utxosInfo) > 0 {
+		go u.publishEvent(domain.UtxoEvent{
+			EventType: domain.UtxoUnlocked,
+			Utxos:     utxosInfo,
+		})
+	}
+
+	return len(utxosInfo), nil
+}
+
+func (u *utxoRepositoryPg) extendUtxosLease(
+	ctx context.Context,
+	utxoKeys []domain.UtxoKey, leaseID string, expiryTimestamp int64,
+) (int, error) {
+	conn, err := u.pgxPool.Acquire(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback(ctx)
+
+	querierWithTx := u.querier.WithTx(tx)
+
+	utxos, err := u.getUtxosByKey(ctx, querierWithTx, utxoKeys)
+	if err != nil {
+		return -1, err
+	}
+
+	for _, utxo := range utxos {
+		if err := utxo.ExtendLease(leaseID, expiryTimestamp); err != nil {
+			return -1, err
+		}
+	}
+	for _, utxo := range utxos {
+		if err := u.updateUtxo(ctx, querierWithTx, utxo); err != nil {
+			return -1, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return -1, err
+	}
+
+	return len(utxos), nil
+}
+
 func (u *utxoRepositoryPg) convertToUtxos(
 	utxos []queries.GetAllUtxosRow,
 ) (map[domain.UtxoKey]*domain.Utxo, error) {
@@ -822,6 +1234,9 @@ func (u *utxoRepositoryPg) convertToUtxos(
 				AccountName:         v.AccountName,
 				LockTimestamp:       v.LockTimestamp,
 				LockExpiryTimestamp: v.LockExpiryTimestamp,
+				LeaseID:             v.LeaseID,
+				FrozenTimestamp:     v.FrozenTimestamp,
+				FreezeReason:        v.FreezeReason,
 			}
 			utxosByKey[key] = utxo
 		}
@@ -870,6 +1285,9 @@ func toGetAllUtxosRow(v queries.GetUtxosForAccountRow) queries.GetAllUtxosRow {
 		AccountName:         v.AccountName,
 		LockTimestamp:       v.LockTimestamp,
 		LockExpiryTimestamp: v.LockExpiryTimestamp,
+		LeaseID:             v.LeaseID,
+		FrozenTimestamp:     v.FrozenTimestamp,
+		FreezeReason:        v.FreezeReason,
 		ID_2:                v.ID_2,
 		BlockHeight:         v.BlockHeight,
 		BlockTime:           v.BlockTime,
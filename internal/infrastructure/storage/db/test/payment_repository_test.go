@@ -0,0 +1,168 @@
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestPaymentRepository(t *testing.T) {
+	repositories, err := newPaymentRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testPaymentRepository(t, repo)
+		})
+	}
+}
+
+func testPaymentRepository(t *testing.T, repo domain.PaymentRepository) {
+	now := time.Now().Unix()
+	newPayments := []*domain.Payment{
+		{
+			ID:          randomHex(16),
+			AccountName: "test1",
+			Asset:       randomHex(32),
+			Amount:      randomValue(),
+			Script:      randomScript(),
+			BlindingKey: randomBytes(33),
+			Timestamp:   now,
+		},
+		{
+			ID:          randomHex(16),
+			AccountName: "test1",
+			Asset:       randomHex(32),
+			Amount:      randomValue(),
+			Script:      randomScript(),
+			Timestamp:   now + 1,
+		},
+		{
+			ID:          randomHex(16),
+			AccountName: "test1",
+			Asset:       randomHex(32),
+			Amount:      randomValue(),
+			Script:      randomScript(),
+			Timestamp:   now + 2,
+		},
+	}
+	txid := randomHex(32)
+
+	t.Run("add_payment", func(t *testing.T) {
+		for _, payment := range newPayments {
+			done, err := repo.AddPayment(ctx, payment)
+			require.NoError(t, err)
+			require.True(t, done)
+
+			done, err = repo.AddPayment(ctx, payment)
+			require.NoError(t, err)
+			require.False(t, done)
+		}
+	})
+
+	t.Run("get_queued_payments", func(t *testing.T) {
+		payments, err := repo.GetQueuedPayments(ctx)
+		require.NoError(t, err)
+		require.Len(t, payments, len(newPayments))
+		for i, payment := range payments {
+			expected := newPayments[i]
+			require.Equal(t, expected.ID, payment.ID)
+			require.Equal(t, expected.AccountName, payment.AccountName)
+			require.Equal(t, expected.Asset, payment.Asset)
+			require.Equal(t, expected.Amount, payment.Amount)
+			require.Equal(t, expected.Script, payment.Script)
+			require.Equal(t, expected.BlindingKey, payment.BlindingKey)
+			require.Equal(t, expected.Timestamp, payment.Timestamp)
+		}
+	})
+
+	t.Run("delete_payment", func(t *testing.T) {
+		id := newPayments[0].ID
+		done, err := repo.DeletePayment(ctx, id)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeletePayment(ctx, id)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		payments, err := repo.GetQueuedPayments(ctx)
+		require.NoError(t, err)
+		require.Len(t, payments, len(newPayments)-1)
+	})
+
+	t.Run("mark_payments", func(t *testing.T) {
+		sendingId, failedId := newPayments[1].ID, newPayments[2].ID
+		count, err := repo.MarkPaymentsSending(
+			ctx, []string{newPayments[0].ID, sendingId}, txid,
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		count, err = repo.MarkPaymentsFailed(ctx, []string{failedId}, "failed")
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		payments, err := repo.GetQueuedPayments(ctx)
+		require.NoError(t, err)
+		require.Len(t, payments, 2)
+		require.True(t, payments[0].IsSending())
+		require.Equal(t, txid, payments[0].TxID)
+		require.False(t, payments[0].IsFailed())
+		require.False(t, payments[1].IsSending())
+		require.True(t, payments[1].IsFailed())
+		require.Equal(t, "failed", payments[1].FailureReason)
+
+		count, err = repo.MarkPaymentsSending(ctx, []string{sendingId}, "")
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		payments, err = repo.GetQueuedPayments(ctx)
+		require.NoError(t, err)
+		require.False(t, payments[0].IsSending())
+	})
+
+	t.Run("flush_payments", func(t *testing.T) {
+		chEvents := repo.GetEventChannel()
+		ids := []string{newPayments[0].ID, newPayments[1].ID, newPayments[2].ID}
+
+		count, err := repo.FlushPayments(ctx, ids, txid)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		select {
+		case event := <-chEvents:
+			require.Equal(t, domain.PaymentsFlushed, event.EventType)
+			require.Equal(t, txid, event.TxID)
+			require.Len(t, event.Payments, 2)
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected payments flushed event")
+		}
+
+		payments, err := repo.GetQueuedPayments(ctx)
+		require.NoError(t, err)
+		require.Empty(t, payments)
+
+		count, err = repo.FlushPayments(ctx, ids, txid)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
+
+func newPaymentRepositories() (map[string]domain.PaymentRepository, error) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.PaymentRepository{
+		"inmemory": inmemoryRepoManager.PaymentRepository(),
+		"badger":   badgerRepoManager.PaymentRepository(),
+		"postgres": pgRepoManager.PaymentRepository(),
+	}, nil
+}
@@ -0,0 +1,226 @@
+package db_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestSpendingPolicyRepository(t *testing.T) {
+	repositories, err := newSpendingPolicyRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testSpendingPolicyRepository(t, repo)
+		})
+	}
+}
+
+func testSpendingPolicyRepository(
+	t *testing.T, repo domain.SpendingPolicyRepository,
+) {
+	now := time.Now().Unix()
+	asset := randomHex(32)
+	newPolicy := &domain.SpendingPolicy{
+		AccountName: "test1",
+		AssetLimits: []domain.AssetSpendingLimit{
+			{
+				Asset:             asset,
+				MaxAmountPerTx:    randomValue(),
+				MaxAmountPerDay:   randomValue(),
+				ApprovalThreshold: randomValue(),
+			},
+		},
+		AllowedScripts:    []string{randomHex(22)},
+		DeniedScripts:     []string{randomHex(22), randomHex(34)},
+		MaxFeeRate:        randomValue(),
+		MaxFeeAmount:      randomValue(),
+		RequiredApprovals: 2,
+		Approvers:         []string{randomHex(33), randomHex(33)},
+	}
+	newSpendings := []*domain.Spending{
+		{
+			ID:          randomHex(16),
+			AccountName: "test1",
+			Digest:      randomHex(32),
+			Amounts:     map[string]uint64{asset: randomValue()},
+			CreatedAt:   now - 1,
+			SignedAt:    now,
+		},
+		{
+			ID:          randomHex(16),
+			AccountName: "test1",
+			Digest:      randomHex(32),
+			Amounts:     map[string]uint64{asset: randomValue()},
+			CreatedAt:   now,
+		},
+	}
+	newViolation := &domain.PolicyViolation{
+		ID:          randomHex(16),
+		AccountName: "test1",
+		Rule:        domain.PolicyRuleMaxAmountPerTx,
+		Reason:      "amount exceeds limit",
+		Timestamp:   now,
+	}
+
+	t.Run("set_policy", func(t *testing.T) {
+		policy, err := repo.GetPolicy(ctx, newPolicy.AccountName)
+		require.ErrorIs(t, err, domain.ErrSpendingPolicyNotFound)
+		require.Nil(t, policy)
+
+		err = repo.SetPolicy(ctx, newPolicy)
+		require.NoError(t, err)
+
+		policy, err = repo.GetPolicy(ctx, newPolicy.AccountName)
+		require.NoError(t, err)
+		require.Exactly(t, *newPolicy, *policy)
+
+		updatedPolicy := *newPolicy
+		updatedPolicy.AssetLimits = nil
+		updatedPolicy.DeniedScripts = nil
+		updatedPolicy.MaxFeeRate = 0
+		err = repo.SetPolicy(ctx, &updatedPolicy)
+		require.NoError(t, err)
+
+		policy, err = repo.GetPolicy(ctx, newPolicy.AccountName)
+		require.NoError(t, err)
+		require.Empty(t, policy.AssetLimits)
+		require.Empty(t, policy.DeniedScripts)
+		require.Equal(t, newPolicy.AllowedScripts, policy.AllowedScripts)
+		require.Zero(t, policy.MaxFeeRate)
+	})
+
+	t.Run("delete_policy", func(t *testing.T) {
+		done, err := repo.DeletePolicy(ctx, newPolicy.AccountName)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeletePolicy(ctx, newPolicy.AccountName)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		policy, err := repo.GetPolicy(ctx, newPolicy.AccountName)
+		require.ErrorIs(t, err, domain.ErrSpendingPolicyNotFound)
+		require.Nil(t, policy)
+	})
+
+	t.Run("add_spending", func(t *testing.T) {
+		for _, spending := range newSpendings {
+			done, err := repo.AddSpending(ctx, spending)
+			require.NoError(t, err)
+			require.True(t, done)
+
+			done, err = repo.AddSpending(ctx, spending)
+			require.NoError(t, err)
+			require.False(t, done)
+		}
+
+		spending, err := repo.GetSpending(ctx, randomHex(16))
+		require.ErrorIs(t, err, domain.ErrSpendingNotFound)
+		require.Nil(t, spending)
+	})
+
+	t.Run("get_spendings", func(t *testing.T) {
+		spendings, err := repo.GetSpendingsForAccount(ctx, "test1", now-1)
+		require.NoError(t, err)
+		require.Len(t, spendings, len(newSpendings))
+		for i, spending := range spendings {
+			expected := newSpendings[i]
+			require.Equal(t, expected.ID, spending.ID)
+			require.Equal(t, expected.Digest, spending.Digest)
+			require.Equal(t, expected.Amounts, spending.Amounts)
+			require.Equal(t, expected.CreatedAt, spending.CreatedAt)
+			require.Equal(t, expected.SignedAt, spending.SignedAt)
+		}
+
+		spendings, err = repo.GetSpendingsForAccount(ctx, "test1", now)
+		require.NoError(t, err)
+		require.Len(t, spendings, 1)
+
+		spendings, err = repo.GetSpendingsForAccount(ctx, "test2", 0)
+		require.NoError(t, err)
+		require.Empty(t, spendings)
+	})
+
+	t.Run("update_spending", func(t *testing.T) {
+		id := newSpendings[1].ID
+		err := repo.UpdateSpending(
+			ctx, id, func(s *domain.Spending) (*domain.Spending, error) {
+				if err := s.Approve("alice"); err != nil {
+					return nil, err
+				}
+				s.Sign()
+				return s, nil
+			},
+		)
+		require.NoError(t, err)
+
+		spending, err := repo.GetSpending(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"alice"}, spending.Approvers)
+		require.True(t, spending.IsSigned())
+		require.Equal(t, newSpendings[1].Amounts, spending.Amounts)
+
+		err = repo.UpdateSpending(
+			ctx, id, func(s *domain.Spending) (*domain.Spending, error) {
+				if err := s.Approve("bob"); err != nil {
+					return nil, err
+				}
+				return s, nil
+			},
+		)
+		require.ErrorIs(t, err, domain.ErrSpendingAlreadySigned)
+
+		err = repo.UpdateSpending(
+			ctx, randomHex(16), func(s *domain.Spending) (*domain.Spending, error) {
+				return s, nil
+			},
+		)
+		require.ErrorIs(t, err, domain.ErrSpendingNotFound)
+	})
+
+	t.Run("add_violation", func(t *testing.T) {
+		chEvents := repo.GetEventChannel()
+
+		err := repo.AddViolation(ctx, newViolation)
+		require.NoError(t, err)
+
+		select {
+		case event := <-chEvents:
+			require.Equal(t, domain.PolicyViolated, event.EventType)
+			require.Exactly(t, *newViolation, event.Violation)
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected policy violated event")
+		}
+
+		violations, err := repo.GetViolationsForAccount(ctx, "test1")
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		require.Exactly(t, *newViolation, *violations[0])
+
+		violations, err = repo.GetViolationsForAccount(ctx, "test2")
+		require.NoError(t, err)
+		require.Empty(t, violations)
+	})
+}
+
+func newSpendingPolicyRepositories() (
+	map[string]domain.SpendingPolicyRepository, error,
+) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.SpendingPolicyRepository{
+		"inmemory": inmemoryRepoManager.SpendingPolicyRepository(),
+		"badger":   badgerRepoManager.SpendingPolicyRepository(),
+		"postgres": pgRepoManager.SpendingPolicyRepository(),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestSwapRepository(t *testing.T) {
+	repositories, err := newSwapRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testSwapRepository(t, repo)
+		})
+	}
+}
+
+func testSwapRepository(t *testing.T, repo domain.SwapRepository) {
+	newSwap := &domain.Swap{
+		ID:          randomHex(32),
+		AccountName: "test1",
+		GiveAsset:   randomHex(32),
+		GiveAmount:  randomValue(),
+		WantAsset:   randomHex(32),
+		WantAmount:  randomValue(),
+		FeeAmount:   randomValue(),
+	}
+	id := newSwap.ID
+	wrongID := randomHex(32)
+
+	t.Run("add_swap", func(t *testing.T) {
+		done, err := repo.AddSwap(ctx, newSwap)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddSwap(ctx, newSwap)
+		require.NoError(t, err)
+		require.False(t, done)
+	})
+
+	t.Run("get_swap", func(t *testing.T) {
+		swap, err := repo.GetSwap(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, swap)
+		require.Exactly(t, *newSwap, *swap)
+
+		swap, err = repo.GetSwap(ctx, wrongID)
+		require.Error(t, err)
+		require.Nil(t, swap)
+	})
+
+	t.Run("delete_swap", func(t *testing.T) {
+		done, err := repo.DeleteSwap(ctx, id)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeleteSwap(ctx, id)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		swap, err := repo.GetSwap(ctx, id)
+		require.Error(t, err)
+		require.Nil(t, swap)
+	})
+}
+
+func newSwapRepositories() (map[string]domain.SwapRepository, error) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.SwapRepository{
+		"inmemory": inmemoryRepoManager.SwapRepository(),
+		"badger":   badgerRepoManager.SwapRepository(),
+		"postgres": pgRepoManager.SwapRepository(),
+	}, nil
+}
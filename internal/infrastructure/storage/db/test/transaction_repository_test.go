@@ -60,6 +60,60 @@ func testTransactionRepository(t *testing.T, repo domain.TransactionRepository)
 		require.Nil(t, tx)
 	})
 
+	t.Run("get_transactions_for_account", func(t *testing.T) {
+		txs, err := repo.GetTransactionsForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Exactly(t, *newTx, *txs[0])
+
+		txs, err = repo.GetTransactionsForAccount(ctx, "test2")
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	})
+
+	t.Run("get_transactions_for_account_page", func(t *testing.T) {
+		pagedAccount := "test3"
+		unconfirmedTx := randomTx(pagedAccount)
+		lowerTx := randomTx(pagedAccount)
+		higherTx := randomTx(pagedAccount)
+		for _, tx := range []*domain.Transaction{
+			unconfirmedTx, higherTx, lowerTx,
+		} {
+			_, err := repo.AddTransaction(ctx, tx)
+			require.NoError(t, err)
+		}
+		_, err := repo.ConfirmTransaction(
+			ctx, higherTx.TxID, randomHex(32), 200, time.Now().Unix(),
+		)
+		require.NoError(t, err)
+		_, err = repo.ConfirmTransaction(
+			ctx, lowerTx.TxID, randomHex(32), 100, time.Now().Unix(),
+		)
+		require.NoError(t, err)
+
+		txs, err := repo.GetTransactionsForAccountPage(ctx, pagedAccount, 0, 2)
+		require.NoError(t, err)
+		require.Len(t, txs, 2)
+		require.Equal(t, lowerTx.TxID, txs[0].TxID)
+		require.Equal(t, higherTx.TxID, txs[1].TxID)
+
+		txs, err = repo.GetTransactionsForAccountPage(ctx, pagedAccount, 2, 2)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, unconfirmedTx.TxID, txs[0].TxID)
+
+		txs, err = repo.GetTransactionsForAccountPage(ctx, pagedAccount, 3, 2)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+
+		for _, tx := range []*domain.Transaction{
+			unconfirmedTx, higherTx, lowerTx,
+		} {
+			_, err := repo.DeleteTransaction(ctx, tx.TxID)
+			require.NoError(t, err)
+		}
+	})
+
 	t.Run("confirm_transaction", func(t *testing.T) {
 		blockHash := randomHex(32)
 		blockHeight := uint64(randomIntInRange(100, 1000))
@@ -79,6 +133,21 @@ func testTransactionRepository(t *testing.T, repo domain.TransactionRepository)
 		require.True(t, tx.IsConfirmed())
 	})
 
+	t.Run("unconfirm_transaction", func(t *testing.T) {
+		done, err := repo.UnconfirmTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.UnconfirmTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		tx, err := repo.GetTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		require.False(t, tx.IsConfirmed())
+	})
+
 	t.Run("update_transaction", func(t *testing.T) {
 		tx, err := repo.GetTransaction(ctx, txid)
 		require.NoError(t, err)
@@ -87,11 +156,17 @@ func testTransactionRepository(t *testing.T, repo domain.TransactionRepository)
 
 		err = repo.UpdateTransaction(
 			ctx, txid, func(tx *domain.Transaction) (*domain.Transaction, error) {
+				tx.Internal = true
 				return tx, nil
 			},
 		)
 		require.NoError(t, err)
 
+		tx, err = repo.GetTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		require.True(t, tx.Internal)
+
 		err = repo.UpdateTransaction(
 			ctx, txid, func(tx *domain.Transaction) (*domain.Transaction, error) {
 				return nil, errSomethingWentWrong
@@ -99,6 +174,20 @@ func testTransactionRepository(t *testing.T, repo domain.TransactionRepository)
 		)
 		require.EqualError(t, errSomethingWentWrong, err.Error())
 	})
+
+	t.Run("delete_transaction", func(t *testing.T) {
+		done, err := repo.DeleteTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeleteTransaction(ctx, txid)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		tx, err := repo.GetTransaction(ctx, txid)
+		require.Error(t, err)
+		require.Nil(t, tx)
+	})
 }
 
 func newTransactionRepositories(
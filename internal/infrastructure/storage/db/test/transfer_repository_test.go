@@ -0,0 +1,101 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/ocean/internal/core/domain"
+	dbbadger "github.com/vulpemventures/ocean/internal/infrastructure/storage/db/badger"
+	"github.com/vulpemventures/ocean/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestTransferRepository(t *testing.T) {
+	repositories, err := newTransferRepositories()
+	require.NoError(t, err)
+
+	for name, repo := range repositories {
+		t.Run(name, func(t *testing.T) {
+			testTransferRepository(t, repo)
+		})
+	}
+}
+
+func testTransferRepository(t *testing.T, repo domain.TransferRepository) {
+	newTransfer := &domain.Transfer{
+		TxID:        randomHex(32),
+		AccountName: "test1",
+		Receivers: []domain.TransferReceiver{
+			{
+				Asset:       randomHex(32),
+				Amount:      randomValue(),
+				Script:      randomScript(),
+				BlindingKey: randomBytes(33),
+			},
+			{
+				Asset:  randomHex(32),
+				Amount: randomValue(),
+				Script: randomScript(),
+			},
+		},
+	}
+	txid := newTransfer.TxID
+	wrongTxid := randomHex(32)
+
+	t.Run("add_transfer", func(t *testing.T) {
+		done, err := repo.AddTransfer(ctx, newTransfer)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.AddTransfer(ctx, newTransfer)
+		require.NoError(t, err)
+		require.False(t, done)
+	})
+
+	t.Run("get_transfer", func(t *testing.T) {
+		transfer, err := repo.GetTransfer(ctx, txid)
+		require.NoError(t, err)
+		require.NotNil(t, transfer)
+		require.Equal(t, newTransfer.TxID, transfer.TxID)
+		require.Equal(t, newTransfer.AccountName, transfer.AccountName)
+		require.Len(t, transfer.Receivers, len(newTransfer.Receivers))
+		for i, receiver := range transfer.Receivers {
+			expected := newTransfer.Receivers[i]
+			require.Equal(t, expected.Asset, receiver.Asset)
+			require.Equal(t, expected.Amount, receiver.Amount)
+			require.Equal(t, expected.Script, receiver.Script)
+			require.Equal(t, expected.BlindingKey, receiver.BlindingKey)
+		}
+
+		transfer, err = repo.GetTransfer(ctx, wrongTxid)
+		require.Error(t, err)
+		require.Nil(t, transfer)
+	})
+
+	t.Run("delete_transfer", func(t *testing.T) {
+		done, err := repo.DeleteTransfer(ctx, txid)
+		require.NoError(t, err)
+		require.True(t, done)
+
+		done, err = repo.DeleteTransfer(ctx, txid)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		transfer, err := repo.GetTransfer(ctx, txid)
+		require.Error(t, err)
+		require.Nil(t, transfer)
+	})
+}
+
+func newTransferRepositories() (map[string]domain.TransferRepository, error) {
+	inmemoryRepoManager := inmemory.NewRepoManager()
+	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]domain.TransferRepository{
+		"inmemory": inmemoryRepoManager.TransferRepository(),
+		"badger":   badgerRepoManager.TransferRepository(),
+		"postgres": pgRepoManager.TransferRepository(),
+	}, nil
+}
@@ -19,6 +19,7 @@ var (
 	utxoKeys         []domain.UtxoKey
 	balanceByAsset   map[string]*domain.Balance
 	txid             = hex.EncodeToString(make([]byte, 32))
+	leaseID          = "lease"
 )
 
 func TestUtxoRepository(t *testing.T) {
@@ -50,9 +51,19 @@ func testUtxoRepository(t *testing.T, repo domain.UtxoRepository) {
 
 	testUnlockUtxos(t, repo)
 
+	testReleaseUtxos(t, repo)
+
+	testFreezeUtxos(t, repo)
+
 	testSpendUtxos(t, repo)
 
 	testConfirmSpentUtxos(t, repo)
+
+	testUnconfirmUtxos(t, repo)
+
+	testUnspendUtxos(t, repo)
+
+	testDeleteUtxos(t, repo)
 }
 
 func testAddAndGetUtxos(t *testing.T, repo domain.UtxoRepository) {
@@ -158,11 +169,11 @@ func testConfirmUtxos(t *testing.T, repo domain.UtxoRepository) {
 
 func testLockUtxos(t *testing.T, repo domain.UtxoRepository) {
 	t.Run("lock_utxos", func(t *testing.T) {
-		count, err := repo.LockUtxos(ctx, utxoKeys, time.Now().Unix(), 0)
+		count, err := repo.LockUtxos(ctx, utxoKeys, leaseID, time.Now().Unix(), 0)
 		require.NoError(t, err)
 		require.Equal(t, len(newUtxos), count)
 
-		count, err = repo.LockUtxos(ctx, utxoKeys, time.Now().Unix(), 0)
+		count, err = repo.LockUtxos(ctx, utxoKeys, leaseID, time.Now().Unix(), 0)
 		require.NoError(t, err)
 		require.Zero(t, count)
 
@@ -224,6 +235,141 @@ func testUnlockUtxos(t *testing.T, repo domain.UtxoRepository) {
 	})
 }
 
+func testReleaseUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("release_utxos", func(t *testing.T) {
+		now := time.Now()
+		expiry := now.Add(time.Minute).Unix()
+		count, err := repo.LockUtxos(ctx, utxoKeys, leaseID, now.Unix(), expiry)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		utxos, err := repo.GetUtxosForLease(ctx, leaseID)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+
+		utxos, err = repo.GetUtxosForLease(ctx, "other lease")
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		// Lock is not expired, utxos can't be unlocked.
+		count, err = repo.UnlockUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		utxos, err = repo.GetLockedUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+
+		newExpiry := now.Add(2 * time.Minute).Unix()
+		count, err = repo.ExtendUtxosLease(ctx, utxoKeys, "other lease", newExpiry)
+		require.ErrorIs(t, err, domain.ErrUtxoLeaseMismatch)
+		require.Equal(t, -1, count)
+
+		count, err = repo.ExtendUtxosLease(ctx, utxoKeys, leaseID, newExpiry)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		utxos, err = repo.GetUtxosForLease(ctx, leaseID)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.Equal(t, newExpiry, u.LockExpiryTimestamp)
+		}
+
+		count, err = repo.ReleaseUtxos(ctx, utxoKeys, "other lease")
+		require.ErrorIs(t, err, domain.ErrUtxoLeaseMismatch)
+		require.Equal(t, -1, count)
+
+		utxos, err = repo.GetLockedUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+
+		count, err = repo.ReleaseUtxos(ctx, utxoKeys, leaseID)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.ReleaseUtxos(ctx, utxoKeys, leaseID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err = repo.GetLockedUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxos, err = repo.GetUtxosForLease(ctx, leaseID)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		count, err = repo.ExtendUtxosLease(ctx, utxoKeys, leaseID, newExpiry)
+		require.ErrorIs(t, err, domain.ErrUtxoNotLocked)
+		require.Equal(t, -1, count)
+	})
+}
+
+func testFreezeUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("freeze_utxos", func(t *testing.T) {
+		count, err := repo.FreezeUtxos(ctx, utxoKeys, "", time.Now().Unix())
+		require.Error(t, err)
+		require.Equal(t, -1, count)
+
+		count, err = repo.FreezeUtxos(ctx, utxoKeys, "dust", time.Now().Unix())
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.FreezeUtxos(ctx, utxoKeys, "dust", time.Now().Unix())
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetFrozenUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+		for _, u := range utxos {
+			require.Equal(t, "dust", u.FreezeReason)
+		}
+
+		utxos, err = repo.GetSpendableUtxos(ctx)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxos, err = repo.GetSpendableUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		// Frozen utxos can't be locked.
+		count, err = repo.LockUtxos(ctx, utxoKeys, leaseID, time.Now().Unix(), 0)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err = repo.GetLockedUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxoBalance, err := repo.GetBalanceForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.NotNil(t, utxoBalance)
+		for asset, balance := range utxoBalance {
+			prevBalance := balanceByAsset[asset]
+			require.Zero(t, balance.Confirmed)
+			require.Zero(t, balance.Unconfirmed)
+			require.Zero(t, balance.Locked)
+			require.Equal(t, prevBalance.Unconfirmed, balance.Frozen)
+		}
+
+		count, err = repo.UnfreezeUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.UnfreezeUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err = repo.GetFrozenUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Empty(t, utxos)
+
+		utxos, err = repo.GetSpendableUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos))
+	})
+}
+
 func testSpendUtxos(t *testing.T, repo domain.UtxoRepository) {
 	t.Run("spend_utxos", func(t *testing.T) {
 		count, err := repo.SpendUtxos(ctx, utxoKeys, txid)
@@ -278,6 +424,77 @@ func testConfirmSpentUtxos(t *testing.T, repo domain.UtxoRepository) {
 	})
 }
 
+func testUnconfirmUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("unconfirm_spent_utxos", func(t *testing.T) {
+		count, err := repo.UnconfirmSpendUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.UnconfirmSpendUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetUtxosByKey(ctx, utxoKeys)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.True(t, u.IsConfirmed())
+			require.True(t, u.IsSpent())
+			require.False(t, u.IsConfirmedSpent())
+		}
+	})
+
+	t.Run("unconfirm_utxos", func(t *testing.T) {
+		count, err := repo.UnconfirmUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.UnconfirmUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetUtxosByKey(ctx, utxoKeys)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.False(t, u.IsConfirmed())
+			require.True(t, u.IsSpent())
+		}
+	})
+}
+
+func testUnspendUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("unspend_utxos", func(t *testing.T) {
+		count, err := repo.UnspendUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Equal(t, len(newUtxos), count)
+
+		count, err = repo.UnspendUtxos(ctx, utxoKeys)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetUtxosByKey(ctx, utxoKeys)
+		require.NoError(t, err)
+		for _, u := range utxos {
+			require.False(t, u.IsSpent())
+		}
+	})
+}
+
+func testDeleteUtxos(t *testing.T, repo domain.UtxoRepository) {
+	t.Run("delete_utxos", func(t *testing.T) {
+		count, err := repo.DeleteUtxos(ctx, utxoKeys[:1])
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		count, err = repo.DeleteUtxos(ctx, utxoKeys[:1])
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		utxos, err := repo.GetAllUtxosForAccount(ctx, accountName)
+		require.NoError(t, err)
+		require.Len(t, utxos, len(newUtxos)-1)
+	})
+}
+
 func newUtxoRepositories(handlerFactory func(repoType string) ports.UtxoEventHandler) (map[string]domain.UtxoRepository, error) {
 	inmemoryRepoManager := inmemory.NewRepoManager()
 	badgerRepoManager, err := dbbadger.NewRepoManager("", nil)
@@ -298,6 +515,7 @@ func newUtxoRepositories(handlerFactory func(repoType string) ports.UtxoEventHan
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoUnlocked, handler)
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoSpent, handler)
 		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoConfirmedSpend, handler)
+		repoManager.RegisterHandlerForUtxoEvent(domain.UtxoUnconfirmed, handler)
 	}
 	return map[string]domain.UtxoRepository{
 		"inmemory": inmemoryRepoManager.UtxoRepository(),
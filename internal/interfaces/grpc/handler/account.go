@@ -150,7 +150,9 @@ func (a *account) Balance(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	balanceInfo, err := a.appSvc.GetBalanceForAccount(ctx, name)
+	balanceInfo, err := a.appSvc.GetBalanceForAccount(
+		ctx, name, req.MinConfirmations,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +163,7 @@ func (a *account) Balance(
 			UnconfirmedBalance: b.Unconfirmed,
 			LockedBalance:      b.Locked,
 			TotalBalance:       b.Total(),
+			FrozenBalance:      b.Frozen,
 		}
 	}
 	return &pb.BalanceResponse{Balance: balance}, nil
@@ -180,6 +183,7 @@ func (a *account) ListUtxos(
 	}
 	spendableUtxos := parseUtxos(utxosInfo.Spendable.Info())
 	lockedUtxos := parseUtxos(utxosInfo.Locked.Info())
+	frozenUtxos := parseUtxos(utxosInfo.Frozen.Info())
 	return &pb.ListUtxosResponse{
 		SpendableUtxos: &pb.Utxos{
 			AccountName: name,
@@ -189,9 +193,82 @@ func (a *account) ListUtxos(
 			AccountName: name,
 			Utxos:       lockedUtxos,
 		},
+		FrozenUtxos: &pb.Utxos{
+			AccountName: name,
+			Utxos:       frozenUtxos,
+		},
 	}, nil
 }
 
+func (a *account) FreezeUtxos(
+	ctx context.Context, req *pb.FreezeUtxosRequest,
+) (*pb.FreezeUtxosResponse, error) {
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	inputs, err := parseInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	reason, err := parseFreezeReason(req.GetReason())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := a.appSvc.FreezeUtxos(
+		ctx, name, application.Inputs(inputs).Keys(), reason,
+	); err != nil {
+		return nil, err
+	}
+	return &pb.FreezeUtxosResponse{}, nil
+}
+
+func (a *account) UnfreezeUtxos(
+	ctx context.Context, req *pb.UnfreezeUtxosRequest,
+) (*pb.UnfreezeUtxosResponse, error) {
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	inputs, err := parseInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := a.appSvc.UnfreezeUtxos(
+		ctx, name, application.Inputs(inputs).Keys(),
+	); err != nil {
+		return nil, err
+	}
+	return &pb.UnfreezeUtxosResponse{}, nil
+}
+
+func (a *account) ExportHistory(
+	req *pb.ExportHistoryRequest, stream pb.AccountService_ExportHistoryServer,
+) error {
+	name, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return a.appSvc.ExportHistoryForAccount(
+		stream.Context(), name, func(entry application.HistoryEntry) error {
+			return stream.Send(&pb.ExportHistoryResponse{
+				Timestamp:           entry.Timestamp,
+				Txid:                entry.TxID,
+				BlockHeight:         entry.BlockHeight,
+				Asset:               entry.Asset,
+				Amount:              entry.Amount,
+				Fee:                 entry.Fee,
+				Label:               entry.Label,
+				CounterpartyAddress: entry.Counterparty,
+				Internal:            entry.Internal,
+			})
+		},
+	)
+}
+
 func (a *account) DeleteAccount(
 	ctx context.Context, req *pb.DeleteAccountRequest,
 ) (*pb.DeleteAccountResponse, error) {
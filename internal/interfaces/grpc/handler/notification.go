@@ -86,6 +86,61 @@ func (n notification) UtxosNotifications(
 	}
 }
 
+func (n notification) PaymentNotifications(
+	req *pb.PaymentNotificationsRequest,
+	stream pb.NotificationService_PaymentNotificationsServer,
+) error {
+	chPaymentEvents, err := n.appSvc.GetPaymentChannel(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case e := <-chPaymentEvents:
+			ids := make([]string, 0, len(e.Payments))
+			for _, p := range e.Payments {
+				ids = append(ids, p.ID)
+			}
+			if err := stream.Send(&pb.PaymentNotificationsResponse{
+				PaymentIds: ids,
+				Txid:       e.TxID,
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-n.chClose:
+			return ErrStreamConnectionClosed
+		}
+	}
+}
+
+func (n notification) PolicyViolationNotifications(
+	req *pb.PolicyViolationNotificationsRequest,
+	stream pb.NotificationService_PolicyViolationNotificationsServer,
+) error {
+	chPolicyEvents, err := n.appSvc.GetPolicyChannel(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case e := <-chPolicyEvents:
+			if err := stream.Send(&pb.PolicyViolationNotificationsResponse{
+				Violation: parsePolicyViolation(e.Violation),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-n.chClose:
+			return ErrStreamConnectionClosed
+		}
+	}
+}
+
 func (n notification) WatchExternalScript(
 	ctx context.Context, req *pb.WatchExternalScriptRequest,
 ) (*pb.WatchExternalScriptResponse, error) {
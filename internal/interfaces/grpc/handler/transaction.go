@@ -3,6 +3,7 @@ package grpc_handler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pb "github.com/vulpemventures/ocean/api-spec/protobuf/gen/go/ocean/v1"
 	"github.com/vulpemventures/ocean/internal/core/application"
@@ -56,8 +57,9 @@ func (t *transaction) SelectUtxos(
 	}
 	strategy := parseCoinSelectionStrategy(req.GetStrategy())
 
-	utxos, change, expirationDate, err := t.appSvc.SelectUtxos(
+	utxos, change, lease, err := t.appSvc.SelectUtxos(
 		ctx, accountName, targetAsset, targetAmount, strategy,
+		req.MinConfirmations,
 	)
 	if err != nil {
 		return nil, err
@@ -65,7 +67,8 @@ func (t *transaction) SelectUtxos(
 	return &pb.SelectUtxosResponse{
 		Utxos:          parseUtxos(utxos.Info()),
 		Change:         change,
-		ExpirationDate: expirationDate,
+		ExpirationDate: lease.ExpiryTimestamp,
+		LeaseId:        lease.ID,
 	}, nil
 }
 
@@ -80,12 +83,59 @@ func (t *transaction) LockUtxos(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	expirationDate, err := t.appSvc.LockUtxos(ctx, accountName, inputs)
+	lease, err := t.appSvc.LockUtxos(ctx, accountName, inputs)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.LockUtxosResponse{
-		ExpirationDate: expirationDate,
+		ExpirationDate: lease.ExpiryTimestamp,
+		LeaseId:        lease.ID,
+	}, nil
+}
+
+func (t *transaction) UnlockUtxos(
+	ctx context.Context, req *pb.UnlockUtxosRequest,
+) (*pb.UnlockUtxosResponse, error) {
+	leaseID, err := parseLeaseID(req.GetLeaseId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	inputs, err := parseInputs(req.GetUtxos())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := t.appSvc.UnlockUtxos(ctx, leaseID, inputs); err != nil {
+		return nil, err
+	}
+	return &pb.UnlockUtxosResponse{}, nil
+}
+
+func (t *transaction) ExtendLease(
+	ctx context.Context, req *pb.ExtendLeaseRequest,
+) (*pb.ExtendLeaseResponse, error) {
+	leaseID, err := parseLeaseID(req.GetLeaseId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	duration := time.Duration(req.GetDuration()) * time.Second
+	lease, err := t.appSvc.ExtendLease(ctx, leaseID, duration)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExtendLeaseResponse{
+		ExpirationDate: lease.ExpiryTimestamp,
+	}, nil
+}
+
+func (t *transaction) ListLeases(
+	ctx context.Context, req *pb.ListLeasesRequest,
+) (*pb.ListLeasesResponse, error) {
+	leases, err := t.appSvc.ListLeases(ctx, req.GetAccountName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListLeasesResponse{
+		Leases: parseLeases(leases),
 	}, nil
 }
 
@@ -115,6 +165,17 @@ func (t *transaction) EstimateFees(
 	return &pb.EstimateFeesResponse{FeeAmount: uint64(feeAmount)}, nil
 }
 
+func (t *transaction) EstimateFeeRate(
+	ctx context.Context, req *pb.EstimateFeeRateRequest,
+) (*pb.EstimateFeeRateResponse, error) {
+	feeRate, err := t.appSvc.EstimateFeeRate(ctx, req.GetConfTarget())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EstimateFeeRateResponse{MillisatsPerByte: feeRate}, nil
+}
+
 func (t *transaction) SignTransaction(
 	ctx context.Context, req *pb.SignTransactionRequest,
 ) (*pb.SignTransactionResponse, error) {
@@ -123,7 +184,9 @@ func (t *transaction) SignTransaction(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	signedTx, err := t.appSvc.SignTransaction(ctx, txHex, req.GetSighashType())
+	signedTx, err := t.appSvc.SignTransaction(
+		ctx, txHex, req.GetSighashType(), req.GetOverrideFeeGuard(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +222,7 @@ func (t *transaction) CreatePset(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ptx, err := t.appSvc.CreatePset(ctx, inputs, outputs)
+	ptx, err := t.appSvc.CreatePset(ctx, inputs, outputs, req.GetLocktime())
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +245,9 @@ func (t *transaction) UpdatePset(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	updatedPtx, err := t.appSvc.UpdatePset(ctx, ptx, inputs, outputs)
+	updatedPtx, err := t.appSvc.UpdatePset(
+		ctx, ptx, inputs, outputs, req.GetLocktime(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +285,9 @@ func (t *transaction) SignPset(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	signedPtx, err := t.appSvc.SignPset(ctx, ptx, req.GetSighashType())
+	signedPtx, err := t.appSvc.SignPset(
+		ctx, ptx, req.GetSighashType(), req.GetOverrideFeeGuard(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +295,54 @@ func (t *transaction) SignPset(
 	return &pb.SignPsetResponse{Pset: signedPtx}, nil
 }
 
+func (t *transaction) DecodePset(
+	ctx context.Context, req *pb.DecodePsetRequest,
+) (*pb.DecodePsetResponse, error) {
+	ptx, err := parsePset(req.GetPset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decodedPtx, err := t.appSvc.DecodePset(ctx, ptx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDecodedPset(decodedPtx), nil
+}
+
+func (t *transaction) CombinePsets(
+	ctx context.Context, req *pb.CombinePsetsRequest,
+) (*pb.CombinePsetsResponse, error) {
+	psets, err := parsePsets(req.GetPsets())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ptx, err := t.appSvc.CombinePsets(ctx, psets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CombinePsetsResponse{Pset: ptx}, nil
+}
+
+func (t *transaction) FinalizePset(
+	ctx context.Context, req *pb.FinalizePsetRequest,
+) (*pb.FinalizePsetResponse, error) {
+	ptx, err := parsePset(req.GetPset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txHex, txid, err := t.appSvc.FinalizePset(ctx, ptx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FinalizePsetResponse{TxHex: txHex, Txid: txid}, nil
+}
+
 func (t *transaction) Mint(
 	ctx context.Context, req *pb.MintRequest,
 ) (*pb.MintResponse, error) {
@@ -261,8 +376,49 @@ func (t *transaction) Transfer(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	inputs, err := parseInputs(req.GetInputs())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	txHex, err := t.appSvc.Transfer(ctx, accountName, outputs, millisatsPerByte)
+	opts := application.TransferOptions{
+		Inputs:           inputs,
+		AllowExtraInputs: req.GetAllowExtraInputs(),
+		SendAll:          req.GetSendAll(),
+		SubtractFee:      req.GetSubtractFee(),
+		LeaseID:          req.GetLeaseId(),
+		LockTime:         req.GetLocktime(),
+		OverrideFeeGuard: req.GetOverrideFeeGuard(),
+	}
+
+	if req.GetDryRun() {
+		preview, err := t.appSvc.PreviewTransfer(
+			ctx, accountName, outputs, millisatsPerByte,
+			req.MinConfirmations, opts, req.GetReserve(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var reservation *pb.Lease
+		if preview.Reservation != nil {
+			reservation = parseLeases(
+				[]application.Lease{*preview.Reservation},
+			)[0]
+		}
+		return &pb.TransferResponse{
+			Pset:          preview.Pset,
+			Inputs:        parseUtxos(preview.Inputs.Info()),
+			ChangeOutputs: parseChangeOutputs(preview.ChangeOutputs),
+			FeeAmount:     preview.FeeAmount,
+			Reservation:   reservation,
+		}, nil
+	}
+
+	txHex, err := t.appSvc.Transfer(
+		ctx, accountName, outputs, millisatsPerByte, req.MinConfirmations,
+		opts,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +426,452 @@ func (t *transaction) Transfer(
 	return &pb.TransferResponse{TxHex: txHex}, nil
 }
 
+func (t *transaction) BumpFee(
+	ctx context.Context, req *pb.BumpFeeRequest,
+) (*pb.BumpFeeResponse, error) {
+	txid := req.GetTxid()
+	if err := validateTxid(txid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	newTxid, err := t.appSvc.BumpFee(ctx, txid, millisatsPerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BumpFeeResponse{Txid: newTxid}, nil
+}
+
+func (t *transaction) Accelerate(
+	ctx context.Context, req *pb.AccelerateRequest,
+) (*pb.AccelerateResponse, error) {
+	txid := req.GetTxid()
+	if err := validateTxid(txid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	childTxid, err := t.appSvc.Accelerate(ctx, txid, millisatsPerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AccelerateResponse{Txid: childTxid}, nil
+}
+
+func (t *transaction) MoveFunds(
+	ctx context.Context, req *pb.MoveFundsRequest,
+) (*pb.MoveFundsResponse, error) {
+	fromAccount, err := parseAccountName(req.GetFromAccount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	toAccount, err := parseAccountName(req.GetToAccount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	asset, err := parseAsset(req.GetAsset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	amount, err := parseAmount(req.GetAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txid, err := t.appSvc.MoveFunds(
+		ctx, fromAccount, toAccount, asset, amount, millisatsPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveFundsResponse{Txid: txid}, nil
+}
+
+func (t *transaction) FragmentUtxos(
+	ctx context.Context, req *pb.FragmentUtxosRequest,
+) (*pb.FragmentUtxosResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	asset, err := parseAsset(req.GetAsset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txHex, err := t.appSvc.FragmentUtxos(
+		ctx, accountName, asset, req.GetNumFragments(), req.GetFragmentAmount(),
+		millisatsPerByte, req.MinConfirmations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FragmentUtxosResponse{TxHex: txHex}, nil
+}
+
+func (t *transaction) Consolidate(
+	ctx context.Context, req *pb.ConsolidateRequest,
+) (*pb.ConsolidateResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	asset, err := parseAsset(req.GetAsset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txHex, err := t.appSvc.Consolidate(
+		ctx, accountName, asset, req.GetMaxInputs(), req.GetMaxUtxoValue(),
+		millisatsPerByte, req.GetMaxMillisatsPerByte(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConsolidateResponse{TxHex: txHex}, nil
+}
+
+func (t *transaction) EnqueuePayment(
+	ctx context.Context, req *pb.EnqueuePaymentRequest,
+) (*pb.EnqueuePaymentResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetReceiver() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing receiver")
+	}
+	outputs, err := parseOutputs([]*pb.Output{req.GetReceiver()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := t.appSvc.EnqueuePayment(ctx, accountName, outputs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnqueuePaymentResponse{Id: id}, nil
+}
+
+func (t *transaction) CancelPayment(
+	ctx context.Context, req *pb.CancelPaymentRequest,
+) (*pb.CancelPaymentResponse, error) {
+	id, err := parsePaymentID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := t.appSvc.CancelPayment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelPaymentResponse{}, nil
+}
+
+func (t *transaction) ListQueuedPayments(
+	ctx context.Context, req *pb.ListQueuedPaymentsRequest,
+) (*pb.ListQueuedPaymentsResponse, error) {
+	payments, err := t.appSvc.ListQueuedPayments(ctx, req.GetAccountName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListQueuedPaymentsResponse{
+		Payments: parsePayments(payments),
+	}, nil
+}
+
+func (t *transaction) CreateSwapOffer(
+	ctx context.Context, req *pb.CreateSwapOfferRequest,
+) (*pb.CreateSwapOfferResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	giveAsset, err := parseAsset(req.GetGiveAsset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	giveAmount, err := parseAmount(req.GetGiveAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	wantAsset, err := parseAsset(req.GetWantAsset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	wantAmount, err := parseAmount(req.GetWantAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	offer, err := t.appSvc.CreateSwapOffer(
+		ctx, accountName, giveAsset, giveAmount, wantAsset, wantAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateSwapOfferResponse{Offer: parseSwapOffer(offer)}, nil
+}
+
+func (t *transaction) AcceptSwap(
+	ctx context.Context, req *pb.AcceptSwapRequest,
+) (*pb.AcceptSwapResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ptx, err := parsePset(req.GetPset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	offer, err := t.appSvc.AcceptSwap(ctx, accountName, ptx, millisatsPerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptSwapResponse{Offer: parseSwapOffer(offer)}, nil
+}
+
+func (t *transaction) CompleteSwap(
+	ctx context.Context, req *pb.CompleteSwapRequest,
+) (*pb.CompleteSwapResponse, error) {
+	id, err := parseSwapID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ptx, err := parsePset(req.GetPset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	unblindedIns, err := parseUnblindedInputs(req.GetUnblindedInputs())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	signedPtx, txHex, err := t.appSvc.CompleteSwap(
+		ctx, id, ptx, unblindedIns, req.GetOverrideFeeGuard(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompleteSwapResponse{Pset: signedPtx, TxHex: txHex}, nil
+}
+
+func (t *transaction) CreateFragment(
+	ctx context.Context, req *pb.CreateFragmentRequest,
+) (*pb.CreateFragmentResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetUtxo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing utxo")
+	}
+	utxos, err := parseInputs([]*pb.Input{req.GetUtxo()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateTxid(utxos[0].TxID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	wantAsset, err := parseAsset(req.GetWantAsset())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	wantAmount, err := parseAmount(req.GetWantAmount())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fragment, err := t.appSvc.CreateFragment(
+		ctx, accountName, utxos[0], wantAsset, wantAmount, req.GetBlindOutput(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateFragmentResponse{Fragment: parseFragment(fragment)}, nil
+}
+
+func (t *transaction) FillFragments(
+	ctx context.Context, req *pb.FillFragmentsRequest,
+) (*pb.FillFragmentsResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fragments, err := parseFragments(req.GetFragments())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	millisatsPerByte, err := parseMillisatsPerByte(req.GetMillisatsPerByte())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txHex, err := t.appSvc.FillFragments(
+		ctx, accountName, fragments, millisatsPerByte, req.GetOverrideFeeGuard(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FillFragmentsResponse{TxHex: txHex}, nil
+}
+
+func (t *transaction) SetSpendingPolicy(
+	ctx context.Context, req *pb.SetSpendingPolicyRequest,
+) (*pb.SetSpendingPolicyResponse, error) {
+	policy, err := parseSpendingPolicy(req.GetPolicy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := t.appSvc.SetSpendingPolicy(ctx, *policy, password); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetSpendingPolicyResponse{}, nil
+}
+
+func (t *transaction) GetSpendingPolicy(
+	ctx context.Context, req *pb.GetSpendingPolicyRequest,
+) (*pb.GetSpendingPolicyResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	policy, err := t.appSvc.GetSpendingPolicy(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSpendingPolicyResponse{
+		Policy: parseSpendingPolicyInfo(policy),
+	}, nil
+}
+
+func (t *transaction) DeleteSpendingPolicy(
+	ctx context.Context, req *pb.DeleteSpendingPolicyRequest,
+) (*pb.DeleteSpendingPolicyResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := t.appSvc.DeleteSpendingPolicy(
+		ctx, accountName, password,
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteSpendingPolicyResponse{}, nil
+}
+
+func (t *transaction) ApproveSpending(
+	ctx context.Context, req *pb.ApproveSpendingRequest,
+) (*pb.ApproveSpendingResponse, error) {
+	id, err := parseSpendingID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	approver, err := parseApprover(req.GetApprover())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signature, err := parseApprovalSignature(req.GetSignature())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spending, err := t.appSvc.ApproveSpending(ctx, id, approver, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveSpendingResponse{
+		Spending: parseSpending(spending),
+	}, nil
+}
+
+func (t *transaction) ListSpendings(
+	ctx context.Context, req *pb.ListSpendingsRequest,
+) (*pb.ListSpendingsResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spendings, err := t.appSvc.ListSpendings(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListSpendingsResponse{
+		Spendings: parseSpendings(spendings),
+	}, nil
+}
+
+func (t *transaction) ListPolicyViolations(
+	ctx context.Context, req *pb.ListPolicyViolationsRequest,
+) (*pb.ListPolicyViolationsResponse, error) {
+	accountName, err := parseAccountName(req.GetAccountName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	violations, err := t.appSvc.ListPolicyViolations(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*pb.PolicyViolation, 0, len(violations))
+	for _, v := range violations {
+		list = append(list, parsePolicyViolation(*v))
+	}
+	return &pb.ListPolicyViolationsResponse{Violations: list}, nil
+}
+
 func (t *transaction) PegInAddress(
 	ctx context.Context, req *pb.PegInAddressRequest,
 ) (*pb.PegInAddressResponse, error) {
@@ -291,7 +893,7 @@ func (t *transaction) SignPsetWithSchnorrKey(
 	}
 
 	signedTx, err := t.appSvc.SignPsetWithSchnorrKey(
-		ctx, tx, req.GetSighashType(),
+		ctx, tx, req.GetSighashType(), req.GetOverrideFeeGuard(),
 	)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package grpc_handler
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/vulpemventures/go-elements/address"
@@ -97,6 +98,7 @@ func parseUtxos(utxos []domain.UtxoInfo) []*pb.Utxo {
 			AccountName:     u.AccountName,
 			SpentStatus:     spentStatus,
 			ConfirmedStatus: confirmedStatus,
+			FreezeReason:    u.FreezeReason,
 		})
 	}
 	return list
@@ -122,11 +124,237 @@ func parseInputs(ins []*pb.Input) ([]application.Input, error) {
 			Script:        in.GetScript(),
 			ScriptSigSize: int(in.GetScriptsigSize()),
 			WitnessSize:   int(in.GetWitnessSize()),
+			Sequence:      in.Sequence,
 		})
 	}
 	return inputs, nil
 }
 
+func parseLeases(leases []application.Lease) []*pb.Lease {
+	list := make([]*pb.Lease, 0, len(leases))
+	for _, l := range leases {
+		utxos := make([]*pb.Input, 0, len(l.Utxos))
+		for _, key := range l.Utxos {
+			utxos = append(utxos, &pb.Input{
+				Txid:  key.TxID,
+				Index: key.VOut,
+			})
+		}
+		list = append(list, &pb.Lease{
+			LeaseId:        l.ID,
+			AccountName:    l.AccountName,
+			Utxos:          utxos,
+			LockTimestamp:  l.LockTimestamp,
+			ExpirationDate: l.ExpiryTimestamp,
+		})
+	}
+	return list
+}
+
+func parsePayments(payments []*domain.Payment) []*pb.QueuedPayment {
+	list := make([]*pb.QueuedPayment, 0, len(payments))
+	for _, p := range payments {
+		list = append(list, &pb.QueuedPayment{
+			Id:          p.ID,
+			AccountName: p.AccountName,
+			Receiver: &pb.Output{
+				Asset:          p.Asset,
+				Amount:         p.Amount,
+				Script:         hex.EncodeToString(p.Script),
+				BlindingPubkey: hex.EncodeToString(p.BlindingKey),
+			},
+			Timestamp:     p.Timestamp,
+			Txid:          p.TxID,
+			FailureReason: p.FailureReason,
+		})
+	}
+	return list
+}
+
+func parseSwapOffer(offer *application.SwapOffer) *pb.SwapOffer {
+	unblindedInputs := make([]*pb.UnblindedInput, 0, len(offer.UnblindedInputs))
+	for _, in := range offer.UnblindedInputs {
+		unblindedInputs = append(unblindedInputs, &pb.UnblindedInput{
+			Index:         in.Index,
+			Asset:         in.Asset,
+			Amount:        in.Amount,
+			AssetBlinder:  in.AssetBlinder,
+			AmountBlinder: in.AmountBlinder,
+		})
+	}
+	return &pb.SwapOffer{
+		Id:              offer.ID,
+		AccountName:     offer.AccountName,
+		GiveAsset:       offer.GiveAsset,
+		GiveAmount:      offer.GiveAmount,
+		WantAsset:       offer.WantAsset,
+		WantAmount:      offer.WantAmount,
+		FeeAmount:       offer.FeeAmount,
+		Pset:            offer.Pset,
+		UnblindedInputs: unblindedInputs,
+		ExpiryTimestamp: offer.ExpiryTimestamp,
+	}
+}
+
+func parseFragment(fragment *application.Fragment) *pb.Fragment {
+	in := fragment.UnblindedInput
+	return &pb.Fragment{
+		Pset: fragment.Pset,
+		UnblindedInput: &pb.UnblindedInput{
+			Index:         in.Index,
+			Asset:         in.Asset,
+			Amount:        in.Amount,
+			AssetBlinder:  in.AssetBlinder,
+			AmountBlinder: in.AmountBlinder,
+		},
+		OutputAssetBlinder:  fragment.OutputAssetBlinder,
+		OutputAmountBlinder: fragment.OutputAmountBlinder,
+		LeaseId:             fragment.LeaseID,
+		ExpiryTimestamp:     fragment.ExpiryTimestamp,
+	}
+}
+
+func parseSpendingPolicy(policy *pb.SpendingPolicy) (*domain.SpendingPolicy, error) {
+	if policy == nil {
+		return nil, fmt.Errorf("missing spending policy")
+	}
+	accountName, err := parseAccountName(policy.GetAccountName())
+	if err != nil {
+		return nil, err
+	}
+	limits := make([]domain.AssetSpendingLimit, 0, len(policy.GetAssetLimits()))
+	for _, l := range policy.GetAssetLimits() {
+		if l == nil {
+			return nil, fmt.Errorf("missing asset limit")
+		}
+		asset, err := parseAsset(l.GetAsset())
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, domain.AssetSpendingLimit{
+			Asset:             asset,
+			MaxAmountPerTx:    l.GetMaxAmountPerTx(),
+			MaxAmountPerDay:   l.GetMaxAmountPerDay(),
+			ApprovalThreshold: l.GetApprovalThreshold(),
+		})
+	}
+	allowedScripts, err := parseScripts(policy.GetAllowedScripts())
+	if err != nil {
+		return nil, err
+	}
+	deniedScripts, err := parseScripts(policy.GetDeniedScripts())
+	if err != nil {
+		return nil, err
+	}
+	return &domain.SpendingPolicy{
+		AccountName:       accountName,
+		AssetLimits:       limits,
+		AllowedScripts:    allowedScripts,
+		DeniedScripts:     deniedScripts,
+		MaxFeeRate:        policy.GetMaxFeeRate(),
+		MaxFeeAmount:      policy.GetMaxFeeAmount(),
+		RequiredApprovals: policy.GetRequiredApprovals(),
+		Approvers:         policy.GetApprovers(),
+	}, nil
+}
+
+func parseSpendingPolicyInfo(policy *domain.SpendingPolicy) *pb.SpendingPolicy {
+	limits := make([]*pb.AssetSpendingLimit, 0, len(policy.AssetLimits))
+	for _, l := range policy.AssetLimits {
+		limits = append(limits, &pb.AssetSpendingLimit{
+			Asset:             l.Asset,
+			MaxAmountPerTx:    l.MaxAmountPerTx,
+			MaxAmountPerDay:   l.MaxAmountPerDay,
+			ApprovalThreshold: l.ApprovalThreshold,
+		})
+	}
+	return &pb.SpendingPolicy{
+		AccountName:       policy.AccountName,
+		AssetLimits:       limits,
+		AllowedScripts:    policy.AllowedScripts,
+		DeniedScripts:     policy.DeniedScripts,
+		MaxFeeRate:        policy.MaxFeeRate,
+		MaxFeeAmount:      policy.MaxFeeAmount,
+		RequiredApprovals: policy.RequiredApprovals,
+		Approvers:         policy.Approvers,
+	}
+}
+
+func parseSpendings(spendings []*domain.Spending) []*pb.Spending {
+	list := make([]*pb.Spending, 0, len(spendings))
+	for _, s := range spendings {
+		list = append(list, parseSpending(s))
+	}
+	return list
+}
+
+func parseSpending(s *domain.Spending) *pb.Spending {
+	return &pb.Spending{
+		Id:          s.ID,
+		AccountName: s.AccountName,
+		Amounts:     s.Amounts,
+		Approvers:   s.Approvers,
+		CreatedAt:   s.CreatedAt,
+		SignedAt:    s.SignedAt,
+		Digest:      s.Digest,
+	}
+}
+
+func parsePolicyViolation(v domain.PolicyViolation) *pb.PolicyViolation {
+	return &pb.PolicyViolation{
+		Id:          v.ID,
+		AccountName: v.AccountName,
+		Rule:        string(v.Rule),
+		Reason:      v.Reason,
+		Timestamp:   v.Timestamp,
+	}
+}
+
+func parseDecodedPset(ptx *application.DecodedPset) *pb.DecodePsetResponse {
+	inputs := make([]*pb.DecodedInput, 0, len(ptx.Inputs))
+	for _, in := range ptx.Inputs {
+		inputs = append(inputs, &pb.DecodedInput{
+			Txid:         in.TxID,
+			Index:        in.VOut,
+			AccountName:  in.AccountName,
+			Asset:        in.Asset,
+			Amount:       in.Amount,
+			Confidential: in.Confidential,
+			SighashType:  in.SighashType,
+			Signed:       in.Signed,
+			Finalized:    in.Finalized,
+		})
+	}
+	outputs := make([]*pb.DecodedOutput, 0, len(ptx.Outputs))
+	for _, out := range ptx.Outputs {
+		outputs = append(outputs, &pb.DecodedOutput{
+			Script:       out.Script,
+			AccountName:  out.AccountName,
+			Asset:        out.Asset,
+			Amount:       out.Amount,
+			Confidential: out.Confidential,
+			Blinded:      out.Blinded,
+		})
+	}
+	netAmounts := make([]*pb.AccountNetAmounts, 0, len(ptx.NetAmountByAccount))
+	for accountName, amounts := range ptx.NetAmountByAccount {
+		netAmounts = append(netAmounts, &pb.AccountNetAmounts{
+			AccountName: accountName,
+			Amounts:     amounts,
+		})
+	}
+	sort.SliceStable(netAmounts, func(i, j int) bool {
+		return netAmounts[i].AccountName < netAmounts[j].AccountName
+	})
+	return &pb.DecodePsetResponse{
+		Inputs:           inputs,
+		Outputs:          outputs,
+		NetAmounts:       netAmounts,
+		FeeAmount:        ptx.FeeAmount,
+		BlindingComplete: ptx.BlindingComplete,
+	}
+}
+
 func parseOutputs(outs []*pb.Output) ([]application.Output, error) {
 	outputs := make([]application.Output, 0, len(outs))
 	for _, out := range outs {
@@ -160,6 +388,68 @@ func parseOutputs(outs []*pb.Output) ([]application.Output, error) {
 	return outputs, nil
 }
 
+func parseChangeOutputs(outs application.Outputs) []*pb.Output {
+	list := make([]*pb.Output, 0, len(outs))
+	for _, out := range outs {
+		list = append(list, &pb.Output{
+			Asset:          out.Asset,
+			Amount:         out.Amount,
+			Script:         hex.EncodeToString(out.Script),
+			BlindingPubkey: hex.EncodeToString(out.BlindingKey),
+		})
+	}
+	return list
+}
+
+func parseLeaseID(leaseID string) (string, error) {
+	if leaseID == "" {
+		return "", fmt.Errorf("missing lease id")
+	}
+	return leaseID, nil
+}
+
+func parsePaymentID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("missing payment id")
+	}
+	return id, nil
+}
+
+func parseSwapID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("missing swap id")
+	}
+	return id, nil
+}
+
+func parseSpendingID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("missing spending id")
+	}
+	return id, nil
+}
+
+func parseApprover(approver string) (string, error) {
+	if approver == "" {
+		return "", fmt.Errorf("missing approver")
+	}
+	return approver, nil
+}
+
+func parseApprovalSignature(signature string) (string, error) {
+	if signature == "" {
+		return "", fmt.Errorf("missing approval signature")
+	}
+	return signature, nil
+}
+
+func parseFreezeReason(reason string) (string, error) {
+	if reason == "" {
+		return "", fmt.Errorf("missing freeze reason")
+	}
+	return reason, nil
+}
+
 func parseAmount(amount uint64) (uint64, error) {
 	if amount == 0 {
 		return 0, fmt.Errorf("missing amount")
@@ -182,12 +472,21 @@ func parseAsset(asset string) (string, error) {
 }
 
 func parseCoinSelectionStrategy(str pb.SelectUtxosRequest_Strategy) int {
-	return application.CoinSelectionStrategySmallestSubset
+	switch str {
+	case pb.SelectUtxosRequest_STRATEGY_BRANCH_BOUND:
+		return application.CoinSelectionStrategyBranchAndBound
+	case pb.SelectUtxosRequest_STRATEGY_FRAGMENT:
+		return application.CoinSelectionStrategyFragment
+	default:
+		return application.CoinSelectionStrategySmallestSubset
+	}
 }
 
+// parseMillisatsPerByte returns 0 if the ratio is not specified so that the
+// app service can estimate it.
 func parseMillisatsPerByte(ratio uint64) (uint64, error) {
 	if ratio == 0 {
-		return application.MinMillisatsPerByte, nil
+		return 0, nil
 	}
 	if ratio < application.MinMillisatsPerByte {
 		return 0, fmt.Errorf("mSats/byte ratio is too low")
@@ -209,6 +508,18 @@ func parsePset(ptx string) (string, error) {
 	return ptx, nil
 }
 
+func parsePsets(psets []string) ([]string, error) {
+	if len(psets) < 2 {
+		return nil, fmt.Errorf("at least 2 psets are required")
+	}
+	for i, ptx := range psets {
+		if _, err := parsePset(ptx); err != nil {
+			return nil, fmt.Errorf("pset %d: %s", i, err)
+		}
+	}
+	return psets, nil
+}
+
 func parseTxEventType(eventType domain.TransactionEventType) pb.TxEventType {
 	switch eventType {
 	case domain.TransactionAdded:
@@ -217,6 +528,8 @@ func parseTxEventType(eventType domain.TransactionEventType) pb.TxEventType {
 		return pb.TxEventType_TX_EVENT_TYPE_CONFIRMED
 	case domain.TransactionUnconfirmed:
 		return pb.TxEventType_TX_EVENT_TYPE_UNCONFIRMED
+	case domain.TransactionDeleted:
+		return pb.TxEventType_TX_EVENT_TYPE_EVICTED
 	default:
 		return pb.TxEventType_TX_EVENT_TYPE_UNSPECIFIED
 	}
@@ -236,6 +549,14 @@ func parseUtxoEventType(eventType domain.UtxoEventType) pb.UtxoEventType {
 		return pb.UtxoEventType_UTXO_EVENT_TYPE_SPENT
 	case domain.UtxoConfirmedSpend:
 		return pb.UtxoEventType_UTXO_EVENT_TYPE_CONFIRMED_SPENT
+	case domain.UtxoUnconfirmed:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_UNCONFIRMED
+	case domain.UtxoFrozen:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_FROZEN
+	case domain.UtxoUnfrozen:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_UNFROZEN
+	case domain.UtxoUnspent:
+		return pb.UtxoEventType_UTXO_EVENT_TYPE_UNSPENT
 	default:
 		return pb.UtxoEventType_UTXO_EVENT_TYPE_UNSPECIFIED
 	}
@@ -285,6 +606,46 @@ func parseUnblindedInputs(
 	return ins, nil
 }
 
+func parseFragments(list []*pb.Fragment) ([]application.Fragment, error) {
+	if len(list) <= 0 {
+		return nil, fmt.Errorf("missing fragments")
+	}
+	fragments := make([]application.Fragment, 0, len(list))
+	for i, f := range list {
+		if f.GetPset() == "" {
+			return nil, fmt.Errorf("missing pset of fragment %d", i)
+		}
+		if f.GetUnblindedInput() == nil {
+			return nil, fmt.Errorf("missing unblinded input of fragment %d", i)
+		}
+		ins, err := parseUnblindedInputs(
+			[]*pb.UnblindedInput{f.GetUnblindedInput()},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fragment %d: %s", i, err)
+		}
+		for _, blinder := range []string{
+			f.GetOutputAssetBlinder(), f.GetOutputAmountBlinder(),
+		} {
+			if blinder == "" {
+				continue
+			}
+			if _, err := parseAsset(blinder); err != nil {
+				return nil, fmt.Errorf(
+					"invalid output blinder of fragment %d", i,
+				)
+			}
+		}
+		fragments = append(fragments, application.Fragment{
+			Pset:                f.GetPset(),
+			UnblindedInput:      ins[0],
+			OutputAssetBlinder:  f.GetOutputAssetBlinder(),
+			OutputAmountBlinder: f.GetOutputAmountBlinder(),
+		})
+	}
+	return fragments, nil
+}
+
 func parseRootPath(p string) (string, error) {
 	if p == "" {
 		return p, nil
@@ -309,6 +670,18 @@ func parseScript(script string) (string, error) {
 	return script, nil
 }
 
+func parseScripts(scripts []string) ([]string, error) {
+	list := make([]string, 0, len(scripts))
+	for _, script := range scripts {
+		s, err := parseScript(script)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
 func parsePrvkey(key string) (string, error) {
 	if len(key) <= 0 {
 		return "", nil
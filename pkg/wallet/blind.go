@@ -1,9 +1,11 @@
 package wallet
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/vulpemventures/go-elements/confidential"
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/psetv2"
@@ -13,6 +15,12 @@ var (
 	ErrMissingOwnedInputs       = fmt.Errorf("missing list of owned inputs")
 	ErrMissingBlindingMasterKey = fmt.Errorf("missing blinding master key")
 	ErrBlindInvalidInputIndex   = fmt.Errorf("input index to blind is out of range")
+	ErrBlindInvalidOutputIndex  = fmt.Errorf("output index to blind is out of range")
+	ErrOutputNotConfidential    = fmt.Errorf("output to blind is not confidential")
+	ErrOutputNotBlinded         = fmt.Errorf("output is not blinded")
+	ErrSignedTaprootInput       = fmt.Errorf(
+		"output proof can't be replaced without invalidating taproot signatures",
+	)
 )
 
 type BlindPsetWithOwnedInputsArgs struct {
@@ -276,7 +284,7 @@ func getOutputIndexesToBlind(
 	}
 
 	for i, out := range ptx.Outputs {
-		if out.NeedsBlinding() {
+		if out.NeedsBlinding() && !out.IsFullyBlinded() {
 			if !isOwnedOutput(out.BlinderIndex) {
 				continue
 			}
@@ -285,3 +293,233 @@ func getOutputIndexesToBlind(
 	}
 	return ownedOuts
 }
+
+type BlindOutputArgs struct {
+	PsetBase64 string
+	Index      uint32
+}
+
+func (a BlindOutputArgs) validate() error {
+	if a.PsetBase64 == "" {
+		return ErrMissingPset
+	}
+	ptx, err := psetv2.NewPsetFromBase64(a.PsetBase64)
+	if err != nil {
+		return err
+	}
+	if int(a.Index) >= len(ptx.Outputs) {
+		return ErrBlindInvalidOutputIndex
+	}
+	out := ptx.Outputs[a.Index]
+	if !out.NeedsBlinding() || out.IsPartiallyBlinded() {
+		return ErrOutputNotConfidential
+	}
+	return nil
+}
+
+// BlindOutput blinds the given output of a partial transaction independently
+// from its inputs and returns the random asset and value blinders used, so
+// that whoever blinds the other outputs as last blinder can balance them.
+// Since the asset of the output might not be spent by any of the current
+// inputs, its surjection proof is made against the explicit asset and must
+// be replaced with ProveOutputAsset once all the inputs are known.
+func BlindOutput(args BlindOutputArgs) (string, []byte, []byte, error) {
+	if err := args.validate(); err != nil {
+		return "", nil, nil, err
+	}
+
+	ptx, _ := psetv2.NewPsetFromBase64(args.PsetBase64)
+	out := &ptx.Outputs[args.Index]
+
+	assetBlinder, err := randomBlinder()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	valueBlinder, err := randomBlinder()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	assetCommitment, err := confidential.AssetCommitment(out.Asset, assetBlinder)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	valueCommitment, err := confidential.ValueCommitment(
+		out.Value, assetCommitment, valueBlinder,
+	)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	ephemeralKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	nonce, err := confidential.NonceHash(
+		out.BlindingPubkey, ephemeralKey.Serialize(),
+	)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var valueBlinder32 [32]byte
+	copy(valueBlinder32[:], valueBlinder)
+	rangeProof, err := confidential.RangeProof(confidential.RangeProofArgs{
+		Value:               out.Value,
+		Nonce:               nonce,
+		Asset:               out.Asset,
+		AssetBlindingFactor: assetBlinder,
+		ValueBlindFactor:    valueBlinder32,
+		ValueCommit:         valueCommitment,
+		ScriptPubkey:        out.Script,
+	})
+	if err != nil {
+		return "", nil, nil, err
+	}
+	valueBlindProof, err := confidential.CreateBlindValueProof(
+		nil, valueBlinder, out.Value, valueCommitment, assetCommitment,
+	)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	assetBlindProof, err := confidential.CreateBlindAssetProof(
+		out.Asset, assetCommitment, assetBlinder,
+	)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	surjectionProof, err := surjectionProof(
+		out.Asset, assetBlinder, [][]byte{out.Asset}, [][]byte{zeroBlinder()},
+	)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	out.AssetCommitment = assetCommitment
+	out.ValueCommitment = valueCommitment
+	out.ValueRangeproof = rangeProof
+	out.AssetSurjectionProof = surjectionProof
+	out.BlindValueProof = valueBlindProof
+	out.BlindAssetProof = assetBlindProof
+	out.EcdhPubkey = ephemeralKey.PubKey().SerializeCompressed()
+	out.BlinderIndex = 0
+
+	ptxBase64, err := ptx.ToBase64()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return ptxBase64, assetBlinder, valueBlinder, nil
+}
+
+type ProveOutputAssetArgs struct {
+	PsetBase64    string
+	Index         uint32
+	AssetBlinder  []byte
+	InputsByIndex map[uint32]Input
+}
+
+func (a ProveOutputAssetArgs) validate() error {
+	if a.PsetBase64 == "" {
+		return ErrMissingPset
+	}
+	ptx, err := psetv2.NewPsetFromBase64(a.PsetBase64)
+	if err != nil {
+		return err
+	}
+	if int(a.Index) >= len(ptx.Outputs) {
+		return ErrBlindInvalidOutputIndex
+	}
+	if !ptx.Outputs[a.Index].IsFullyBlinded() {
+		return ErrOutputNotBlinded
+	}
+	if len(a.AssetBlinder) != 32 {
+		return fmt.Errorf("invalid output asset blinder length")
+	}
+	if len(a.InputsByIndex) != len(ptx.Inputs) {
+		return ErrMissingOwnedInputs
+	}
+	for i, in := range a.InputsByIndex {
+		if int(i) >= len(ptx.Inputs) {
+			return ErrBlindInvalidInputIndex
+		}
+		if err := in.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProveOutputAsset replaces the surjection proof of the given blinded output
+// with one made against the assets of all the given inputs of the partial
+// transaction. This is safe for inputs already signed with segwit v0 sighash,
+// which doesn't commit to the proof, while taproot signatures commit to the
+// output witnesses, therefore the proof can't be replaced if any taproot input
+// is already signed.
+func ProveOutputAsset(args ProveOutputAssetArgs) (string, error) {
+	if err := args.validate(); err != nil {
+		return "", err
+	}
+
+	ptx, _ := psetv2.NewPsetFromBase64(args.PsetBase64)
+	for i, in := range ptx.Inputs {
+		if len(in.TapKeySig) > 0 || len(in.TapScriptSig) > 0 {
+			return "", fmt.Errorf(
+				"%w: input %d is a signed taproot one", ErrSignedTaprootInput, i,
+			)
+		}
+	}
+	inAssets := make([][]byte, 0, len(ptx.Inputs))
+	inAssetBlinders := make([][]byte, 0, len(ptx.Inputs))
+	for i := range ptx.Inputs {
+		in := args.InputsByIndex[uint32(i)]
+		asset, _ := elementsutil.AssetHashToBytes(in.Asset)
+		assetBlinder := in.AssetBlinder
+		if len(assetBlinder) <= 0 {
+			assetBlinder = zeroBlinder()
+		}
+		inAssets = append(inAssets, asset[1:])
+		inAssetBlinders = append(inAssetBlinders, assetBlinder)
+	}
+
+	out := &ptx.Outputs[args.Index]
+	proof, err := surjectionProof(
+		out.Asset, args.AssetBlinder, inAssets, inAssetBlinders,
+	)
+	if err != nil {
+		return "", err
+	}
+	out.AssetSurjectionProof = proof
+
+	return ptx.ToBase64()
+}
+
+func surjectionProof(
+	asset, assetBlinder []byte, inAssets, inAssetBlinders [][]byte,
+) ([]byte, error) {
+	seed, err := randomBlinder()
+	if err != nil {
+		return nil, err
+	}
+	proof, ok := confidential.SurjectionProof(confidential.SurjectionProofArgs{
+		OutputAsset:               asset,
+		OutputAssetBlindingFactor: assetBlinder,
+		InputAssets:               inAssets,
+		InputAssetBlindingFactors: inAssetBlinders,
+		Seed:                      seed,
+	})
+	if !ok {
+		return nil, fmt.Errorf("failed to generate asset surjection proof")
+	}
+	return proof, nil
+}
+
+func randomBlinder() ([]byte, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate random blinder: %s", err)
+	}
+	return buf, nil
+}
+
+func zeroBlinder() []byte {
+	return make([]byte, 32)
+}
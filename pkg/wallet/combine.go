@@ -0,0 +1,327 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/vulpemventures/go-elements/psetv2"
+)
+
+var (
+	ErrMissingPsetsToCombine = fmt.Errorf("at least 2 partial transactions are required to combine")
+	ErrCombineTxMismatch     = fmt.Errorf("partial transactions don't refer to the same unsigned transaction")
+	ErrCombineConflict       = fmt.Errorf("partial transactions contain conflicting data")
+)
+
+type CombinePsetsArgs struct {
+	PsetsBase64 []string
+}
+
+func (a CombinePsetsArgs) validate() error {
+	if len(a.PsetsBase64) < 2 {
+		return ErrMissingPsetsToCombine
+	}
+	for i, ptx := range a.PsetsBase64 {
+		if _, err := psetv2.NewPsetFromBase64(ptx); err != nil {
+			return fmt.Errorf("invalid partial transaction %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// CombinePsets merges several copies of the same unsigned transaction, each
+// possibly updated by a different party, into a single partial transaction.
+// Signatures, tapscript signatures and leaves, derivation paths, preimages,
+// utxos, blinding commitments and proofs are merged, while an error is
+// returned if the copies spend/create different coins or contain conflicting
+// data, like different signatures for the same key or different commitments
+// for the same output.
+func CombinePsets(args CombinePsetsArgs) (string, error) {
+	if err := args.validate(); err != nil {
+		return "", err
+	}
+
+	ptx, _ := psetv2.NewPsetFromBase64(args.PsetsBase64[0])
+	for i, psetBase64 := range args.PsetsBase64[1:] {
+		other, _ := psetv2.NewPsetFromBase64(psetBase64)
+		if err := checkSameUnsignedTx(ptx, other); err != nil {
+			return "", fmt.Errorf("partial transaction %d: %w", i+1, err)
+		}
+
+		for j := range ptx.Inputs {
+			if err := combineInputs(&ptx.Inputs[j], other.Inputs[j]); err != nil {
+				return "", fmt.Errorf(
+					"partial transaction %d: input %d: %w", i+1, j, err,
+				)
+			}
+		}
+		for j := range ptx.Outputs {
+			if err := combineOutputs(&ptx.Outputs[j], other.Outputs[j]); err != nil {
+				return "", fmt.Errorf(
+					"partial transaction %d: output %d: %w", i+1, j, err,
+				)
+			}
+		}
+		ptx.Global.Scalars = combineScalars(
+			ptx.Global.Scalars, other.Global.Scalars,
+		)
+	}
+
+	if err := ptx.SanityCheck(); err != nil {
+		return "", err
+	}
+	return ptx.ToBase64()
+}
+
+// checkSameUnsignedTx makes sure the given partial transactions refer to the
+// same unsigned transaction, regardless of whether their outputs are blinded.
+func checkSameUnsignedTx(ptx, other *psetv2.Pset) error {
+	if ptx.Global.TxVersion != other.Global.TxVersion ||
+		ptx.Locktime() != other.Locktime() ||
+		len(ptx.Inputs) != len(other.Inputs) ||
+		len(ptx.Outputs) != len(other.Outputs) {
+		return ErrCombineTxMismatch
+	}
+	for i, in := range ptx.Inputs {
+		otherIn := other.Inputs[i]
+		if !bytes.Equal(in.PreviousTxid, otherIn.PreviousTxid) ||
+			in.PreviousTxIndex != otherIn.PreviousTxIndex ||
+			in.Sequence != otherIn.Sequence {
+			return ErrCombineTxMismatch
+		}
+	}
+	for i, out := range ptx.Outputs {
+		otherOut := other.Outputs[i]
+		if !bytes.Equal(out.Script, otherOut.Script) ||
+			!bytes.Equal(out.Asset, otherOut.Asset) ||
+			out.Value != otherOut.Value ||
+			!bytes.Equal(out.BlindingPubkey, otherOut.BlindingPubkey) {
+			return ErrCombineTxMismatch
+		}
+	}
+	return nil
+}
+
+func combineInputs(in *psetv2.Input, other psetv2.Input) error {
+	if in.WitnessUtxo == nil {
+		in.WitnessUtxo = other.WitnessUtxo
+	} else if other.WitnessUtxo != nil {
+		if !bytes.Equal(in.WitnessUtxo.Script, other.WitnessUtxo.Script) ||
+			!bytes.Equal(in.WitnessUtxo.Asset, other.WitnessUtxo.Asset) ||
+			!bytes.Equal(in.WitnessUtxo.Value, other.WitnessUtxo.Value) {
+			return fmt.Errorf("%w: different witness utxos", ErrCombineConflict)
+		}
+	}
+	if in.NonWitnessUtxo == nil {
+		in.NonWitnessUtxo = other.NonWitnessUtxo
+	}
+
+	if in.SigHashType == 0 {
+		in.SigHashType = other.SigHashType
+	} else if other.SigHashType != 0 && in.SigHashType != other.SigHashType {
+		return fmt.Errorf("%w: different sighash types", ErrCombineConflict)
+	}
+
+	for _, field := range []struct {
+		name string
+		dst  *[]byte
+		src  []byte
+	}{
+		{"redeem scripts", &in.RedeemScript, other.RedeemScript},
+		{"witness scripts", &in.WitnessScript, other.WitnessScript},
+		{"final script sigs", &in.FinalScriptSig, other.FinalScriptSig},
+		{"final witnesses", &in.FinalScriptWitness, other.FinalScriptWitness},
+		{"utxo range proofs", &in.UtxoRangeProof, other.UtxoRangeProof},
+		{"taproot key signatures", &in.TapKeySig, other.TapKeySig},
+		{"taproot internal keys", &in.TapInternalKey, other.TapInternalKey},
+		{"taproot merkle roots", &in.TapMerkleRoot, other.TapMerkleRoot},
+	} {
+		if err := combineField(field.name, field.dst, field.src); err != nil {
+			return err
+		}
+	}
+
+	if len(in.ExplicitAsset) <= 0 {
+		in.ExplicitAsset, in.AssetProof = other.ExplicitAsset, other.AssetProof
+	}
+	if in.ExplicitValue <= 0 {
+		in.ExplicitValue, in.ValueProof = other.ExplicitValue, other.ValueProof
+	}
+
+	sigsByKey := make(map[string][]byte)
+	for _, sig := range in.PartialSigs {
+		sigsByKey[hex.EncodeToString(sig.PubKey)] = sig.Signature
+	}
+	for _, sig := range other.PartialSigs {
+		key := hex.EncodeToString(sig.PubKey)
+		if s, ok := sigsByKey[key]; ok {
+			if !bytes.Equal(s, sig.Signature) {
+				return fmt.Errorf(
+					"%w: different signatures for key %s", ErrCombineConflict, key,
+				)
+			}
+			continue
+		}
+		in.PartialSigs = append(in.PartialSigs, sig)
+	}
+
+	tapSigsByKey := make(map[string][]byte)
+	for _, sig := range in.TapScriptSig {
+		key := hex.EncodeToString(sig.PubKey) + hex.EncodeToString(sig.LeafHash)
+		tapSigsByKey[key] = sig.Signature
+	}
+	for _, sig := range other.TapScriptSig {
+		key := hex.EncodeToString(sig.PubKey) + hex.EncodeToString(sig.LeafHash)
+		if s, ok := tapSigsByKey[key]; ok {
+			if !bytes.Equal(s, sig.Signature) {
+				return fmt.Errorf(
+					"%w: different tapscript signatures for key %x",
+					ErrCombineConflict, sig.PubKey,
+				)
+			}
+			continue
+		}
+		in.TapScriptSig = append(in.TapScriptSig, sig)
+	}
+
+	leaves := make(map[string]struct{})
+	for _, leaf := range in.TapLeafScript {
+		leaves[leaf.TapHash().String()] = struct{}{}
+	}
+	for _, leaf := range other.TapLeafScript {
+		if _, ok := leaves[leaf.TapHash().String()]; !ok {
+			in.TapLeafScript = append(in.TapLeafScript, leaf)
+		}
+	}
+
+	in.Bip32Derivation = combineDerivationPaths(
+		in.Bip32Derivation, other.Bip32Derivation,
+	)
+	tapKeys := make(map[string]struct{})
+	for _, d := range in.TapBip32Derivation {
+		tapKeys[hex.EncodeToString(d.PubKey)] = struct{}{}
+	}
+	for _, d := range other.TapBip32Derivation {
+		if _, ok := tapKeys[hex.EncodeToString(d.PubKey)]; !ok {
+			in.TapBip32Derivation = append(in.TapBip32Derivation, d)
+		}
+	}
+
+	in.Ripemd160Preimages = combinePreimages(
+		in.Ripemd160Preimages, other.Ripemd160Preimages,
+	)
+	in.Hash160Preimages = combinePreimages(
+		in.Hash160Preimages, other.Hash160Preimages,
+	)
+	in.Sha256Preimages = combinePreimages(
+		in.Sha256Preimages, other.Sha256Preimages,
+	)
+	in.Hash256Preimages = combinePreimages(
+		in.Hash256Preimages, other.Hash256Preimages,
+	)
+	return nil
+}
+
+func combineOutputs(out *psetv2.Output, other psetv2.Output) error {
+	wasBlinded := out.IsFullyBlinded()
+
+	// Commitments are what signatures commit to, therefore they must match,
+	// while proofs are just added if missing.
+	for _, field := range []struct {
+		name string
+		dst  *[]byte
+		src  []byte
+	}{
+		{"redeem scripts", &out.RedeemScript, other.RedeemScript},
+		{"witness scripts", &out.WitnessScript, other.WitnessScript},
+		{"value commitments", &out.ValueCommitment, other.ValueCommitment},
+		{"asset commitments", &out.AssetCommitment, other.AssetCommitment},
+		{"ecdh pubkeys", &out.EcdhPubkey, other.EcdhPubkey},
+	} {
+		if err := combineField(field.name, field.dst, field.src); err != nil {
+			return err
+		}
+	}
+	if len(out.ValueRangeproof) <= 0 {
+		out.ValueRangeproof = other.ValueRangeproof
+	}
+	if len(out.AssetSurjectionProof) <= 0 {
+		out.AssetSurjectionProof = other.AssetSurjectionProof
+	}
+	if len(out.BlindValueProof) <= 0 {
+		out.BlindValueProof = other.BlindValueProof
+	}
+	if len(out.BlindAssetProof) <= 0 {
+		out.BlindAssetProof = other.BlindAssetProof
+	}
+	if !wasBlinded && out.IsFullyBlinded() {
+		out.BlinderIndex = other.BlinderIndex
+	}
+
+	out.Bip32Derivation = combineDerivationPaths(
+		out.Bip32Derivation, other.Bip32Derivation,
+	)
+	return nil
+}
+
+// combineField sets the given field if empty, or makes sure it matches the
+// other one otherwise.
+func combineField(name string, dst *[]byte, src []byte) error {
+	if len(src) <= 0 {
+		return nil
+	}
+	if len(*dst) <= 0 {
+		*dst = src
+		return nil
+	}
+	if !bytes.Equal(*dst, src) {
+		return fmt.Errorf("%w: different %s", ErrCombineConflict, name)
+	}
+	return nil
+}
+
+func combineDerivationPaths(
+	paths, otherPaths []psetv2.DerivationPathWithPubKey,
+) []psetv2.DerivationPathWithPubKey {
+	keys := make(map[string]struct{})
+	for _, d := range paths {
+		keys[hex.EncodeToString(d.PubKey)] = struct{}{}
+	}
+	for _, d := range otherPaths {
+		if _, ok := keys[hex.EncodeToString(d.PubKey)]; !ok {
+			paths = append(paths, d)
+		}
+	}
+	return paths
+}
+
+func combinePreimages[K comparable](
+	preimages, otherPreimages map[K][]byte,
+) map[K][]byte {
+	if len(otherPreimages) <= 0 {
+		return preimages
+	}
+	if preimages == nil {
+		preimages = make(map[K][]byte)
+	}
+	for hash, preimage := range otherPreimages {
+		if _, ok := preimages[hash]; !ok {
+			preimages[hash] = preimage
+		}
+	}
+	return preimages
+}
+
+func combineScalars(scalars, otherScalars [][]byte) [][]byte {
+	keys := make(map[string]struct{})
+	for _, s := range scalars {
+		keys[hex.EncodeToString(s)] = struct{}{}
+	}
+	for _, s := range otherScalars {
+		if _, ok := keys[hex.EncodeToString(s)]; !ok {
+			scalars = append(scalars, s)
+		}
+	}
+	return scalars
+}
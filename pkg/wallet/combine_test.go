@@ -0,0 +1,634 @@
+package wallet_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/taproot"
+	"github.com/vulpemventures/go-elements/transaction"
+	wallet "github.com/vulpemventures/ocean/pkg/wallet"
+)
+
+func TestCombineAndFinalizePsets(t *testing.T) {
+	t.Parallel()
+
+	key1, _ := btcec.NewPrivateKey()
+	key2, _ := btcec.NewPrivateKey()
+	pubkey1 := key1.PubKey().SerializeCompressed()
+	pubkey2 := key2.PubKey().SerializeCompressed()
+
+	multisigScript, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_2).AddData(pubkey1).AddData(pubkey2).
+		AddOp(txscript.OP_2).AddOp(txscript.OP_CHECKMULTISIG).Script()
+	templateScript, _ := txscript.NewScriptBuilder().
+		AddData(pubkey1).AddOp(txscript.OP_CHECKSIGVERIFY).
+		AddData(pubkey2).AddOp(txscript.OP_CHECKSIG).Script()
+
+	tests := []struct {
+		name          string
+		witnessScript []byte
+	}{
+		{"multisig", multisigScript},
+		{"template", templateScript},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ptx := newWitnessScriptPset(t, tt.witnessScript)
+			ptx1 := signWitnessScriptInput(t, ptx, key1, tt.witnessScript)
+			ptx2 := signWitnessScriptInput(t, ptx, key2, tt.witnessScript)
+
+			combinedPtx, err := wallet.CombinePsets(wallet.CombinePsetsArgs{
+				PsetsBase64: []string{ptx1, ptx2},
+			})
+			require.NoError(t, err)
+			require.NotEmpty(t, combinedPtx)
+
+			p, err := psetv2.NewPsetFromBase64(combinedPtx)
+			require.NoError(t, err)
+			require.Len(t, p.Inputs[0].PartialSigs, 2)
+			sigsByKey := make(map[string][]byte)
+			for _, sig := range p.Inputs[0].PartialSigs {
+				sigsByKey[string(sig.PubKey)] = sig.Signature
+			}
+
+			// A multisig input can't be finalized without all the signatures.
+			if tt.name == "multisig" {
+				_, _, err := wallet.FinalizeAndExtractTransaction(
+					wallet.FinalizeAndExtractTransactionArgs{PsetBase64: ptx1},
+				)
+				require.Error(t, err)
+			}
+
+			finalizedPtx, err := wallet.FinalizePset(wallet.FinalizePsetArgs{
+				PsetBase64: combinedPtx,
+			})
+			require.NoError(t, err)
+
+			p, err = psetv2.NewPsetFromBase64(finalizedPtx)
+			require.NoError(t, err)
+			require.NotEmpty(t, p.Inputs[0].FinalScriptWitness)
+
+			txHex, txid, err := wallet.FinalizeAndExtractTransaction(
+				wallet.FinalizeAndExtractTransactionArgs{PsetBase64: combinedPtx},
+			)
+			require.NoError(t, err)
+			require.NotEmpty(t, txid)
+
+			tx, err := transaction.NewTxFromHex(txHex)
+			require.NoError(t, err)
+			witness := tx.Inputs[0].Witness
+			require.Equal(t, tt.witnessScript, []byte(witness[len(witness)-1]))
+			if tt.name == "template" {
+				// The signature for the first key checked by the script must be at
+				// the top of the stack.
+				require.Len(t, witness, 3)
+				require.Equal(t, sigsByKey[string(pubkey2)], []byte(witness[0]))
+				require.Equal(t, sigsByKey[string(pubkey1)], []byte(witness[1]))
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ptx := newWitnessScriptPset(t, multisigScript)
+		otherPtx := newWitnessScriptPset(t, multisigScript)
+		signedPtx := signWitnessScriptInput(t, ptx, key1, multisigScript)
+		p, _ := psetv2.NewPsetFromBase64(signedPtx)
+		p.Inputs[0].PartialSigs[0].Signature[10] ^= 0xff
+		tamperedPtx, _ := p.ToBase64()
+
+		leafScript := checksigLeafScript(key1)
+		tapPtx, leaves := newTapscriptPset(t, leafScript)
+		tapSignedPtx := signTapscriptInput(t, tapPtx, key1, leaves[0])
+		p, _ = psetv2.NewPsetFromBase64(tapSignedPtx)
+		p.Inputs[0].TapScriptSig[0].Signature[10] ^= 0xff
+		tapTamperedPtx, _ := p.ToBase64()
+
+		tests := []struct {
+			name        string
+			psets       []string
+			expectedErr error
+		}{
+			{
+				name:        "missing_psets",
+				psets:       []string{ptx},
+				expectedErr: wallet.ErrMissingPsetsToCombine,
+			},
+			{
+				name:        "different_txs",
+				psets:       []string{ptx, otherPtx},
+				expectedErr: wallet.ErrCombineTxMismatch,
+			},
+			{
+				name:        "conflicting_signatures",
+				psets:       []string{signedPtx, tamperedPtx},
+				expectedErr: wallet.ErrCombineConflict,
+			},
+			{
+				name:        "conflicting_tapscript_signatures",
+				psets:       []string{tapSignedPtx, tapTamperedPtx},
+				expectedErr: wallet.ErrCombineConflict,
+			},
+		}
+
+		for _, tt := range tests {
+			_, err := wallet.CombinePsets(wallet.CombinePsetsArgs{
+				PsetsBase64: tt.psets,
+			})
+			require.ErrorIs(t, err, tt.expectedErr, tt.name)
+		}
+	})
+}
+
+func TestCombineBlindingProofs(t *testing.T) {
+	t.Parallel()
+
+	outputs := randomOutputs(1)
+	outputs[0].Asset = network.Regtest.AssetID
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs:  randomInputs(1),
+		Outputs: outputs,
+	})
+	require.NoError(t, err)
+
+	blindedPtx, _, _, err := wallet.BlindOutput(wallet.BlindOutputArgs{
+		PsetBase64: ptx,
+		Index:      0,
+	})
+	require.NoError(t, err)
+	blinded, _ := psetv2.NewPsetFromBase64(blindedPtx)
+	expectedOut := blinded.Outputs[0]
+
+	t.Run("output_proofs", func(t *testing.T) {
+		t.Parallel()
+
+		combinedPtx, err := wallet.CombinePsets(wallet.CombinePsetsArgs{
+			PsetsBase64: []string{ptx, blindedPtx},
+		})
+		require.NoError(t, err)
+
+		p, err := psetv2.NewPsetFromBase64(combinedPtx)
+		require.NoError(t, err)
+		out := p.Outputs[0]
+		require.True(t, out.IsFullyBlinded())
+		require.Equal(t, expectedOut.AssetCommitment, out.AssetCommitment)
+		require.Equal(t, expectedOut.ValueCommitment, out.ValueCommitment)
+		require.Equal(t, expectedOut.ValueRangeproof, out.ValueRangeproof)
+		require.Equal(
+			t, expectedOut.AssetSurjectionProof, out.AssetSurjectionProof,
+		)
+		require.Equal(t, expectedOut.BlindValueProof, out.BlindValueProof)
+		require.Equal(t, expectedOut.BlindAssetProof, out.BlindAssetProof)
+	})
+
+	t.Run("input_proofs", func(t *testing.T) {
+		t.Parallel()
+
+		// Every party reveals a different explicit amount of the confidential
+		// input.
+		asset := randomBytes(32)
+		valueProof, assetProof := randomBytes(73), randomBytes(67)
+		p, _ := psetv2.NewPsetFromBase64(ptx)
+		p.Inputs[0].ExplicitValue = 1000
+		p.Inputs[0].ValueProof = valueProof
+		ptx1, _ := p.ToBase64()
+		p, _ = psetv2.NewPsetFromBase64(ptx)
+		p.Inputs[0].ExplicitAsset = asset
+		p.Inputs[0].AssetProof = assetProof
+		ptx2, _ := p.ToBase64()
+
+		combinedPtx, err := wallet.CombinePsets(wallet.CombinePsetsArgs{
+			PsetsBase64: []string{ptx1, ptx2},
+		})
+		require.NoError(t, err)
+
+		p, err = psetv2.NewPsetFromBase64(combinedPtx)
+		require.NoError(t, err)
+		in := p.Inputs[0]
+		require.Equal(t, uint64(1000), in.ExplicitValue)
+		require.Equal(t, valueProof, in.ValueProof)
+		require.Equal(t, asset, in.ExplicitAsset)
+		require.Equal(t, assetProof, in.AssetProof)
+	})
+
+	t.Run("conflicting_commitments", func(t *testing.T) {
+		t.Parallel()
+
+		otherBlindedPtx, _, _, err := wallet.BlindOutput(wallet.BlindOutputArgs{
+			PsetBase64: ptx,
+			Index:      0,
+		})
+		require.NoError(t, err)
+
+		_, err = wallet.CombinePsets(wallet.CombinePsetsArgs{
+			PsetsBase64: []string{blindedPtx, otherBlindedPtx},
+		})
+		require.ErrorIs(t, err, wallet.ErrCombineConflict)
+	})
+}
+
+func TestFinalizeTemplateScripts(t *testing.T) {
+	t.Parallel()
+
+	key1, _ := btcec.NewPrivateKey()
+	key2, _ := btcec.NewPrivateKey()
+	pubkey1 := key1.PubKey().SerializeCompressed()
+	pubkey2 := key2.PubKey().SerializeCompressed()
+	preimage := randomBytes(32)
+	sha256Hash := sha256.Sum256(preimage)
+	hash160 := btcutil.Hash160(preimage)
+
+	templateScript, _ := txscript.NewScriptBuilder().
+		AddData(pubkey1).AddOp(txscript.OP_CHECKSIGVERIFY).
+		AddData(pubkey2).AddOp(txscript.OP_CHECKSIG).Script()
+	sha256Script, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_SIZE).AddInt64(32).AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_SHA256).AddData(sha256Hash[:]).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddData(pubkey1).AddOp(txscript.OP_CHECKSIG).Script()
+	hash160Script, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).AddData(hash160).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddData(pubkey1).AddOp(txscript.OP_CHECKSIG).Script()
+	branchedScript, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_IF).
+		AddData(pubkey1).AddOp(txscript.OP_CHECKSIG).
+		AddOp(txscript.OP_ELSE).
+		AddData(pubkey2).AddOp(txscript.OP_CHECKSIG).
+		AddOp(txscript.OP_ENDIF).Script()
+
+	addSha256Preimage := func(in *psetv2.Input) {
+		in.Sha256Preimages = map[[32]byte][]byte{sha256Hash: preimage}
+	}
+	addHash160Preimage := func(in *psetv2.Input) {
+		var h [20]byte
+		copy(h[:], hash160)
+		in.Hash160Preimages = map[[20]byte][]byte{h: preimage}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name          string
+			witnessScript []byte
+			addPreimage   func(in *psetv2.Input)
+		}{
+			{"sha256_hashlock", sha256Script, addSha256Preimage},
+			{"hash160_hashlock", hash160Script, addHash160Preimage},
+		}
+
+		for _, tt := range tests {
+			ptx := newWitnessScriptPset(t, tt.witnessScript)
+			signedPtx := signWitnessScriptInput(t, ptx, key1, tt.witnessScript)
+			p, _ := psetv2.NewPsetFromBase64(ptx)
+			tt.addPreimage(&p.Inputs[0])
+			preimagePtx, _ := p.ToBase64()
+
+			// The preimage is revealed by another party.
+			combinedPtx, err := wallet.CombinePsets(wallet.CombinePsetsArgs{
+				PsetsBase64: []string{signedPtx, preimagePtx},
+			})
+			require.NoError(t, err, tt.name)
+
+			txHex, _, err := wallet.FinalizeAndExtractTransaction(
+				wallet.FinalizeAndExtractTransactionArgs{PsetBase64: combinedPtx},
+			)
+			require.NoError(t, err, tt.name)
+
+			tx, err := transaction.NewTxFromHex(txHex)
+			require.NoError(t, err, tt.name)
+			witness := tx.Inputs[0].Witness
+			require.Len(t, witness, 3, tt.name)
+			p, _ = psetv2.NewPsetFromBase64(signedPtx)
+			require.Equal(t, p.Inputs[0].PartialSigs[0].Signature, []byte(witness[0]))
+			require.Equal(t, preimage, []byte(witness[1]), tt.name)
+			require.Equal(t, tt.witnessScript, []byte(witness[2]), tt.name)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name          string
+			witnessScript []byte
+			keys          []*btcec.PrivateKey
+		}{
+			{"under_signed_template", templateScript, []*btcec.PrivateKey{key1}},
+			{"missing_preimage", sha256Script, []*btcec.PrivateKey{key1}},
+		}
+
+		for _, tt := range tests {
+			ptx := newWitnessScriptPset(t, tt.witnessScript)
+			for _, key := range tt.keys {
+				ptx = signWitnessScriptInput(t, ptx, key, tt.witnessScript)
+			}
+
+			finalizedPtx, err := wallet.FinalizePset(wallet.FinalizePsetArgs{
+				PsetBase64: ptx,
+			})
+			require.ErrorIs(t, err, wallet.ErrFinalizeMissingSignatures, tt.name)
+			require.Empty(t, finalizedPtx, tt.name)
+		}
+
+		// Scripts with branches are not supported even if fully signed, since
+		// the branch to satisfy can't be chosen on behalf of the signers.
+		ptx := newWitnessScriptPset(t, branchedScript)
+		for _, key := range []*btcec.PrivateKey{key1, key2} {
+			ptx = signWitnessScriptInput(t, ptx, key, branchedScript)
+		}
+
+		finalizedPtx, err := wallet.FinalizePset(wallet.FinalizePsetArgs{
+			PsetBase64: ptx,
+		})
+		require.ErrorIs(t, err, wallet.ErrFinalizeUnsupportedScript)
+		require.Empty(t, finalizedPtx)
+	})
+}
+
+func TestFinalizeTapscript(t *testing.T) {
+	t.Parallel()
+
+	key1, _ := btcec.NewPrivateKey()
+	key2, _ := btcec.NewPrivateKey()
+	key3, _ := btcec.NewPrivateKey()
+	xonly := func(key *btcec.PrivateKey) []byte {
+		return schnorr.SerializePubKey(key.PubKey())
+	}
+
+	templateScript, _ := txscript.NewScriptBuilder().
+		AddData(xonly(key1)).AddOp(txscript.OP_CHECKSIGVERIFY).
+		AddData(xonly(key2)).AddOp(txscript.OP_CHECKSIG).Script()
+	thresholdScript, _ := txscript.NewScriptBuilder().
+		AddData(xonly(key1)).AddOp(txscript.OP_CHECKSIG).
+		AddData(xonly(key2)).AddOp(txscript.OP_CHECKSIGADD).
+		AddData(xonly(key3)).AddOp(txscript.OP_CHECKSIGADD).
+		AddInt64(2).AddOp(txscript.OP_NUMEQUAL).Script()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		ptx, leaves := newTapscriptPset(t, templateScript, thresholdScript)
+
+		tests := []struct {
+			name string
+			leaf psetv2.TapLeafScript
+			keys []*btcec.PrivateKey
+			// Expected witness stack, without leaf script and control block,
+			// where nil stands for an empty signature.
+			expectedSigs []*btcec.PrivateKey
+		}{
+			{
+				name:         "template",
+				leaf:         leaves[0],
+				keys:         []*btcec.PrivateKey{key1, key2},
+				expectedSigs: []*btcec.PrivateKey{key2, key1},
+			},
+			{
+				name:         "threshold",
+				leaf:         leaves[1],
+				keys:         []*btcec.PrivateKey{key1, key3},
+				expectedSigs: []*btcec.PrivateKey{key3, nil, key1},
+			},
+			{
+				name:         "threshold_with_exceeding_signatures",
+				leaf:         leaves[1],
+				keys:         []*btcec.PrivateKey{key1, key2, key3},
+				expectedSigs: []*btcec.PrivateKey{nil, key2, key1},
+			},
+		}
+
+		for _, tt := range tests {
+			// Every party signs its own copy.
+			psets := make([]string, 0, len(tt.keys))
+			for _, key := range tt.keys {
+				psets = append(psets, signTapscriptInput(t, ptx, key, tt.leaf))
+			}
+			combinedPtx, err := wallet.CombinePsets(wallet.CombinePsetsArgs{
+				PsetsBase64: psets,
+			})
+			require.NoError(t, err, tt.name)
+
+			p, _ := psetv2.NewPsetFromBase64(combinedPtx)
+			require.Len(t, p.Inputs[0].TapScriptSig, len(tt.keys), tt.name)
+			sigsByKey := make(map[string][]byte)
+			for _, sig := range p.Inputs[0].TapScriptSig {
+				sigsByKey[string(sig.PubKey)] = sig.Signature
+			}
+
+			txHex, _, err := wallet.FinalizeAndExtractTransaction(
+				wallet.FinalizeAndExtractTransactionArgs{PsetBase64: combinedPtx},
+			)
+			require.NoError(t, err, tt.name)
+
+			tx, err := transaction.NewTxFromHex(txHex)
+			require.NoError(t, err, tt.name)
+			witness := tx.Inputs[0].Witness
+			require.Len(t, witness, len(tt.expectedSigs)+2, tt.name)
+			for i, key := range tt.expectedSigs {
+				if key == nil {
+					require.Empty(t, witness[i], tt.name)
+					continue
+				}
+				require.Equal(
+					t, sigsByKey[string(xonly(key))], []byte(witness[i]), tt.name,
+				)
+			}
+			require.Equal(t, tt.leaf.Script, []byte(witness[len(witness)-2]))
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ptx, leaves := newTapscriptPset(t, templateScript, thresholdScript)
+
+		tests := []struct {
+			name string
+			keys []*btcec.PrivateKey
+			leaf psetv2.TapLeafScript
+		}{
+			{"under_signed_template", []*btcec.PrivateKey{key2}, leaves[0]},
+			{"under_signed_threshold", []*btcec.PrivateKey{key3}, leaves[1]},
+		}
+
+		for _, tt := range tests {
+			signedPtx := ptx
+			for _, key := range tt.keys {
+				signedPtx = signTapscriptInput(t, signedPtx, key, tt.leaf)
+			}
+
+			finalizedPtx, err := wallet.FinalizePset(wallet.FinalizePsetArgs{
+				PsetBase64: signedPtx,
+			})
+			require.ErrorIs(t, err, wallet.ErrFinalizeMissingSignatures, tt.name)
+			require.Empty(t, finalizedPtx, tt.name)
+		}
+	})
+}
+
+func newWitnessScriptPset(t *testing.T, witnessScript []byte) string {
+	scriptHash := sha256.Sum256(witnessScript)
+	script := append([]byte{txscript.OP_0, txscript.OP_DATA_32}, scriptHash[:]...)
+	outputs := randomOutputs(1)
+	outputs[0].Asset = network.Regtest.AssetID
+	outputs[0].Amount = 1000
+	outputs[0].BlindingKey = nil
+
+	ptx, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs: []wallet.Input{{
+			TxID:         randomHex(32),
+			TxIndex:      randomVout(),
+			Value:        1500,
+			Asset:        network.Regtest.AssetID,
+			Script:       script,
+			RedeemScript: witnessScript,
+		}},
+		Outputs: append(outputs, wallet.Output{
+			Asset:  network.Regtest.AssetID,
+			Amount: 500,
+		}),
+	})
+	require.NoError(t, err)
+	return ptx
+}
+
+func signWitnessScriptInput(
+	t *testing.T, psetBase64 string, key *btcec.PrivateKey,
+	witnessScript []byte,
+) string {
+	ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+	require.NoError(t, err)
+	tx, err := ptx.UnsignedTx()
+	require.NoError(t, err)
+
+	hash := tx.HashForWitnessV0(
+		0, witnessScript, ptx.Inputs[0].GetUtxo().Value, txscript.SigHashAll,
+	)
+	sig := ecdsa.Sign(key, hash[:]).Serialize()
+
+	signer, err := psetv2.NewSigner(ptx)
+	require.NoError(t, err)
+	err = signer.SignInput(
+		0, append(sig, byte(txscript.SigHashAll)),
+		key.PubKey().SerializeCompressed(), nil, witnessScript,
+	)
+	require.NoError(t, err)
+
+	signedPtx, err := ptx.ToBase64()
+	require.NoError(t, err)
+	return signedPtx
+}
+
+func checksigLeafScript(key *btcec.PrivateKey) []byte {
+	script, _ := txscript.NewScriptBuilder().
+		AddData(schnorr.SerializePubKey(key.PubKey())).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	return script
+}
+
+func newTapscriptPset(
+	t *testing.T, leafScripts ...[]byte,
+) (string, []psetv2.TapLeafScript) {
+	internalKey, _ := btcec.NewPrivateKey()
+	leaves := make([]taproot.TapElementsLeaf, 0, len(leafScripts))
+	for _, script := range leafScripts {
+		leaves = append(leaves, taproot.NewBaseTapElementsLeaf(script))
+	}
+	tree := taproot.AssembleTaprootScriptTree(leaves...)
+	taprootPay, err := payment.FromTaprootScriptTree(
+		internalKey.PubKey(), tree, &network.Regtest, nil,
+	)
+	require.NoError(t, err)
+
+	outputs := randomOutputs(1)
+	outputs[0].Asset = network.Regtest.AssetID
+	outputs[0].Amount = 1000
+	outputs[0].BlindingKey = nil
+	psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+		Inputs: []wallet.Input{{
+			TxID:    randomHex(32),
+			TxIndex: randomVout(),
+			Value:   1500,
+			Asset:   network.Regtest.AssetID,
+			Script:  taprootPay.Script,
+		}},
+		Outputs: append(outputs, wallet.Output{
+			Asset:  network.Regtest.AssetID,
+			Amount: 500,
+		}),
+	})
+	require.NoError(t, err)
+
+	ptx, _ := psetv2.NewPsetFromBase64(psetBase64)
+	updater, err := psetv2.NewUpdater(ptx)
+	require.NoError(t, err)
+	tapLeaves := make([]psetv2.TapLeafScript, 0, len(leafScripts))
+	for _, proof := range tree.LeafMerkleProofs {
+		leaf := psetv2.NewTapLeafScript(proof, internalKey.PubKey())
+		err := updater.AddInTapLeafScript(0, leaf)
+		require.NoError(t, err)
+		tapLeaves = append(tapLeaves, leaf)
+	}
+
+	psetBase64, err = ptx.ToBase64()
+	require.NoError(t, err)
+	return psetBase64, tapLeaves
+}
+
+func signTapscriptInput(
+	t *testing.T, psetBase64 string, key *btcec.PrivateKey,
+	leaf psetv2.TapLeafScript,
+) string {
+	ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+	require.NoError(t, err)
+	tx, err := ptx.UnsignedTx()
+	require.NoError(t, err)
+
+	prevout := ptx.Inputs[0].GetUtxo()
+	genesisBlockHash, _ := chainhash.NewHashFromStr(
+		network.Regtest.GenesisBlockHash,
+	)
+	leafHash := leaf.TapHash()
+	hash := tx.HashForWitnessV1(
+		0, [][]byte{prevout.Script}, [][]byte{prevout.Asset},
+		[][]byte{prevout.Value}, txscript.SigHashDefault, genesisBlockHash,
+		&leafHash, nil,
+	)
+	// schnorr.Sign negates odd keys in place, sign with a copy to not alter
+	// the keys shared with other (parallel) tests.
+	signingKey, _ := btcec.PrivKeyFromBytes(key.Serialize())
+	sig, err := schnorr.Sign(signingKey, hash[:])
+	require.NoError(t, err)
+
+	signer, err := psetv2.NewSigner(ptx)
+	require.NoError(t, err)
+	err = signer.SignTaprootInputTapscriptSig(0, psetv2.TapScriptSig{
+		PartialSig: psetv2.PartialSig{
+			PubKey:    schnorr.SerializePubKey(key.PubKey()),
+			Signature: sig.Serialize(),
+		},
+		LeafHash: leafHash[:],
+	})
+	require.NoError(t, err)
+
+	signedPtx, err := ptx.ToBase64()
+	require.NoError(t, err)
+	return signedPtx
+}
@@ -4,6 +4,14 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 )
 
+const (
+	// Sizes of the proofs of a blinded output or issuance.
+	rangeProofSize      = 4174
+	surjectionProofSize = 131
+	// Size of a schnorr signature with explicit sighash type.
+	schnorrSigSize = 65
+)
+
 var (
 	scripsigtSizeByScriptType = map[int]int{
 		P2PK:        140, // len + opcode + sig + opcode + pubkey uncompressed
@@ -12,6 +20,7 @@ var (
 		P2SH_P2WSH:  35,  // len + p2wsh script
 		P2WPKH:      1,   // no scriptsig, still len is serialized
 		P2WSH:       1,   // no scriptsig
+		P2TR:        1,   // no scriptsig
 	}
 )
 
@@ -23,48 +32,23 @@ var (
 // since the method is not able to retrieve the size of redeem script containg
 // all pubkeys, nor it expects anyone as arg.
 func EstimateTxSize(inputs []Input, outputs []Output) uint64 {
-	inScriptsigsSize, inWitnessesSize := make([]int, 0), make([]int, 0)
-	for _, in := range inputs {
-		inType := in.ScriptType()
-		scriptsigSize := in.ScriptSigSize
-		witnessSize := in.WitnessSize
-		if scriptsigSize <= 0 {
-			scriptsigSize = scripsigtSizeByScriptType[inType]
-		}
-		if witnessSize <= 0 {
-			if len(in.RedeemScript) > 0 {
-				_, m, _ := txscript.CalcMultiSigStats(in.RedeemScript)
-				// num of sigs + separators + size of redeem script
-				witnessSize = 75*m + m - 1 + varSliceSerializeSize(in.RedeemScript)
-			} else {
-				// len + witness[sig,pubkey]
-				witnessSize = (1 + 107)
-			}
-		}
-		// add no issuance proof + no token proof + no pegin
-		witnessSize += 1 + 1 + 1
-		inScriptsigsSize = append(inScriptsigsSize, scriptsigSize)
-		inWitnessesSize = append(inWitnessesSize, witnessSize)
-	}
-	outsSize, outWitnessesSize := make([]int, 0), make([]int, 0)
+	weight := estimateTxWeight(inputs, outputs)
+	return uint64((weight + 3) / 4)
+}
+
+// EstimateDiscountedTxSize is like EstimateTxSize but returns the discounted
+// virtual size introduced by Elements 23 for confidential transactions, as
+// calculated by GetDiscountVirtualTransactionSize.
+// Every confidential output weighs as much as its explicit version, ie. the
+// rangeproof and surjection proof are not taken into account, and neither is
+// the difference between the commitments of amount and nonce and their
+// explicit versions.
+func EstimateDiscountedTxSize(inputs []Input, outputs []Output) uint64 {
+	weight := estimateTxWeight(inputs, outputs)
 	for _, out := range outputs {
-		// no rangeproof + no surjectionproof
-		witnessSize := 1 + 1
-		// asset + amount + empty noce
-		outSize := 33 + 9 + 1
-		if out.IsConfidential() {
-			outSize = 33 + 33 + 33
-			// size(rangeproof) + proof + size(sujectionproof) + proof
-			witnessSize = (3 + 4174 + 1 + 131)
-		}
-		outsSize = append(outsSize, outSize+out.ScriptSize())
-		outWitnessesSize = append(outWitnessesSize, witnessSize)
+		weight -= outputDiscount(out)
 	}
-
-	txSize := estimateTxSize(
-		inScriptsigsSize, inWitnessesSize, outsSize, outWitnessesSize,
-	)
-	return uint64(txSize)
+	return uint64((weight + 3) / 4)
 }
 
 // EstimateFees estimates the virtual size of the transaciton composed of the
@@ -74,31 +58,199 @@ func EstimateFees(
 	inputs []Input, outputs []Output, millisatsPerByte uint64,
 ) uint64 {
 	txSize := EstimateTxSize(inputs, outputs)
-	satsPerByte := float64(millisatsPerByte) / 1000
-	return uint64(float64(txSize) * satsPerByte)
+	return calcFeeAmount(txSize, millisatsPerByte)
 }
 
-func estimateTxSize(
-	inScripsigsSize, inWitnessesSize, outsSize, outWitnessesSize []int,
-) int {
+// EstimateDiscountedFees is like EstimateFees but uses the discounted virtual
+// size of the transaction. See EstimateDiscountedTxSize.
+func EstimateDiscountedFees(
+	inputs []Input, outputs []Output, millisatsPerByte uint64,
+) uint64 {
+	txSize := EstimateDiscountedTxSize(inputs, outputs)
+	return calcFeeAmount(txSize, millisatsPerByte)
+}
+
+// EstimateInputSize returns the virtual size that the given input adds to
+// the transaction spending it.
+func EstimateInputSize(in Input) uint64 {
+	inSize, witnessSize := inputSizes(in)
+	return uint64((inSize*4 + witnessSize + 3) / 4)
+}
+
+// EstimateOutputSize returns the virtual size that the given output adds to
+// the transaction including it.
+func EstimateOutputSize(out Output) uint64 {
+	outSize, witnessSize := outputSizes(out)
+	return uint64((outSize*4 + witnessSize + 3) / 4)
+}
+
+// EstimateDiscountedOutputSize is like EstimateOutputSize but returns the
+// discounted virtual size of the output. See EstimateDiscountedTxSize.
+func EstimateDiscountedOutputSize(out Output) uint64 {
+	outSize, witnessSize := outputSizes(out)
+	return uint64((outSize*4 + witnessSize - outputDiscount(out) + 3) / 4)
+}
+
+// inputSizes returns the non-witness and witness serialization sizes of the
+// given input.
+func inputSizes(in Input) (int, int) {
+	scriptsigSize := in.ScriptSigSize
+	witnessSize := in.WitnessSize
+	if scriptsigSize <= 0 {
+		scriptsigSize = scripsigtSizeByScriptType[in.ScriptType()]
+	}
+	if witnessSize <= 0 {
+		switch {
+		case in.ScriptType() == P2TR:
+			witnessSize = taprootWitnessSize(in)
+		case len(in.RedeemScript) > 0:
+			_, m, _ := txscript.CalcMultiSigStats(in.RedeemScript)
+			// num of sigs + separators + size of redeem script
+			witnessSize = 75*m + m - 1 + varSliceSerializeSize(in.RedeemScript)
+		default:
+			// len + witness[sig,pubkey]
+			witnessSize = (1 + 107)
+		}
+	}
+
+	// hash + index + scriptsig + sequence
+	inSize := 32 + 4 + scriptsigSize + 4
+	issuanceSize, issuanceWitnessSize := issuanceSizes(in.Issuance)
+	inSize += issuanceSize
+	witnessSize += issuanceWitnessSize
+	witnessSize += peginWitnessSize(in.PeginWitness)
+	return inSize, witnessSize
+}
+
+// taprootWitnessSize returns the size of the witness of a taproot input,
+// either a key-path spend, or a script-path one if the leaf script and
+// control block are known. In the latter case, a signature is expected for
+// every signature opcode of the leaf script.
+func taprootWitnessSize(in Input) int {
+	if len(in.TapLeafScript) <= 0 {
+		// len + witness[sig]
+		return 1 + 1 + schnorrSigSize
+	}
+
+	numOfSigs := 0
+	tokenizer := txscript.MakeScriptTokenizer(0, in.TapLeafScript)
+	for tokenizer.Next() {
+		switch tokenizer.Opcode() {
+		case txscript.OP_CHECKSIG, txscript.OP_CHECKSIGVERIFY,
+			txscript.OP_CHECKSIGADD:
+			numOfSigs++
+		}
+	}
+	// len + witness[sigs..., script, control block]
+	return varIntSerializeSize(uint64(numOfSigs+2)) +
+		numOfSigs*(1+schnorrSigSize) +
+		varSliceSerializeSize(in.TapLeafScript) +
+		varSliceSerializeSize(in.TapControlBlock)
+}
+
+// issuanceSizes returns the non-witness and witness serialization sizes of
+// the given input issuance. The former is 0 without issuance, while the
+// latter always includes the empty issuance and token rangeproofs.
+func issuanceSizes(issuance *InputIssuance) (int, int) {
+	if issuance == nil {
+		// no issuance proof + no token proof
+		return 0, 1 + 1
+	}
+
+	amountSize := func(amount uint64) int {
+		if amount == 0 {
+			return 1
+		}
+		if issuance.Blinded {
+			return 33
+		}
+		return 9
+	}
+	proofSize := func(amount uint64) int {
+		if amount == 0 || !issuance.Blinded {
+			return 1
+		}
+		return varIntSerializeSize(rangeProofSize) + rangeProofSize
+	}
+
+	// blinding nonce + entropy + asset amount + token amount
+	size := 32 + 32 +
+		amountSize(issuance.AssetAmount) + amountSize(issuance.TokenAmount)
+	witnessSize := proofSize(issuance.AssetAmount) +
+		proofSize(issuance.TokenAmount)
+	return size, witnessSize
+}
+
+// peginWitnessSize returns the size of the given peg-in witness stack, or of
+// an empty one if not defined.
+func peginWitnessSize(witness [][]byte) int {
+	size := varIntSerializeSize(uint64(len(witness)))
+	for _, item := range witness {
+		size += varSliceSerializeSize(item)
+	}
+	return size
+}
+
+func outputSizes(out Output) (int, int) {
+	// no rangeproof + no surjectionproof
+	witnessSize := 1 + 1
+	// asset + amount + empty noce
+	outSize := 33 + 9 + 1
+	if out.IsConfidential() {
+		outSize = 33 + 33 + 33
+		// size(rangeproof) + proof + size(sujectionproof) + proof
+		witnessSize = varIntSerializeSize(rangeProofSize) + rangeProofSize +
+			varIntSerializeSize(surjectionProofSize) + surjectionProofSize
+	}
+	return outSize + out.ScriptSize(), witnessSize
+}
+
+// outputDiscount returns the weight discounted for the given output, that is
+// 0 if not confidential.
+func outputDiscount(out Output) int {
+	if !out.IsConfidential() {
+		return 0
+	}
+	_, witnessSize := outputSizes(out)
+	// proofs except for the 2 bytes serializing them as empty + value
+	// commitment vs explicit value + nonce commitment vs empty nonce
+	return (witnessSize - 2) + (33-9)*4 + (33-1)*4
+}
+
+func estimateTxWeight(inputs []Input, outputs []Output) int {
+	insSize, inWitnessesSize := make([]int, 0), make([]int, 0)
+	for _, in := range inputs {
+		inSize, witnessSize := inputSizes(in)
+		insSize = append(insSize, inSize)
+		inWitnessesSize = append(inWitnessesSize, witnessSize)
+	}
+	outsSize, outWitnessesSize := make([]int, 0), make([]int, 0)
+	for _, out := range outputs {
+		outSize, witnessSize := outputSizes(out)
+		outsSize = append(outsSize, outSize)
+		outWitnessesSize = append(outWitnessesSize, witnessSize)
+	}
+
 	baseSize := calcTxSize(
-		false, inScripsigsSize, inWitnessesSize, outsSize, outWitnessesSize,
+		false, insSize, inWitnessesSize, outsSize, outWitnessesSize,
 	)
 	totalSize := calcTxSize(
-		true, inScripsigsSize, inWitnessesSize, outsSize, outWitnessesSize,
+		true, insSize, inWitnessesSize, outsSize, outWitnessesSize,
 	)
 
-	weight := baseSize*3 + totalSize
-	vsize := (weight + 3) / 4
+	return baseSize*3 + totalSize
+}
 
-	return vsize
+func calcFeeAmount(txSize, millisatsPerByte uint64) uint64 {
+	satsPerByte := float64(millisatsPerByte) / 1000
+	return uint64(float64(txSize) * satsPerByte)
 }
 
 func calcTxSize(
 	withWitness bool,
-	inScripsigsSize, inWitnessesSize, outsSize, outWitnessesSize []int,
+	insSize, inWitnessesSize, outsSize, outWitnessesSize []int,
 ) int {
-	txSize := calcTxBaseSize(inScripsigsSize, outsSize)
+	txSize := calcTxBaseSize(insSize, outsSize)
 	if withWitness {
 		txSize += calcTxWitnessSize(inWitnessesSize, outWitnessesSize)
 	}
@@ -106,13 +258,11 @@ func calcTxSize(
 }
 
 func calcTxBaseSize(
-	inScripsigsSize, outNonWitnessesSize []int,
+	inNonWitnessesSize, outNonWitnessesSize []int,
 ) int {
-	// hash + index + sequence
-	inBaseSize := 40
 	insSize := 0
-	for _, scriptSigSize := range inScripsigsSize {
-		insSize += inBaseSize + scriptSigSize
+	for _, inSize := range inNonWitnessesSize {
+		insSize += inSize
 	}
 
 	outsSize := 0
@@ -124,7 +274,7 @@ func calcTxBaseSize(
 	outsSize += 33 + 9 + 1 + 1
 
 	return 9 +
-		varIntSerializeSize(uint64(len(inScripsigsSize))) +
+		varIntSerializeSize(uint64(len(inNonWitnessesSize))) +
 		varIntSerializeSize(uint64(len(outNonWitnessesSize)+1)) +
 		insSize + outsSize
 }
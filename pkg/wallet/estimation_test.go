@@ -2,9 +2,13 @@ package wallet_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"os"
 	"testing"
 
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/transaction"
 	"github.com/vulpemventures/ocean/pkg/wallet"
 )
 
@@ -90,6 +94,331 @@ func TestEstimateTxSize(t *testing.T) {
 	}
 }
 
+func TestEstimateDiscountedTxSize(t *testing.T) {
+	p2wpkhIn := wallet.Input{Script: h2b("00140b51f5036527f61a234015ed3bdc84497793b26d")}
+	p2wpkhScript := h2b("00148fcf009ef09cad277621239c3cacdb57d292030c")
+	confOut := wallet.Output{Script: p2wpkhScript, BlindingKey: make([]byte, 33)}
+	unconfOut := wallet.Output{Script: p2wpkhScript}
+
+	tests := []struct {
+		name           string
+		inputs         []wallet.Input
+		outputs        []wallet.Output
+		explicitOutput []wallet.Output
+		expectedSize   uint64
+	}{
+		{
+			name:           "confidential outputs",
+			inputs:         []wallet.Input{p2wpkhIn},
+			outputs:        []wallet.Output{confOut, confOut},
+			explicitOutput: []wallet.Output{unconfOut, unconfOut},
+			expectedSize:   258,
+		},
+		{
+			name:           "mixed outputs",
+			inputs:         []wallet.Input{p2wpkhIn, p2wpkhIn},
+			outputs:        []wallet.Output{confOut, unconfOut},
+			explicitOutput: []wallet.Output{unconfOut, unconfOut},
+			expectedSize:   326,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := wallet.EstimateDiscountedTxSize(tt.inputs, tt.outputs)
+			require.Equal(t, tt.expectedSize, size)
+			require.Less(t, size, wallet.EstimateTxSize(tt.inputs, tt.outputs))
+			// Confidential outputs weigh as much as explicit ones.
+			require.Equal(
+				t, wallet.EstimateTxSize(tt.inputs, tt.explicitOutput), size,
+			)
+		})
+	}
+
+	t.Run("unconfidential outputs", func(t *testing.T) {
+		ins := []wallet.Input{p2wpkhIn}
+		outs := []wallet.Output{unconfOut, unconfOut}
+		require.Equal(
+			t, wallet.EstimateTxSize(ins, outs),
+			wallet.EstimateDiscountedTxSize(ins, outs),
+		)
+	})
+
+	t.Run("output size", func(t *testing.T) {
+		require.Equal(
+			t, wallet.EstimateOutputSize(unconfOut),
+			wallet.EstimateDiscountedOutputSize(confOut),
+		)
+		require.Equal(
+			t, wallet.EstimateOutputSize(unconfOut),
+			wallet.EstimateDiscountedOutputSize(unconfOut),
+		)
+	})
+
+	// 1 P2WPKH input and 2 confidential P2WPKH outputs.
+	t.Run("elements tx", func(t *testing.T) {
+		tx, err := transaction.NewTxFromHex(testTxs(t)["p2wpkhConfidential"])
+		require.NoError(t, err)
+
+		ins := []wallet.Input{p2wpkhIn}
+		size := wallet.EstimateDiscountedTxSize(ins, estimationOutputs(tx))
+		require.Equal(t, uint64(258), size)
+		// The estimation expects 72-byte signatures, at most 1 byte bigger
+		// than the actual ones.
+		expectedSize := discountVirtualSize(tx)
+		require.GreaterOrEqual(t, size, expectedSize)
+		require.LessOrEqual(t, size, expectedSize+1)
+	})
+}
+
+func TestEstimateTxSizeSpecialInputs(t *testing.T) {
+	p2wpkhScript := h2b("00140b51f5036527f61a234015ed3bdc84497793b26d")
+	p2trScript := h2b("51201dd2d73d7cc3cde9c07e67c4dd0b0f22a4e8d79efa1a8e3bc7d1e2c0b5f8f5a2")
+	p2wshScript := h2b("0020c2b6e7c87a7f44c947ba95cf2c4c6444d32ca344780997f9d139b6a28e92f87e")
+	p2wpkhWitness := transaction.TxWitness{make([]byte, 72), make([]byte, 33)}
+	leafScript := append(
+		append([]byte{txscript.OP_DATA_32}, make([]byte, 32)...),
+		txscript.OP_CHECKSIG,
+	)
+	// control byte + internal key + 1 merkle path node
+	controlBlock := make([]byte, 33+32)
+	peginWitness := [][]byte{
+		make([]byte, 8),   // value
+		make([]byte, 32),  // asset
+		make([]byte, 32),  // genesis block hash
+		make([]byte, 22),  // claim script
+		make([]byte, 226), // mainchain tx
+		make([]byte, 151), // txout proof
+	}
+	confOut := wallet.Output{
+		Script: h2b("00148fcf009ef09cad277621239c3cacdb57d292030c"), BlindingKey: make([]byte, 33),
+	}
+
+	tests := []struct {
+		name    string
+		input   wallet.Input
+		txInput *transaction.TxInput
+	}{
+		{
+			name:  "taproot key-path",
+			input: wallet.Input{Script: p2trScript},
+			txInput: &transaction.TxInput{
+				Witness: transaction.TxWitness{make([]byte, 65)},
+			},
+		},
+		{
+			name: "taproot script-path",
+			input: wallet.Input{
+				Script:          p2trScript,
+				TapLeafScript:   leafScript,
+				TapControlBlock: controlBlock,
+			},
+			txInput: &transaction.TxInput{
+				Witness: transaction.TxWitness{
+					make([]byte, 65), leafScript, controlBlock,
+				},
+			},
+		},
+		{
+			name: "blinded issuance",
+			input: wallet.Input{
+				Script: p2wpkhScript,
+				Issuance: &wallet.InputIssuance{
+					AssetAmount: 1000, TokenAmount: 1, Blinded: true,
+				},
+			},
+			txInput: &transaction.TxInput{
+				Witness: p2wpkhWitness,
+				Issuance: &transaction.TxIssuance{
+					AssetBlindingNonce: make([]byte, 32),
+					AssetEntropy:       make([]byte, 32),
+					AssetAmount:        make([]byte, 33),
+					TokenAmount:        make([]byte, 33),
+				},
+				IssuanceRangeProof:  make([]byte, 4174),
+				InflationRangeProof: make([]byte, 4174),
+			},
+		},
+		{
+			name: "unblinded reissuance",
+			input: wallet.Input{
+				Script:   p2wpkhScript,
+				Issuance: &wallet.InputIssuance{AssetAmount: 1000},
+			},
+			txInput: &transaction.TxInput{
+				Witness: p2wpkhWitness,
+				Issuance: &transaction.TxIssuance{
+					AssetBlindingNonce: make([]byte, 32),
+					AssetEntropy:       make([]byte, 32),
+					AssetAmount:        make([]byte, 9),
+					TokenAmount:        make([]byte, 1),
+				},
+			},
+		},
+		{
+			name: "peg-in claim",
+			input: wallet.Input{
+				Script:       p2wpkhScript,
+				PeginWitness: peginWitness,
+			},
+			txInput: &transaction.TxInput{
+				Witness:      p2wpkhWitness,
+				IsPegin:      true,
+				PeginWitness: peginWitness,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := transaction.NewTx(2)
+			tt.txInput.Hash = make([]byte, 32)
+			tt.txInput.Sequence = transaction.DefaultSequence
+			tx.AddInput(tt.txInput)
+			tx.AddOutput(&transaction.TxOutput{
+				Asset:           make([]byte, 33),
+				Value:           make([]byte, 33),
+				Nonce:           make([]byte, 33),
+				Script:          confOut.Script,
+				RangeProof:      make([]byte, 4174),
+				SurjectionProof: make([]byte, 131),
+			})
+			tx.AddOutput(&transaction.TxOutput{
+				Asset: make([]byte, 33),
+				Value: make([]byte, 9),
+				Nonce: make([]byte, 1),
+			})
+
+			size := wallet.EstimateTxSize(
+				[]wallet.Input{tt.input}, []wallet.Output{confOut},
+			)
+			require.Equal(t, uint64(tx.VirtualSize()), size)
+		})
+	}
+
+	// The estimations of the real txs are expected to exceed the actual sizes
+	// only of the few bytes of the signatures that are shorter than the
+	// expected ones.
+	txs := testTxs(t)
+	elementsTests := []struct {
+		name    string
+		txHex   string
+		input   func(in *transaction.TxInput) wallet.Input
+		maxDiff uint64
+	}{
+		{
+			name:  "elements taproot key-path",
+			txHex: txs["p2trKeyPath"],
+			input: func(*transaction.TxInput) wallet.Input {
+				return wallet.Input{Script: p2trScript}
+			},
+		},
+		{
+			name:  "elements taproot script-path",
+			txHex: txs["p2trScriptPath"],
+			input: func(in *transaction.TxInput) wallet.Input {
+				return wallet.Input{
+					Script:          p2trScript,
+					TapLeafScript:   in.Witness[1],
+					TapControlBlock: in.Witness[2],
+				}
+			},
+		},
+		{
+			name:  "elements blinded issuance",
+			txHex: txs["blindedIssuance"],
+			input: func(in *transaction.TxInput) wallet.Input {
+				return wallet.Input{
+					Script:       p2wshScript,
+					RedeemScript: in.Witness[len(in.Witness)-1],
+					Issuance: &wallet.InputIssuance{
+						AssetAmount: 1000, TokenAmount: 1, Blinded: true,
+					},
+				}
+			},
+			maxDiff: 1,
+		},
+		{
+			name:  "elements peg-in claim",
+			txHex: txs["peginClaim"],
+			input: func(in *transaction.TxInput) wallet.Input {
+				return wallet.Input{
+					Script:       p2wpkhScript,
+					PeginWitness: in.PeginWitness,
+				}
+			},
+		},
+	}
+	for _, tt := range elementsTests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := transaction.NewTxFromHex(tt.txHex)
+			require.NoError(t, err)
+			require.Len(t, tx.Inputs, 1)
+
+			size := wallet.EstimateTxSize(
+				[]wallet.Input{tt.input(tx.Inputs[0])}, estimationOutputs(tx),
+			)
+			expectedSize := uint64(tx.VirtualSize())
+			require.GreaterOrEqual(t, size, expectedSize)
+			require.LessOrEqual(t, size, expectedSize+tt.maxDiff)
+		})
+	}
+}
+
+// testTxs returns the Elements txs used as test vectors, indexed by name.
+// They're taken from the go-elements test vectors, except for the taproot
+// script-path spend that was signed on regtest.
+func testTxs(t *testing.T) map[string]string {
+	buf, err := os.ReadFile("testdata/txs.json")
+	require.NoError(t, err)
+	txs := make(map[string]string)
+	require.NoError(t, json.Unmarshal(buf, &txs))
+	return txs
+}
+
+// estimationOutputs returns the outputs to estimate the size of the given tx,
+// excluding the fee one.
+func estimationOutputs(tx *transaction.Transaction) []wallet.Output {
+	outs := make([]wallet.Output, 0, len(tx.Outputs))
+	for _, out := range tx.Outputs {
+		if len(out.Script) <= 0 {
+			continue
+		}
+		var blindingKey []byte
+		if len(out.Value) == 33 {
+			blindingKey = make([]byte, 33)
+		}
+		outs = append(outs, wallet.Output{Script: out.Script, BlindingKey: blindingKey})
+	}
+	return outs
+}
+
+// discountVirtualSize returns the virtual size of the given tx as calculated
+// by GetDiscountVirtualTransactionSize of Elements.
+func discountVirtualSize(tx *transaction.Transaction) uint64 {
+	varSliceSize := func(buf []byte) int {
+		size := len(buf)
+		switch {
+		case size < 0xfd:
+			return 1 + size
+		case size <= 0xffff:
+			return 3 + size
+		default:
+			return 5 + size
+		}
+	}
+
+	weight := tx.Weight()
+	for _, out := range tx.Outputs {
+		weight -= varSliceSize(out.RangeProof) + varSliceSize(out.SurjectionProof) - 2
+		if len(out.Value) == 33 {
+			weight -= (33 - 9) * 4
+		}
+		if len(out.Nonce) == 33 {
+			weight -= (33 - 1) * 4
+		}
+	}
+	return uint64((weight + 3) / 4)
+}
+
 func h2b(str string) []byte {
 	buf, _ := hex.DecodeString(str)
 	return buf
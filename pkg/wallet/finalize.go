@@ -0,0 +1,381 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/vulpemventures/go-elements/psetv2"
+)
+
+var (
+	ErrFinalizeMissingSignatures = fmt.Errorf("input script can't be satisfied with the available signatures")
+	ErrFinalizeUnsupportedScript = fmt.Errorf("input script is not a supported template")
+)
+
+type FinalizePsetArgs struct {
+	PsetBase64 string
+}
+
+func (a FinalizePsetArgs) validate() error {
+	if a.PsetBase64 == "" {
+		return ErrMissingPset
+	}
+	if _, err := psetv2.NewPsetFromBase64(a.PsetBase64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FinalizePset finalizes all the inputs of the given partial transaction that
+// are not finalized yet.
+// Other than the standard single-sig, multisig and taproot key-path inputs,
+// segwit and tapscript inputs locked by any template script without branches,
+// like timelocked or hashlocked multi-party scripts, are finalized by
+// satisfying every key checked by the script with its signature, and every
+// hash with its preimage. Only the keys of OP_CHECKSIGADD thresholds can be
+// left unsigned, as long as the threshold is reached, otherwise an error is
+// returned and the input is left untouched.
+func FinalizePset(args FinalizePsetArgs) (string, error) {
+	if err := args.validate(); err != nil {
+		return "", err
+	}
+
+	ptx, _ := psetv2.NewPsetFromBase64(args.PsetBase64)
+	if err := finalizeInputs(ptx); err != nil {
+		return "", err
+	}
+	return ptx.ToBase64()
+}
+
+func finalizeInputs(ptx *psetv2.Pset) error {
+	for i, in := range ptx.Inputs {
+		if len(in.FinalScriptSig) > 0 || len(in.FinalScriptWitness) > 0 {
+			continue
+		}
+
+		var err error
+		prevout := in.GetUtxo()
+		switch {
+		case prevout != nil && txscript.IsPayToTaproot(prevout.Script) &&
+			len(in.TapKeySig) <= 0:
+			err = finalizeTapscriptInput(&ptx.Inputs[i])
+		case len(in.WitnessScript) > 0 && len(in.RedeemScript) <= 0 &&
+			!isMultisigScript(in.WitnessScript):
+			err = finalizeWitnessScriptInput(&ptx.Inputs[i])
+		default:
+			err = psetv2.Finalize(ptx, i)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to finalize input %d: %w", i, err)
+		}
+	}
+	return ptx.SanityCheck()
+}
+
+// finalizeWitnessScriptInput finalizes a native segwit input locked by a
+// template witness script.
+func finalizeWitnessScriptInput(in *psetv2.Input) error {
+	sighashType := in.SigHashType
+	if sighashType == 0 {
+		sighashType = txscript.SigHashAll
+	}
+	sigsByKey := make(map[string][]byte)
+	for _, sig := range in.PartialSigs {
+		if txscript.SigHashType(sig.Signature[len(sig.Signature)-1]) != sighashType {
+			return psetv2.ErrFinalizerInvalidSigHashFlags
+		}
+		sigsByKey[hex.EncodeToString(sig.PubKey)] = sig.Signature
+	}
+
+	witness, err := satisfyScript(in, in.WitnessScript, sigsByKey)
+	if err != nil {
+		return err
+	}
+	witness = append(witness, in.WitnessScript)
+
+	in.FinalScriptWitness, err = serializeWitness(witness)
+	if err != nil {
+		return err
+	}
+	in.PartialSigs = nil
+	return nil
+}
+
+// finalizeTapscriptInput finalizes a taproot input via script-path, using the
+// first leaf script, from the one for which most of the signatures are
+// available, that can be satisfied.
+func finalizeTapscriptInput(in *psetv2.Input) error {
+	type candidate struct {
+		leaf      *psetv2.TapLeafScript
+		sigsByKey map[string][]byte
+	}
+	candidates := make([]candidate, 0, len(in.TapLeafScript))
+	for i, l := range in.TapLeafScript {
+		leafHash := l.TapHash()
+		sigs := make(map[string][]byte)
+		for _, sig := range in.TapScriptSig {
+			if bytes.Equal(sig.LeafHash, leafHash[:]) {
+				sigs[hex.EncodeToString(sig.PubKey)] = sig.Signature
+			}
+		}
+		if len(sigs) > 0 {
+			candidates = append(candidates, candidate{&in.TapLeafScript[i], sigs})
+		}
+	}
+	if len(candidates) <= 0 {
+		return ErrFinalizeMissingSignatures
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].sigsByKey) > len(candidates[j].sigsByKey)
+	})
+
+	var err error
+	for _, c := range candidates {
+		var witness [][]byte
+		witness, err = satisfyScript(in, c.leaf.Script, c.sigsByKey)
+		if err != nil {
+			continue
+		}
+		controlBlock, err := c.leaf.ControlBlock.ToBytes()
+		if err != nil {
+			return err
+		}
+		witness = append(witness, c.leaf.Script, controlBlock)
+
+		in.FinalScriptWitness, err = serializeWitness(witness)
+		return err
+	}
+	return err
+}
+
+// satisfyScript returns the witness stack, without the script, that satisfies
+// the given template script. Every key checked by the script is replaced with
+// its signature and every hash with its preimage, and the elements are then
+// ordered so that the first one consumed by the script is at the top of the
+// stack.
+// All keys must be signed, except those of a threshold made with
+// OP_CHECKSIGADD, for which empty signatures are pushed as long as the
+// threshold is reached. Scripts with OP_CHECKMULTISIG are not supported since
+// their stack doesn't map to the keys.
+func satisfyScript(
+	in *psetv2.Input, script []byte, sigsByKey map[string][]byte,
+) ([][]byte, error) {
+	tokens, err := parseScript(script)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([][]byte, 0, len(tokens))
+	// Indexes of the elements of the current OP_CHECKSIGADD threshold.
+	thresholdSigs := make([]int, 0)
+	signed := false
+	for i, tok := range tokens {
+		switch tok.opcode {
+		case txscript.OP_IF, txscript.OP_NOTIF, txscript.OP_ELSE,
+			txscript.OP_ENDIF, txscript.OP_VERIF, txscript.OP_VERNOTIF:
+			// The branch to satisfy can't be chosen on behalf of the signers.
+			return nil, fmt.Errorf(
+				"%w: template script with branches", ErrFinalizeUnsupportedScript,
+			)
+		case txscript.OP_CHECKMULTISIG, txscript.OP_CHECKMULTISIGVERIFY:
+			return nil, fmt.Errorf(
+				"%w: template script with OP_CHECKMULTISIG", ErrFinalizeUnsupportedScript,
+			)
+		case txscript.OP_NUMEQUAL, txscript.OP_NUMEQUALVERIFY,
+			txscript.OP_GREATERTHANOREQUAL:
+			if len(thresholdSigs) <= 0 {
+				continue
+			}
+			threshold, err := tokens[i-1].number()
+			if err != nil {
+				return nil, err
+			}
+			if err := satisfyThreshold(
+				elements, thresholdSigs, threshold,
+				tok.opcode != txscript.OP_GREATERTHANOREQUAL,
+			); err != nil {
+				return nil, err
+			}
+			signed = signed || threshold > 0
+			thresholdSigs = thresholdSigs[:0]
+			continue
+		}
+		if tok.data == nil {
+			continue
+		}
+
+		next := opcodeAt(tokens, i+1)
+		switch {
+		case isHashOpcode(opcodeAt(tokens, i-1)):
+			preimage, ok := findPreimage(in, tok.data)
+			if !ok {
+				return nil, fmt.Errorf(
+					"%w: missing preimage of hash %x",
+					ErrFinalizeMissingSignatures, tok.data,
+				)
+			}
+			elements = append(elements, preimage)
+		case next == txscript.OP_CHECKSIGADD ||
+			next == txscript.OP_CHECKSIG &&
+				opcodeAt(tokens, i+3) == txscript.OP_CHECKSIGADD:
+			thresholdSigs = append(thresholdSigs, len(elements))
+			elements = append(elements, sigsByKey[hex.EncodeToString(tok.data)])
+		case next == txscript.OP_CHECKSIG || next == txscript.OP_CHECKSIGVERIFY:
+			sig, ok := sigsByKey[hex.EncodeToString(tok.data)]
+			if !ok {
+				return nil, fmt.Errorf(
+					"%w: missing signature for key %x",
+					ErrFinalizeMissingSignatures, tok.data,
+				)
+			}
+			elements = append(elements, sig)
+			signed = true
+		}
+	}
+	if len(thresholdSigs) > 0 {
+		return nil, fmt.Errorf(
+			"%w: OP_CHECKSIGADD without threshold", ErrFinalizeUnsupportedScript,
+		)
+	}
+	if !signed {
+		return nil, ErrFinalizeMissingSignatures
+	}
+
+	witness := make([][]byte, 0, len(elements))
+	for i := len(elements) - 1; i >= 0; i-- {
+		witness = append(witness, elements[i])
+	}
+	return witness, nil
+}
+
+// satisfyThreshold makes sure that enough signatures are available among the
+// given elements of an OP_CHECKSIGADD threshold. If the number of signatures
+// must exactly match the threshold, the exceeding ones are replaced with
+// empty ones.
+func satisfyThreshold(
+	elements [][]byte, indexes []int, threshold int64, exact bool,
+) error {
+	count := int64(0)
+	for _, i := range indexes {
+		if len(elements[i]) <= 0 {
+			continue
+		}
+		if count >= threshold && exact {
+			elements[i] = nil
+			continue
+		}
+		count++
+	}
+	if count < threshold {
+		return fmt.Errorf(
+			"%w: got %d signatures out of %d required",
+			ErrFinalizeMissingSignatures, count, threshold,
+		)
+	}
+	return nil
+}
+
+type scriptToken struct {
+	opcode byte
+	data   []byte
+}
+
+// number returns the script number pushed by the token.
+func (t scriptToken) number() (int64, error) {
+	if t.opcode >= txscript.OP_1 && t.opcode <= txscript.OP_16 {
+		return int64(t.opcode - (txscript.OP_1 - 1)), nil
+	}
+	if t.data == nil || len(t.data) > 4 {
+		return 0, fmt.Errorf(
+			"%w: invalid threshold", ErrFinalizeUnsupportedScript,
+		)
+	}
+	num := int64(0)
+	for i, b := range t.data {
+		num |= int64(b) << (8 * i)
+	}
+	if t.data[len(t.data)-1]&0x80 != 0 {
+		num &= ^(int64(0x80) << (8 * (len(t.data) - 1)))
+		num = -num
+	}
+	return num, nil
+}
+
+// parseScript returns the list of opcodes of the given script, along with
+// their data pushes, if any.
+func parseScript(script []byte) ([]scriptToken, error) {
+	tokens := make([]scriptToken, 0)
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		tokens = append(tokens, scriptToken{
+			opcode: tokenizer.Opcode(),
+			data:   tokenizer.Data(),
+		})
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func opcodeAt(tokens []scriptToken, i int) byte {
+	if i < 0 || i >= len(tokens) {
+		return txscript.OP_INVALIDOPCODE
+	}
+	return tokens[i].opcode
+}
+
+func isHashOpcode(opcode byte) bool {
+	switch opcode {
+	case txscript.OP_SHA256, txscript.OP_HASH256, txscript.OP_HASH160,
+		txscript.OP_RIPEMD160:
+		return true
+	default:
+		return false
+	}
+}
+
+func findPreimage(in *psetv2.Input, hash []byte) ([]byte, bool) {
+	switch len(hash) {
+	case 20:
+		var h [20]byte
+		copy(h[:], hash)
+		if preimage, ok := in.Hash160Preimages[h]; ok {
+			return preimage, true
+		}
+		preimage, ok := in.Ripemd160Preimages[h]
+		return preimage, ok
+	case 32:
+		var h [32]byte
+		copy(h[:], hash)
+		if preimage, ok := in.Sha256Preimages[h]; ok {
+			return preimage, true
+		}
+		preimage, ok := in.Hash256Preimages[h]
+		return preimage, ok
+	default:
+		return nil, false
+	}
+}
+
+func isMultisigScript(script []byte) bool {
+	isMultisig, _ := txscript.IsMultisigScript(script)
+	return isMultisig
+}
+
+func serializeWitness(witness [][]byte) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := wire.WriteVarInt(buf, 0, uint64(len(witness))); err != nil {
+		return nil, err
+	}
+	for _, w := range witness {
+		if err := wire.WriteVarBytes(buf, 0, w); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -16,6 +16,7 @@ const (
 	P2SH_P2WSH
 	P2WPKH
 	P2WSH
+	P2TR
 )
 
 var (
@@ -24,12 +25,43 @@ var (
 		address.P2ShScript:   P2SH_P2WPKH,
 		address.P2WpkhScript: P2WPKH,
 		address.P2WshScript:  P2WSH,
+		address.P2TRScript:   P2TR,
 	}
 )
 
+// RBFSequence is the highest input sequence signaling that the tx opts in to
+// be replaced by another one paying higher fees (BIP-125).
+const RBFSequence = uint32(0xfffffffd)
+
+// LockTimeSequence is the highest input sequence enabling the locktime of the
+// tx without signaling replaceability.
+const LockTimeSequence = uint32(0xfffffffe)
+
+// FinalSequence is the sequence of a final input. The locktime of a tx whose
+// inputs are all final is ignored.
+const FinalSequence = uint32(0xffffffff)
+
+const (
+	// sequenceDisableFlag disables the relative timelock of an input (BIP-68).
+	sequenceDisableFlag = uint32(1 << 31)
+	// sequenceTypeFlag makes the relative timelock of an input be expressed in
+	// units of 512 seconds rather than in blocks (BIP-68).
+	sequenceTypeFlag = uint32(1 << 22)
+	// sequenceLockMask is the mask of the value of a relative timelock.
+	sequenceLockMask = uint32(0x0000ffff)
+)
+
 var (
-	ErrInputMissingTxid = fmt.Errorf("input is missing txid")
-	ErrInputInvalidTxid = fmt.Errorf("invalid input txid length: must be exactly 32 bytes")
+	ErrInputMissingTxid     = fmt.Errorf("input is missing txid")
+	ErrInputInvalidTxid     = fmt.Errorf("invalid input txid length: must be exactly 32 bytes")
+	ErrInputInvalidSequence = fmt.Errorf(
+		"invalid input sequence: a relative timelock must be expressed with " +
+			"only the type flag and the 16 lower bits set",
+	)
+	ErrInputZeroSequence = fmt.Errorf(
+		"invalid input sequence: 0 is not supported because a pset can't " +
+			"encode it and would make the input final",
+	)
 )
 
 // Input is the data structure representing an input to be added to a partial
@@ -53,6 +85,29 @@ type Input struct {
 	RedeemScript    []byte
 	ScriptSigSize   int
 	WitnessSize     int
+	// TapLeafScript and TapControlBlock are used to estimate the size of a
+	// taproot script-path spend. Without them, a key-path spend is assumed.
+	TapLeafScript   []byte
+	TapControlBlock []byte
+	// Issuance describes the (re)issuance attached to the input, if any.
+	Issuance *InputIssuance
+	// PeginWitness is the witness stack of a peg-in claim input.
+	PeginWitness [][]byte
+	// Sequence is the nSequence of the input. If nil, the input is final,
+	// unless the tx has a locktime. A relative timelock (BIP-68) is set when
+	// the disable flag is not set. An explicit 0 is rejected since a pset
+	// can't tell it from a missing sequence.
+	Sequence *uint32
+}
+
+// InputIssuance holds the info about the (re)issuance attached to an input
+// required to estimate its size. A zero amount means that the asset or the
+// token is not issued. If blinded, the amounts are commitments with a
+// rangeproof each.
+type InputIssuance struct {
+	AssetAmount uint64
+	TokenAmount uint64
+	Blinded     bool
 }
 
 func (i Input) Validate() error {
@@ -66,9 +121,22 @@ func (i Input) Validate() error {
 	if len(buf) != 32 {
 		return ErrInputInvalidTxid
 	}
+	if i.Sequence != nil && *i.Sequence == 0 {
+		return ErrInputZeroSequence
+	}
+	if i.HasRelativeTimelock() &&
+		*i.Sequence&^(sequenceTypeFlag|sequenceLockMask) != 0 {
+		return ErrInputInvalidSequence
+	}
 	return nil
 }
 
+// HasRelativeTimelock returns whether the sequence of the input enables a
+// relative timelock (BIP-68).
+func (i Input) HasRelativeTimelock() bool {
+	return i.Sequence != nil && *i.Sequence&sequenceDisableFlag == 0
+}
+
 func (i Input) Prevout() *transaction.TxOutput {
 	value := i.ValueCommitment
 	if len(value) == 0 {
@@ -12,13 +12,21 @@ var (
 	ErrMissingPset       = fmt.Errorf("missing pset base64")
 	ErrMissingInputs     = fmt.Errorf("at least one input is mandatory to create a partial transaction with one or more confidential outputs")
 	ErrInvalidSignatures = fmt.Errorf("transaction contains invalid signature(s)")
+	ErrLockTimeConflict  = fmt.Errorf("partial transaction already has a different locktime")
+
+	ErrLockTimeWithFinalInputs = fmt.Errorf("locktime requires at least one non-final input")
 
 	DummyFeeAmount = uint64(700)
 )
 
+// CreatePsetArgs are the args to create a partial transaction. LockTime is
+// the optional nLockTime of the tx, either a block height or a timestamp.
+// If set, the inputs without a sequence enable it without signaling
+// replaceability.
 type CreatePsetArgs struct {
-	Inputs  []Input
-	Outputs []Output
+	Inputs   []Input
+	Outputs  []Output
+	LockTime uint32
 }
 
 func (a CreatePsetArgs) validate() error {
@@ -27,6 +35,9 @@ func (a CreatePsetArgs) validate() error {
 			return fmt.Errorf("invalid input %d: %s", i, err)
 		}
 	}
+	if err := validateLockTime(a.LockTime, a.Inputs); err != nil {
+		return err
+	}
 
 	for i, out := range a.Outputs {
 		if err := out.Validate(); err != nil {
@@ -41,14 +52,15 @@ func (a CreatePsetArgs) validate() error {
 }
 
 func (a CreatePsetArgs) inputs() []psetv2.InputArgs {
-	ins := make([]psetv2.InputArgs, 0, len(a.Inputs))
-	for _, in := range a.Inputs {
-		ins = append(ins, psetv2.InputArgs{
-			Txid:    in.TxID,
-			TxIndex: in.TxIndex,
-		})
+	return toInputArgs(a.Inputs, a.LockTime > 0)
+}
+
+func (a CreatePsetArgs) locktime() *uint32 {
+	if a.LockTime == 0 {
+		return nil
 	}
-	return ins
+	locktime := a.LockTime
+	return &locktime
 }
 
 func (a CreatePsetArgs) outputs() []psetv2.OutputArgs {
@@ -65,7 +77,7 @@ func CreatePset(args CreatePsetArgs) (string, error) {
 		return "", err
 	}
 
-	ptx, err := psetv2.New(args.inputs(), args.outputs(), nil)
+	ptx, err := psetv2.New(args.inputs(), args.outputs(), args.locktime())
 	if err != nil {
 		return "", err
 	}
@@ -98,17 +110,22 @@ func CreatePset(args CreatePsetArgs) (string, error) {
 	return ptx.ToBase64()
 }
 
+// UpdatePsetArgs are the args to add inputs and outputs to a partial
+// transaction. LockTime, if set, becomes the nLockTime of the tx, as long as
+// it doesn't conflict with the one already set.
 type UpdatePsetArgs struct {
 	PsetBase64 string
 	Inputs     []Input
 	Outputs    []Output
+	LockTime   uint32
 }
 
 func (a UpdatePsetArgs) validate() error {
 	if len(a.PsetBase64) == 0 {
 		return ErrMissingPset
 	}
-	if _, err := psetv2.NewPsetFromBase64(a.PsetBase64); err != nil {
+	ptx, err := psetv2.NewPsetFromBase64(a.PsetBase64)
+	if err != nil {
 		return err
 	}
 
@@ -117,6 +134,15 @@ func (a UpdatePsetArgs) validate() error {
 			return fmt.Errorf("invalid input %d: %s", i, err)
 		}
 	}
+	if a.LockTime > 0 {
+		if locktime := ptx.Global.FallbackLocktime; locktime != nil &&
+			*locktime != a.LockTime {
+			return ErrLockTimeConflict
+		}
+		if err := validateLockTime(a.LockTime, a.Inputs); err != nil {
+			return err
+		}
+	}
 
 	for i, out := range a.Outputs {
 		if err := out.Validate(); err != nil {
@@ -126,15 +152,9 @@ func (a UpdatePsetArgs) validate() error {
 	return nil
 }
 
-func (a UpdatePsetArgs) inputs() []psetv2.InputArgs {
-	ins := make([]psetv2.InputArgs, 0, len(a.Inputs))
-	for _, in := range a.Inputs {
-		ins = append(ins, psetv2.InputArgs{
-			Txid:    in.TxID,
-			TxIndex: in.TxIndex,
-		})
-	}
-	return ins
+func (a UpdatePsetArgs) inputs(ptx *psetv2.Pset) []psetv2.InputArgs {
+	hasLockTime := a.LockTime > 0 || ptx.Global.FallbackLocktime != nil
+	return toInputArgs(a.Inputs, hasLockTime)
 }
 
 func (a UpdatePsetArgs) outputs(blinderIndex uint32) []psetv2.OutputArgs {
@@ -158,8 +178,13 @@ func UpdatePset(args UpdatePsetArgs) (string, error) {
 		return "", err
 	}
 
+	if args.LockTime > 0 {
+		locktime := args.LockTime
+		ptx.Global.FallbackLocktime = &locktime
+	}
+
 	nextInputIndex := uint32(ptx.Global.InputCount)
-	if err := updater.AddInputs(args.inputs()); err != nil {
+	if err := updater.AddInputs(args.inputs(ptx)); err != nil {
 		return "", err
 	}
 
@@ -196,6 +221,40 @@ func UpdatePset(args UpdatePsetArgs) (string, error) {
 	return ptx.ToBase64()
 }
 
+// validateLockTime makes sure that the given locktime is not ignored because
+// all the given inputs are explicitly final.
+func validateLockTime(locktime uint32, inputs []Input) error {
+	if locktime == 0 || len(inputs) == 0 {
+		return nil
+	}
+	for _, in := range inputs {
+		if in.Sequence == nil || *in.Sequence != FinalSequence {
+			return nil
+		}
+	}
+	return ErrLockTimeWithFinalInputs
+}
+
+// toInputArgs converts the given inputs into pset ones. If the tx has a
+// locktime, the inputs without a sequence are given one enabling it.
+func toInputArgs(inputs []Input, hasLockTime bool) []psetv2.InputArgs {
+	ins := make([]psetv2.InputArgs, 0, len(inputs))
+	for _, in := range inputs {
+		var sequence uint32
+		if in.Sequence != nil {
+			sequence = *in.Sequence
+		} else if hasLockTime {
+			sequence = LockTimeSequence
+		}
+		ins = append(ins, psetv2.InputArgs{
+			Txid:     in.TxID,
+			TxIndex:  in.TxIndex,
+			Sequence: sequence,
+		})
+	}
+	return ins
+}
+
 type FinalizeAndExtractTransactionArgs struct {
 	PsetBase64 string
 }
@@ -210,6 +269,8 @@ func (a FinalizeAndExtractTransactionArgs) validate() error {
 // FinalizeAndExtractTransaction attempts to finalize the provided partial
 // transaction and eventually extracts the final raw transaction, returning
 // it in hex string format, along with its transaction id.
+// Inputs already finalized are left untouched, the others are finalized like
+// in FinalizePset.
 func FinalizeAndExtractTransaction(args FinalizeAndExtractTransactionArgs) (string, string, error) {
 	if err := args.validate(); err != nil {
 		return "", "", err
@@ -217,15 +278,20 @@ func FinalizeAndExtractTransaction(args FinalizeAndExtractTransactionArgs) (stri
 
 	ptx, _ := psetv2.NewPsetFromBase64(args.PsetBase64)
 
-	ok, err := ptx.ValidateAllSignatures()
-	if err != nil {
-		return "", "", err
-	}
-	if !ok {
-		return "", "", ErrInvalidSignatures
+	for i, in := range ptx.Inputs {
+		if len(in.PartialSigs) <= 0 {
+			continue
+		}
+		ok, err := ptx.ValidateInputSignatures(i)
+		if err != nil {
+			return "", "", err
+		}
+		if !ok {
+			return "", "", ErrInvalidSignatures
+		}
 	}
 
-	if err := psetv2.FinalizeAll(ptx); err != nil {
+	if err := finalizeInputs(ptx); err != nil {
 		return "", "", err
 	}
 
@@ -38,6 +38,102 @@ func TestCreatePset(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, ptx)
 	})
+
+	t.Run("with_timelocks", func(t *testing.T) {
+		inputs := randomInputs(2)
+		relativeLock := uint32(144)
+		inputs[1].Sequence = &relativeLock
+		outputs := randomOutputs(3)
+		lockTime := uint32(1000)
+
+		psetBase64, err := wallet.CreatePset(wallet.CreatePsetArgs{
+			Inputs:   inputs,
+			Outputs:  outputs,
+			LockTime: lockTime,
+		})
+		require.NoError(t, err)
+
+		ptx, err := psetv2.NewPsetFromBase64(psetBase64)
+		require.NoError(t, err)
+		require.Equal(t, lockTime, ptx.Locktime())
+		require.Equal(t, wallet.LockTimeSequence, ptx.Inputs[0].Sequence)
+		require.Equal(t, relativeLock, ptx.Inputs[1].Sequence)
+
+		// Adding inputs to a pset with locktime preserves it.
+		newInputs := randomInputs(1)
+		psetBase64, err = wallet.UpdatePset(wallet.UpdatePsetArgs{
+			PsetBase64: psetBase64,
+			Inputs:     newInputs,
+		})
+		require.NoError(t, err)
+
+		ptx, err = psetv2.NewPsetFromBase64(psetBase64)
+		require.NoError(t, err)
+		require.Equal(t, lockTime, ptx.Locktime())
+		require.Equal(t, wallet.LockTimeSequence, ptx.Inputs[2].Sequence)
+
+		// Locktime can't be changed once set.
+		_, err = wallet.UpdatePset(wallet.UpdatePsetArgs{
+			PsetBase64: psetBase64,
+			Inputs:     randomInputs(1),
+			LockTime:   lockTime + 1,
+		})
+		require.ErrorIs(t, err, wallet.ErrLockTimeConflict)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		finalSequence := wallet.FinalSequence
+		finalInputs := randomInputs(2)
+		for i := range finalInputs {
+			finalInputs[i].Sequence = &finalSequence
+		}
+		invalidSequence := uint32(1 << 23)
+		invalidSequenceInputs := randomInputs(1)
+		invalidSequenceInputs[0].Sequence = &invalidSequence
+		zeroSequence := uint32(0)
+		zeroSequenceInputs := randomInputs(1)
+		zeroSequenceInputs[0].Sequence = &zeroSequence
+
+		tests := []struct {
+			name          string
+			args          wallet.CreatePsetArgs
+			expectedError error
+		}{
+			{
+				name: "locktime_with_final_inputs",
+				args: wallet.CreatePsetArgs{
+					Inputs:   finalInputs,
+					Outputs:  randomOutputs(1),
+					LockTime: 1000,
+				},
+				expectedError: wallet.ErrLockTimeWithFinalInputs,
+			},
+			{
+				name: "invalid_relative_timelock",
+				args: wallet.CreatePsetArgs{
+					Inputs:  invalidSequenceInputs,
+					Outputs: randomOutputs(1),
+				},
+				expectedError: wallet.ErrInputInvalidSequence,
+			},
+			{
+				name: "zero_sequence",
+				args: wallet.CreatePsetArgs{
+					Inputs:  zeroSequenceInputs,
+					Outputs: randomOutputs(1),
+				},
+				expectedError: wallet.ErrInputZeroSequence,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				psetBase64, err := wallet.CreatePset(tt.args)
+				require.ErrorContains(t, err, tt.expectedError.Error())
+				require.Empty(t, psetBase64)
+			})
+		}
+	})
 }
 
 func randomInputs(num int) []wallet.Input {